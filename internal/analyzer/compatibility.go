@@ -0,0 +1,527 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/taking/kubemigrate/pkg/client/kubernetes"
+	"github.com/taking/kubemigrate/pkg/types"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// defaultStorageClassAnnotation : 기본 StorageClass 표시 어노테이션
+const defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+
+// clusterVersion : 비교용 Kubernetes 버전 (1.x)
+type clusterVersion struct {
+	major, minor int
+}
+
+// analysis : 단일 분석 실행 상태
+type analysis struct {
+	source, target kubernetes.Client
+	opts           Options
+	targetVersion  clusterVersion
+	report         *types.CompatibilityReport
+}
+
+// usedResource : 원본 네임스페이스에 객체가 존재하는 리소스 종류
+type usedResource struct {
+	gvr  schema.GroupVersionResource
+	kind string
+}
+
+// addError : 복원을 실패시키는 항목 추가
+func (a *analysis) addError(category, res, message, suggestion string) {
+	a.report.Errors = append(a.report.Errors, types.CompatibilityIssue{
+		Category: category, Resource: res, Message: message, Suggestion: suggestion,
+	})
+}
+
+// addWarning : 확인이 필요한 항목 추가
+func (a *analysis) addWarning(category, res, message, suggestion string) {
+	a.report.Warnings = append(a.report.Warnings, types.CompatibilityIssue{
+		Category: category, Resource: res, Message: message, Suggestion: suggestion,
+	})
+}
+
+// checkVersionSkew : Kubernetes 버전 차이 확인
+func (a *analysis) checkVersionSkew(source clusterVersion) {
+	target := a.targetVersion
+	if source.major == 0 || target.major == 0 {
+		a.addWarning(CategoryVersion, "", "unable to parse cluster versions, version skew was not checked", "")
+		return
+	}
+
+	switch {
+	case target.major < source.major || (target.major == source.major && target.minor < source.minor):
+		a.addWarning(CategoryVersion, "",
+			fmt.Sprintf("target cluster (%d.%d) is older than source cluster (%d.%d)", target.major, target.minor, source.major, source.minor),
+			"API versions and fields introduced after the target version will not be restored; review the api findings")
+	case target.major == source.major && target.minor-source.minor > 3:
+		a.addWarning(CategoryVersion, "",
+			fmt.Sprintf("target cluster (%d.%d) is %d minor versions ahead of source cluster (%d.%d)",
+				target.major, target.minor, target.minor-source.minor, source.major, source.minor),
+			"consider an intermediate upgrade or verify workloads against the target version")
+	}
+}
+
+// checkAPIResources : 원본에서 사용 중인 API 버전과 CRD가 대상에서 제공되는지 확인
+func (a *analysis) checkAPIResources(ctx context.Context) error {
+	sourceLists, err := a.source.GetAPIResources(ctx, true)
+	if err != nil {
+		return fmt.Errorf("source cluster: %w", err)
+	}
+	targetLists, err := a.target.GetAPIResources(ctx, false)
+	if err != nil {
+		return fmt.Errorf("target cluster: %w", err)
+	}
+
+	used, err := a.usedResources(ctx, sourceLists)
+	if err != nil {
+		return err
+	}
+
+	sourceCRDs, err := crdNames(ctx, a.source)
+	if err != nil {
+		return fmt.Errorf("source cluster: %w", err)
+	}
+	targetCRDs, err := crdNames(ctx, a.target)
+	if err != nil {
+		return fmt.Errorf("target cluster: %w", err)
+	}
+
+	// 대상 클러스터 제공 리소스 색인 (group/resource → 제공 버전 목록)
+	served := make(map[schema.GroupResource][]string)
+	for _, list := range targetLists {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, res := range list.APIResources {
+			if strings.Contains(res.Name, "/") {
+				continue
+			}
+			gr := schema.GroupResource{Group: gv.Group, Resource: res.Name}
+			served[gr] = append(served[gr], gv.Version)
+		}
+	}
+
+	includeClusterResources := a.opts.IncludeClusterResources == nil || *a.opts.IncludeClusterResources
+	for _, res := range used {
+		gr := res.gvr.GroupResource()
+		name := resourceID(res.gvr)
+
+		// CRD로 정의된 리소스가 대상에 없는 경우
+		if sourceCRDs[gr.String()] && !targetCRDs[gr.String()] {
+			message := fmt.Sprintf("CustomResourceDefinition %s is not installed on the target cluster", gr.String())
+			if includeClusterResources {
+				a.addWarning(CategoryCRD, gr.String(), message,
+					"Velero restores the CRD with the backup; make sure its controller/operator is installed on the target")
+			} else {
+				a.addError(CategoryCRD, gr.String(), message,
+					"install the CRD on the target cluster or set includeClusterResources to restore it from the backup")
+			}
+			continue
+		}
+
+		versions, ok := served[gr]
+		if !ok {
+			a.addError(CategoryAPI, name,
+				fmt.Sprintf("%s (%s) is not served by the target cluster", gr.String(), res.kind),
+				suggestionFor(res.gvr, "exclude the resource from the migration or install the API on the target cluster"))
+			continue
+		}
+
+		if !contains(versions, res.gvr.Version) {
+			a.addError(CategoryAPI, name,
+				fmt.Sprintf("%s is backed up as %s but the target cluster only serves %s",
+					gr.String(), res.gvr.GroupVersion().String(), strings.Join(versions, ", ")),
+				suggestionFor(res.gvr, "enable the Velero EnableAPIGroupVersions feature on both clusters"))
+			continue
+		}
+
+		if deprecated, ok := deprecatedAPIs[name]; ok {
+			a.addWarning(CategoryAPI, name,
+				fmt.Sprintf("%s is deprecated and removed in Kubernetes 1.%d", res.gvr.GroupVersion().String(), deprecated.removedIn),
+				fmt.Sprintf("migrate manifests to %s before upgrading the target cluster", deprecated.replacement))
+		}
+	}
+
+	return nil
+}
+
+// usedResources : 포함 네임스페이스에 객체가 하나 이상 있는 리소스 종류 수집 (선호 버전 기준)
+func (a *analysis) usedResources(ctx context.Context, lists []*metav1.APIResourceList) ([]usedResource, error) {
+	excluded := make(map[string]bool, len(a.opts.ExcludeResources))
+	for _, name := range a.opts.ExcludeResources {
+		excluded[strings.ToLower(name)] = true
+	}
+
+	var used []usedResource
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil || skippedGroups[gv.Group] {
+			continue
+		}
+
+		for _, res := range list.APIResources {
+			if !res.Namespaced || strings.Contains(res.Name, "/") || !contains(res.Verbs, "list") {
+				continue
+			}
+			// Velero 기본 제외 리소스
+			if gv.Group == "" && res.Name == "events" {
+				continue
+			}
+			gr := schema.GroupResource{Group: gv.Group, Resource: res.Name}
+			if excluded[res.Name] || excluded[gr.String()] {
+				continue
+			}
+
+			gvr := gv.WithResource(res.Name)
+			found, err := a.hasObjects(ctx, gr)
+			if err != nil {
+				return nil, fmt.Errorf("source cluster: failed to list %s: %w", gr.String(), err)
+			}
+			if found {
+				used = append(used, usedResource{gvr: gvr, kind: res.Kind})
+			}
+		}
+	}
+
+	sort.Slice(used, func(i, j int) bool {
+		return resourceID(used[i].gvr) < resourceID(used[j].gvr)
+	})
+	return used, nil
+}
+
+// hasObjects : 포함 네임스페이스 중 하나라도 해당 리소스 객체가 있는지 확인
+func (a *analysis) hasObjects(ctx context.Context, gr schema.GroupResource) (bool, error) {
+	// "pods." 처럼 그룹을 명시해 다른 그룹의 동일 이름 리소스와 구분
+	kind := gr.Resource + "." + gr.Group
+	for _, namespace := range a.opts.IncludeNamespaces {
+		result, err := a.source.GetResources(ctx, kind, namespace, "", kubernetes.ResourceQuery{Limit: 1})
+		if err != nil {
+			return false, err
+		}
+		if list, ok := result.(*unstructured.UnstructuredList); ok && len(list.Items) > 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// checkStorage : PVC가 사용하는 StorageClass와 CSI 드라이버가 대상에 있는지 확인
+func (a *analysis) checkStorage(ctx context.Context) error {
+	sourceClasses, sourceDefault, err := storageClasses(ctx, a.source)
+	if err != nil {
+		return fmt.Errorf("source cluster: %w", err)
+	}
+	targetClasses, targetDefault, err := storageClasses(ctx, a.target)
+	if err != nil {
+		return fmt.Errorf("target cluster: %w", err)
+	}
+	drivers, err := csiDrivers(ctx, a.target)
+	if err != nil {
+		return fmt.Errorf("target cluster: %w", err)
+	}
+
+	// StorageClass별 PVC 목록
+	claims := make(map[string][]string)
+	for _, namespace := range a.opts.IncludeNamespaces {
+		result, err := a.source.GetPVCs(ctx, namespace, "")
+		if err != nil {
+			return fmt.Errorf("source cluster: failed to list PVCs in %s: %w", namespace, err)
+		}
+		pvcs, ok := result.(*v1.PersistentVolumeClaimList)
+		if !ok {
+			return fmt.Errorf("source cluster: unexpected PVC list type %T", result)
+		}
+
+		for _, pvc := range pvcs.Items {
+			className := sourceDefault
+			if pvc.Spec.StorageClassName != nil {
+				className = *pvc.Spec.StorageClassName
+			}
+			claims[className] = append(claims[className], pvc.Namespace+"/"+pvc.Name)
+		}
+	}
+
+	classNames := make([]string, 0, len(claims))
+	for name := range claims {
+		classNames = append(classNames, name)
+	}
+	sort.Strings(classNames)
+
+	for _, sourceName := range classNames {
+		pvcs := claims[sourceName]
+		if sourceName == "" {
+			a.addWarning(CategoryStorage, strings.Join(pvcs, ", "),
+				fmt.Sprintf("%d PVC(s) have no StorageClass and rely on statically provisioned volumes", len(pvcs)),
+				"create matching PersistentVolumes on the target cluster or use file system backup")
+			continue
+		}
+
+		targetName := sourceName
+		if mapped, ok := a.opts.StorageClassMappings[sourceName]; ok && mapped != "" {
+			targetName = mapped
+		}
+
+		targetClass, ok := targetClasses[targetName]
+		if !ok {
+			suggestion := fmt.Sprintf("create StorageClass %s on the target cluster or add a storageClassMappings entry", targetName)
+			if targetDefault != "" {
+				suggestion = fmt.Sprintf("%s (e.g. %s:%s)", suggestion, sourceName, targetDefault)
+			}
+			a.addError(CategoryStorage, targetName,
+				fmt.Sprintf("StorageClass %s used by %d PVC(s) does not exist on the target cluster", targetName, len(pvcs)),
+				suggestion)
+			continue
+		}
+
+		provisioner := targetClass.Provisioner
+		if !strings.HasPrefix(provisioner, "kubernetes.io/") && !drivers[provisioner] {
+			a.addWarning(CategoryStorage, targetName,
+				fmt.Sprintf("provisioner %s of StorageClass %s has no registered CSIDriver on the target cluster", provisioner, targetName),
+				"install the CSI driver or verify the external provisioner is running")
+		}
+
+		if sourceClass, ok := sourceClasses[sourceName]; ok && sourceClass.Provisioner != provisioner {
+			a.addWarning(CategoryStorage, targetName,
+				fmt.Sprintf("volumes are provisioned by %s on the source but by %s on the target", sourceClass.Provisioner, provisioner),
+				"volume snapshots cannot be restored across drivers; use file system backup (defaultVolumesToFsBackup)")
+		}
+	}
+
+	return nil
+}
+
+// checkCapacity : 원본 워크로드 요청량을 대상 클러스터 여유 용량과 비교
+func (a *analysis) checkCapacity(ctx context.Context) error {
+	required := v1.ResourceList{}
+	for _, namespace := range a.opts.IncludeNamespaces {
+		pods, err := podList(ctx, a.source, namespace)
+		if err != nil {
+			return fmt.Errorf("source cluster: %w", err)
+		}
+		addPodRequests(required, pods)
+	}
+
+	result, err := a.target.GetNodes(ctx, "")
+	if err != nil {
+		return fmt.Errorf("target cluster: failed to list nodes: %w", err)
+	}
+	nodes, ok := result.(*v1.NodeList)
+	if !ok {
+		return fmt.Errorf("target cluster: unexpected node list type %T", result)
+	}
+
+	allocatable := v1.ResourceList{}
+	schedulable := 0
+	for _, node := range nodes.Items {
+		if node.Spec.Unschedulable || !nodeReady(node) {
+			continue
+		}
+		schedulable++
+		addResources(allocatable, node.Status.Allocatable)
+	}
+	if schedulable == 0 {
+		a.addError(CategoryCapacity, "", "target cluster has no ready, schedulable nodes", "")
+		return nil
+	}
+
+	// 대상 클러스터에서 이미 사용 중인 요청량 차감 (모든 네임스페이스)
+	targetPods, err := podList(ctx, a.target, "")
+	if err != nil {
+		return fmt.Errorf("target cluster: %w", err)
+	}
+	inUse := v1.ResourceList{}
+	addPodRequests(inUse, targetPods)
+
+	for _, name := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+		need, ok := required[name]
+		if !ok || need.IsZero() {
+			continue
+		}
+
+		available := allocatable[name].DeepCopy()
+		available.Sub(inUse[name])
+
+		switch {
+		case need.Cmp(available) > 0:
+			a.addError(CategoryCapacity, string(name),
+				fmt.Sprintf("workloads request %s %s but only %s is available on %d schedulable node(s)",
+					need.String(), name, available.String(), schedulable),
+				"add nodes to the target cluster or reduce resource requests")
+		case need.AsApproximateFloat64() > available.AsApproximateFloat64()*capacityWarningRatio:
+			a.addWarning(CategoryCapacity, string(name),
+				fmt.Sprintf("workloads request %s %s, more than %.0f%% of the %s available on the target cluster",
+					need.String(), name, capacityWarningRatio*100, available.String()),
+				"leave headroom for rolling updates and autoscaling")
+		}
+	}
+
+	return nil
+}
+
+// crdNames : 클러스터에 설치된 CRD 이름 집합 ("plural.group")
+func crdNames(ctx context.Context, c kubernetes.Client) (map[string]bool, error) {
+	result, err := c.GetCRDs(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CRDs: %w", err)
+	}
+	crds, ok := result.(*apiextensionsv1.CustomResourceDefinitionList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected CRD list type %T", result)
+	}
+
+	names := make(map[string]bool, len(crds.Items))
+	for _, crd := range crds.Items {
+		names[crd.Name] = true
+	}
+	return names, nil
+}
+
+// storageClasses : StorageClass 이름별 색인과 기본 StorageClass 이름
+func storageClasses(ctx context.Context, c kubernetes.Client) (map[string]storagev1.StorageClass, string, error) {
+	result, err := c.GetStorageClasses(ctx, "")
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to list storage classes: %w", err)
+	}
+	list, ok := result.(*storagev1.StorageClassList)
+	if !ok {
+		return nil, "", fmt.Errorf("unexpected storage class list type %T", result)
+	}
+
+	classes := make(map[string]storagev1.StorageClass, len(list.Items))
+	defaultClass := ""
+	for _, sc := range list.Items {
+		classes[sc.Name] = sc
+		if sc.Annotations[defaultStorageClassAnnotation] == "true" {
+			defaultClass = sc.Name
+		}
+	}
+	return classes, defaultClass, nil
+}
+
+// csiDrivers : 등록된 CSI 드라이버 이름 집합
+func csiDrivers(ctx context.Context, c kubernetes.Client) (map[string]bool, error) {
+	result, err := c.GetCSIDrivers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := result.(*storagev1.CSIDriverList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected CSI driver list type %T", result)
+	}
+
+	drivers := make(map[string]bool, len(list.Items))
+	for _, driver := range list.Items {
+		drivers[driver.Name] = true
+	}
+	return drivers, nil
+}
+
+// podList : 네임스페이스의 Pod 목록 조회 (빈 문자열이면 전체)
+func podList(ctx context.Context, c kubernetes.Client, namespace string) (*v1.PodList, error) {
+	result, err := c.GetPods(ctx, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	pods, ok := result.(*v1.PodList)
+	if !ok {
+		return nil, fmt.Errorf("unexpected pod list type %T", result)
+	}
+	return pods, nil
+}
+
+// addPodRequests : 종료되지 않은 Pod의 유효 요청량 합산
+// 유효 요청량 = max(컨테이너 요청 합, 가장 큰 init 컨테이너 요청) + overhead
+func addPodRequests(total v1.ResourceList, pods *v1.PodList) {
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == v1.PodSucceeded || pod.Status.Phase == v1.PodFailed {
+			continue
+		}
+
+		requests := v1.ResourceList{}
+		for _, container := range pod.Spec.Containers {
+			addResources(requests, container.Resources.Requests)
+		}
+		for _, container := range pod.Spec.InitContainers {
+			for name, quantity := range container.Resources.Requests {
+				if current, ok := requests[name]; !ok || quantity.Cmp(current) > 0 {
+					requests[name] = quantity.DeepCopy()
+				}
+			}
+		}
+		addResources(requests, pod.Spec.Overhead)
+		addResources(total, requests)
+	}
+}
+
+// addResources : 리소스 수량 합산
+func addResources(total, add v1.ResourceList) {
+	for name, quantity := range add {
+		current, ok := total[name]
+		if !ok {
+			current = resource.Quantity{Format: quantity.Format}
+		}
+		current.Add(quantity)
+		total[name] = current
+	}
+}
+
+// nodeReady : 노드 Ready 조건 확인
+func nodeReady(node v1.Node) bool {
+	for _, condition := range node.Status.Conditions {
+		if condition.Type == v1.NodeReady {
+			return condition.Status == v1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// parseVersion : discovery 버전 정보 파싱 (예: Minor "30+")
+func parseVersion(major, minor string) clusterVersion {
+	majorNum, _ := strconv.Atoi(strings.TrimRight(major, "+"))
+	minorNum, _ := strconv.Atoi(strings.TrimRight(minor, "+"))
+	return clusterVersion{major: majorNum, minor: minorNum}
+}
+
+// resourceID : "group/version/resource" 식별자 (core 그룹은 "version/resource")
+func resourceID(gvr schema.GroupVersionResource) string {
+	return gvr.GroupVersion().String() + "/" + gvr.Resource
+}
+
+// suggestionFor : 지원 중단 API이면 대체 버전 안내, 아니면 기본 안내 반환
+func suggestionFor(gvr schema.GroupVersionResource, fallback string) string {
+	if deprecated, ok := deprecatedAPIs[resourceID(gvr)]; ok {
+		return fmt.Sprintf("re-create the resources as %s before backup", deprecated.replacement)
+	}
+	return fallback
+}
+
+// contains : 문자열 슬라이스 포함 여부
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package analyzer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/taking/kubemigrate/pkg/client/kubernetes"
+	"github.com/taking/kubemigrate/pkg/types"
+)
+
+// Service : 마이그레이션 사전 호환성 분석 서비스
+type Service struct{}
+
+// NewService : 새로운 분석 서비스 생성
+func NewService() *Service {
+	return &Service{}
+}
+
+// Analyze : 원본/대상 클러스터 호환성 분석
+// 클러스터 조회에 실패하면 에러를 반환하고, 호환성 문제는 리포트의 Errors(복원 실패)와 Warnings(확인 필요)로 구분합니다.
+func (s *Service) Analyze(ctx context.Context, source, target kubernetes.Client, opts Options) (*types.CompatibilityReport, error) {
+	if len(opts.IncludeNamespaces) == 0 {
+		return nil, fmt.Errorf("includeNamespaces is required")
+	}
+
+	sourceVersion, err := source.GetServerVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("source cluster: %w", err)
+	}
+	targetVersion, err := target.GetServerVersion(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("target cluster: %w", err)
+	}
+
+	a := &analysis{
+		source:        source,
+		target:        target,
+		opts:          opts,
+		targetVersion: parseVersion(targetVersion.Major, targetVersion.Minor),
+		report: &types.CompatibilityReport{
+			SourceVersion: sourceVersion.GitVersion,
+			TargetVersion: targetVersion.GitVersion,
+			Errors:        []types.CompatibilityIssue{},
+			Warnings:      []types.CompatibilityIssue{},
+		},
+	}
+
+	a.checkVersionSkew(parseVersion(sourceVersion.Major, sourceVersion.Minor))
+
+	checks := []struct {
+		name string
+		run  func(context.Context) error
+	}{
+		{name: "api resources", run: a.checkAPIResources},
+		{name: "storage", run: a.checkStorage},
+		{name: "capacity", run: a.checkCapacity},
+	}
+	for _, check := range checks {
+		if err := check.run(ctx); err != nil {
+			return nil, fmt.Errorf("failed to analyze %s: %w", check.name, err)
+		}
+	}
+
+	report := a.report
+	report.ErrorCount = len(report.Errors)
+	report.WarningCount = len(report.Warnings)
+	report.Compatible = report.ErrorCount == 0
+	report.AnalyzedAt = time.Now()
+
+	return report, nil
+}
@@ -0,0 +1,233 @@
+package analyzer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/taking/kubemigrate/internal/mocks"
+	"github.com/taking/kubemigrate/pkg/client/kubernetes"
+	"github.com/taking/kubemigrate/pkg/types"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// fakeCluster : 분석 테스트용 클러스터 (지정하지 않은 메서드는 Mock 사용)
+type fakeCluster struct {
+	*mocks.MockKubernetesClient
+	minor     string
+	resources []*metav1.APIResourceList
+	used      map[string]bool // GetResources kind("resource.group") → 객체 존재 여부
+	crds      []string
+	classes   []storagev1.StorageClass
+	drivers   []string
+	pvcs      []v1.PersistentVolumeClaim
+	pods      []v1.Pod
+	nodes     []v1.Node
+}
+
+func (f *fakeCluster) GetServerVersion(ctx context.Context) (*version.Info, error) {
+	return &version.Info{Major: "1", Minor: f.minor, GitVersion: "v1." + f.minor + ".0"}, nil
+}
+
+func (f *fakeCluster) GetAPIResources(ctx context.Context, preferred bool) ([]*metav1.APIResourceList, error) {
+	return f.resources, nil
+}
+
+func (f *fakeCluster) GetResources(ctx context.Context, kind, namespace, name string, query kubernetes.ResourceQuery) (interface{}, error) {
+	list := &unstructured.UnstructuredList{}
+	if f.used[kind] {
+		list.Items = []unstructured.Unstructured{{Object: map[string]interface{}{}}}
+	}
+	return list, nil
+}
+
+func (f *fakeCluster) GetCRDs(ctx context.Context, name string) (interface{}, error) {
+	list := &apiextensionsv1.CustomResourceDefinitionList{}
+	for _, crd := range f.crds {
+		list.Items = append(list.Items, apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: crd}})
+	}
+	return list, nil
+}
+
+func (f *fakeCluster) GetStorageClasses(ctx context.Context, name string) (interface{}, error) {
+	return &storagev1.StorageClassList{Items: f.classes}, nil
+}
+
+func (f *fakeCluster) GetCSIDrivers(ctx context.Context) (interface{}, error) {
+	list := &storagev1.CSIDriverList{}
+	for _, driver := range f.drivers {
+		list.Items = append(list.Items, storagev1.CSIDriver{ObjectMeta: metav1.ObjectMeta{Name: driver}})
+	}
+	return list, nil
+}
+
+func (f *fakeCluster) GetPVCs(ctx context.Context, namespace, name string) (interface{}, error) {
+	return &v1.PersistentVolumeClaimList{Items: f.pvcs}, nil
+}
+
+func (f *fakeCluster) GetPods(ctx context.Context, namespace, name string) (interface{}, error) {
+	return &v1.PodList{Items: f.pods}, nil
+}
+
+func (f *fakeCluster) GetNodes(ctx context.Context, name string) (interface{}, error) {
+	return &v1.NodeList{Items: f.nodes}, nil
+}
+
+// apiList : 네임스페이스 범위 리소스 목록 생성
+func apiList(groupVersion string, resources ...string) *metav1.APIResourceList {
+	list := &metav1.APIResourceList{GroupVersion: groupVersion}
+	for _, name := range resources {
+		list.APIResources = append(list.APIResources, metav1.APIResource{
+			Name: name, Namespaced: true, Verbs: metav1.Verbs{"get", "list"},
+		})
+	}
+	return list
+}
+
+// podRequesting : CPU/메모리를 요청하는 Pod 생성
+func podRequesting(cpu, memory string) v1.Pod {
+	return v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{
+		Resources: v1.ResourceRequirements{Requests: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}},
+	}}}}
+}
+
+func storageClassName(name string) *string {
+	return &name
+}
+
+// newClusters : 호환성 문제가 섞여 있는 원본/대상 클러스터 구성
+func newClusters() (*fakeCluster, *fakeCluster) {
+	source := &fakeCluster{
+		minor: "24",
+		resources: []*metav1.APIResourceList{
+			apiList("v1", "pods", "events"),
+			apiList("batch/v1", "cronjobs"),
+			apiList("autoscaling/v2beta2", "horizontalpodautoscalers"),
+			apiList("coordination.k8s.io/v1", "leases"),
+			apiList("example.com/v1", "widgets"),
+		},
+		used: map[string]bool{
+			"pods.": true, "events.": true, "cronjobs.batch": true,
+			"horizontalpodautoscalers.autoscaling": true, "widgets.example.com": true,
+		},
+		crds: []string{"widgets.example.com"},
+		classes: []storagev1.StorageClass{
+			{ObjectMeta: metav1.ObjectMeta{Name: "standard"}, Provisioner: "pd.csi.storage.gke.io"},
+			{ObjectMeta: metav1.ObjectMeta{Name: "fast"}, Provisioner: "pd.csi.storage.gke.io"},
+		},
+		pvcs: []v1.PersistentVolumeClaim{
+			{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "app"}, Spec: v1.PersistentVolumeClaimSpec{StorageClassName: storageClassName("standard")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "app"}, Spec: v1.PersistentVolumeClaimSpec{StorageClassName: storageClassName("fast")}},
+		},
+		pods: []v1.Pod{podRequesting("2", "512Mi"), podRequesting("1", "512Mi")},
+	}
+
+	target := &fakeCluster{
+		minor: "22",
+		resources: []*metav1.APIResourceList{
+			apiList("v1", "pods", "events"),
+			apiList("batch/v1beta1", "cronjobs"),
+			apiList("autoscaling/v2beta2", "horizontalpodautoscalers"),
+		},
+		classes: []storagev1.StorageClass{
+			{ObjectMeta: metav1.ObjectMeta{Name: "standard", Annotations: map[string]string{defaultStorageClassAnnotation: "true"}}, Provisioner: "ebs.csi.aws.com"},
+		},
+		pods: []v1.Pod{podRequesting("2", "1Gi")},
+		nodes: []v1.Node{{
+			Status: v1.NodeStatus{
+				Allocatable: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse("4"),
+					v1.ResourceMemory: resource.MustParse("8Gi"),
+				},
+				Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+			},
+		}},
+	}
+
+	return source, target
+}
+
+// countByCategory : 분류별 항목 개수
+func countByCategory(issues []types.CompatibilityIssue) map[string]int {
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		counts[issue.Category]++
+	}
+	return counts
+}
+
+// TestService_Analyze - 버전/API/CRD/스토리지/용량 호환성 분석 테스트
+func TestService_Analyze(t *testing.T) {
+	source, target := newClusters()
+
+	report, err := NewService().Analyze(context.Background(), source, target, Options{IncludeNamespaces: []string{"app"}})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	if report.Compatible {
+		t.Error("Expected report to be incompatible")
+	}
+	if report.SourceVersion != "v1.24.0" || report.TargetVersion != "v1.22.0" {
+		t.Errorf("Unexpected versions: %s -> %s", report.SourceVersion, report.TargetVersion)
+	}
+
+	// 에러: batch/v1 cronjobs 미제공, StorageClass fast 없음, CPU 부족 (요청 3 > 여유 2)
+	errors := countByCategory(report.Errors)
+	if errors[CategoryAPI] != 1 || errors[CategoryStorage] != 1 || errors[CategoryCapacity] != 1 || len(report.Errors) != 3 {
+		t.Errorf("Unexpected errors: %+v", report.Errors)
+	}
+
+	// 경고: 대상 버전이 낮음, autoscaling/v2beta2 지원 중단, CRD 누락, CSI 드라이버 미등록, 프로비저너 변경
+	warnings := countByCategory(report.Warnings)
+	if warnings[CategoryVersion] != 1 || warnings[CategoryAPI] != 1 || warnings[CategoryCRD] != 1 || warnings[CategoryStorage] != 2 {
+		t.Errorf("Unexpected warnings: %+v", report.Warnings)
+	}
+	if report.ErrorCount != len(report.Errors) || report.WarningCount != len(report.Warnings) {
+		t.Errorf("Counts do not match issues: %d/%d", report.ErrorCount, report.WarningCount)
+	}
+}
+
+// TestService_AnalyzeResolvedIssues - 매핑/제외 설정으로 해결된 항목은 보고하지 않는지 테스트
+func TestService_AnalyzeResolvedIssues(t *testing.T) {
+	source, target := newClusters()
+	source.pods = []v1.Pod{podRequesting("500m", "512Mi")}
+	target.drivers = []string{"ebs.csi.aws.com"}
+
+	includeClusterResources := false
+	report, err := NewService().Analyze(context.Background(), source, target, Options{
+		IncludeNamespaces:       []string{"app"},
+		ExcludeResources:        []string{"cronjobs"},
+		IncludeClusterResources: &includeClusterResources,
+		StorageClassMappings:    map[string]string{"fast": "standard"},
+	})
+	if err != nil {
+		t.Fatalf("Analyze() error = %v", err)
+	}
+
+	// 클러스터 리소스를 복원하지 않으면 CRD 누락이 유일한 에러
+	if len(report.Errors) != 1 || report.Errors[0].Category != CategoryCRD {
+		t.Errorf("Expected only a CRD error, got %+v", report.Errors)
+	}
+	for _, warning := range report.Warnings {
+		if warning.Category == CategoryCapacity {
+			t.Errorf("Unexpected capacity warning: %+v", warning)
+		}
+	}
+}
+
+// TestService_AnalyzeRequiresNamespaces - 네임스페이스 누락 요청 테스트
+func TestService_AnalyzeRequiresNamespaces(t *testing.T) {
+	source, target := newClusters()
+	if _, err := NewService().Analyze(context.Background(), source, target, Options{}); err == nil {
+		t.Error("Expected error for missing includeNamespaces")
+	}
+}
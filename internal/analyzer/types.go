@@ -0,0 +1,50 @@
+package analyzer
+
+// 분석 항목 분류
+const (
+	CategoryVersion  = "version"  // Kubernetes 버전 차이
+	CategoryAPI      = "api"      // 제거되었거나 지원 중단 예정인 API 버전
+	CategoryCRD      = "crd"      // 대상 클러스터에 없는 CRD
+	CategoryStorage  = "storage"  // StorageClass / CSI 드라이버
+	CategoryCapacity = "capacity" // 노드 리소스 용량
+)
+
+// capacityWarningRatio : 대상 클러스터 여유 용량 대비 경고 기준 비율
+const capacityWarningRatio = 0.8
+
+// Options : 호환성 분석 범위 설정 (마이그레이션 요청과 동일한 의미)
+type Options struct {
+	IncludeNamespaces       []string          `json:"includeNamespaces"`
+	ExcludeResources        []string          `json:"excludeResources"`
+	IncludeClusterResources *bool             `json:"includeClusterResources"`
+	StorageClassMappings    map[string]string `json:"storageClassMappings"`
+}
+
+// deprecatedAPI : 지원 중단된 API 버전 정보
+type deprecatedAPI struct {
+	removedIn   int    // 제거된 1.x 마이너 버전
+	replacement string // 대체 API 버전
+}
+
+// deprecatedAPIs : 네임스페이스 범위 리소스의 지원 중단 API 목록 ("group/version/resource" 기준)
+// https://kubernetes.io/docs/reference/using-api/deprecation-guide/
+var deprecatedAPIs = map[string]deprecatedAPI{
+	"extensions/v1beta1/ingresses":                   {removedIn: 22, replacement: "networking.k8s.io/v1"},
+	"networking.k8s.io/v1beta1/ingresses":            {removedIn: 22, replacement: "networking.k8s.io/v1"},
+	"rbac.authorization.k8s.io/v1beta1/roles":        {removedIn: 22, replacement: "rbac.authorization.k8s.io/v1"},
+	"rbac.authorization.k8s.io/v1beta1/rolebindings": {removedIn: 22, replacement: "rbac.authorization.k8s.io/v1"},
+	"coordination.k8s.io/v1beta1/leases":             {removedIn: 22, replacement: "coordination.k8s.io/v1"},
+	"batch/v1beta1/cronjobs":                         {removedIn: 25, replacement: "batch/v1"},
+	"policy/v1beta1/poddisruptionbudgets":            {removedIn: 25, replacement: "policy/v1"},
+	"discovery.k8s.io/v1beta1/endpointslices":        {removedIn: 25, replacement: "discovery.k8s.io/v1"},
+	"autoscaling/v2beta1/horizontalpodautoscalers":   {removedIn: 25, replacement: "autoscaling/v2"},
+	"autoscaling/v2beta2/horizontalpodautoscalers":   {removedIn: 26, replacement: "autoscaling/v2"},
+	"storage.k8s.io/v1beta1/csistoragecapacities":    {removedIn: 27, replacement: "storage.k8s.io/v1"},
+}
+
+// skippedGroups : 백업 대상에서 항상 제외되는 API 그룹
+var skippedGroups = map[string]bool{
+	"velero.io":      true,
+	"events.k8s.io":  true,
+	"metrics.k8s.io": true,
+}
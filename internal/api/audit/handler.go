@@ -0,0 +1,113 @@
+package audit
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	auditlog "github.com/taking/kubemigrate/internal/audit"
+	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/response"
+)
+
+// Handler : 감사 로그 조회 HTTP 핸들러
+type Handler struct {
+	*handler.BaseHandler
+	recorder auditlog.Recorder
+}
+
+// NewHandler : 새로운 감사 로그 핸들러 생성 (recorder가 nil이면 감사 로그 비활성화)
+func NewHandler(base *handler.BaseHandler, recorder auditlog.Recorder) *Handler {
+	return &Handler{
+		BaseHandler: base,
+		recorder:    recorder,
+	}
+}
+
+// GetAuditLogs : 감사 로그 조회
+// @Summary List Audit Log Entries
+// @Description List audit entries of mutating requests (newest first): principal, client IP, route, target cluster fingerprint, redacted parameters, outcome and job ID.
+// @Tags audit
+// @Produce json
+// @Param since query string false "Start time (RFC3339)"
+// @Param until query string false "End time (RFC3339)"
+// @Param subject query string false "Authenticated principal"
+// @Param service query string false "Service (velero, helm, minio, migrations, clusters, storages, ...)"
+// @Param method query string false "HTTP method"
+// @Param outcome query string false "Outcome (success, failure)"
+// @Param jobId query string false "Job or migration ID"
+// @Param cluster query string false "Cluster ID, kubeconfig fingerprint or API server"
+// @Param limit query int false "Maximum entries (default: 100, max: 1000)"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 503 {object} response.ErrorResponse
+// @Router /v1/audit [get]
+func (h *Handler) GetAuditLogs(c echo.Context) error {
+	if h.recorder == nil {
+		return response.RespondWithErrorModel(c, http.StatusServiceUnavailable, "AUDIT_LOG_DISABLED", auditlog.ErrDisabled.Error(), "")
+	}
+
+	filter, err := parseFilter(c)
+	if err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_PARAMETER", "Invalid audit query parameter", err.Error())
+	}
+
+	entries, err := h.recorder.Query(filter)
+	if err != nil {
+		return h.HandleInternalError(c, "audit", "query", err)
+	}
+	if entries == nil {
+		entries = []auditlog.Entry{}
+	}
+
+	return response.RespondWithData(c, http.StatusOK, map[string]interface{}{
+		"items": entries,
+		"count": len(entries),
+	})
+}
+
+// parseFilter : 쿼리 파라미터를 조회 조건으로 변환
+func parseFilter(c echo.Context) (auditlog.Filter, error) {
+	filter := auditlog.Filter{
+		Subject: c.QueryParam("subject"),
+		Service: c.QueryParam("service"),
+		Method:  c.QueryParam("method"),
+		Outcome: c.QueryParam("outcome"),
+		JobID:   c.QueryParam("jobId"),
+		Cluster: c.QueryParam("cluster"),
+	}
+
+	var err error
+	if filter.Since, err = parseTime(c.QueryParam("since")); err != nil {
+		return filter, errors.New("since must be an RFC3339 timestamp")
+	}
+	if filter.Until, err = parseTime(c.QueryParam("until")); err != nil {
+		return filter, errors.New("until must be an RFC3339 timestamp")
+	}
+
+	if value := c.QueryParam("limit"); value != "" {
+		limit, err := strconv.Atoi(value)
+		if err != nil || limit <= 0 {
+			return filter, errors.New("limit must be a positive integer")
+		}
+		filter.Limit = limit
+	}
+
+	switch filter.Outcome {
+	case "", auditlog.OutcomeSuccess, auditlog.OutcomeFailure:
+	default:
+		return filter, errors.New("outcome must be success or failure")
+	}
+
+	return filter, nil
+}
+
+// parseTime : RFC3339 시각 파싱 (빈 값은 zero time)
+func parseTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	return time.Parse(time.RFC3339, value)
+}
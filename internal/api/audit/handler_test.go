@@ -0,0 +1,88 @@
+package audit
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	auditlog "github.com/taking/kubemigrate/internal/audit"
+	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/job"
+)
+
+// TestAuditHandler_GetAuditLogs 감사 로그 조회 API 테스트
+func TestAuditHandler_GetAuditLogs(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+
+	recorder, err := auditlog.NewFileLog(filepath.Join(t.TempDir(), "audit.log"), 0, 1)
+	if err != nil {
+		t.Fatalf("NewFileLog() error = %v", err)
+	}
+	defer recorder.Close() //nolint:errcheck
+
+	now := time.Now().UTC()
+	for _, entry := range []auditlog.Entry{
+		{ID: "1", Timestamp: now.Add(-2 * time.Hour), Subject: "ci", Service: "velero", Method: http.MethodPost, Outcome: auditlog.OutcomeSuccess, JobID: "job-1"},
+		{ID: "2", Timestamp: now.Add(-time.Hour), Subject: "ci", Service: "helm", Method: http.MethodDelete, Outcome: auditlog.OutcomeFailure},
+		{ID: "3", Timestamp: now, Subject: "admin", Service: "velero", Method: http.MethodDelete, Outcome: auditlog.OutcomeSuccess},
+	} {
+		if err := recorder.Record(entry); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	e := echo.New()
+	e.GET("/audit", NewHandler(handler.NewBaseHandlerWithMock(workerPool), recorder).GetAuditLogs)
+	e.GET("/disabled", NewHandler(handler.NewBaseHandlerWithMock(workerPool), nil).GetAuditLogs)
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedCode  int
+		expectedCount int
+	}{
+		{"전체 조회", "/audit", http.StatusOK, 3},
+		{"서비스 필터", "/audit?service=velero", http.StatusOK, 2},
+		{"결과 필터", "/audit?outcome=failure", http.StatusOK, 1},
+		{"작업 ID 필터", "/audit?jobId=job-1", http.StatusOK, 1},
+		{"시간 필터", "/audit?since=" + now.Add(-90*time.Minute).Format(time.RFC3339), http.StatusOK, 2},
+		{"건수 제한", "/audit?limit=1", http.StatusOK, 1},
+		{"잘못된 limit", "/audit?limit=abc", http.StatusBadRequest, 0},
+		{"잘못된 since", "/audit?since=yesterday", http.StatusBadRequest, 0},
+		{"잘못된 outcome", "/audit?outcome=maybe", http.StatusBadRequest, 0},
+		{"비활성화", "/disabled", http.StatusServiceUnavailable, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedCode {
+				t.Fatalf("Expected status %d, got %d: %s", tt.expectedCode, rec.Code, rec.Body.String())
+			}
+			if rec.Code != http.StatusOK {
+				return
+			}
+
+			var body struct {
+				Data struct {
+					Items []auditlog.Entry `json:"items"`
+					Count int              `json:"count"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if body.Data.Count != tt.expectedCount || len(body.Data.Items) != tt.expectedCount {
+				t.Errorf("Expected %d entries, got %d", tt.expectedCount, body.Data.Count)
+			}
+		})
+	}
+}
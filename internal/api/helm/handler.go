@@ -2,13 +2,17 @@ package helm
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/response"
 	"github.com/taking/kubemigrate/pkg/client"
+	helmclient "github.com/taking/kubemigrate/pkg/client/helm"
 	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
 	"github.com/taking/kubemigrate/pkg/utils"
 )
 
@@ -133,8 +137,8 @@ func (h *Handler) GetChartStatus(c echo.Context) error {
 // @Produce json
 // @Param request body config.KubeConfig true "Kubernetes configuration"
 // @Param releaseName query string true "Release name"
-// @Param chartURL query string true "Chart URL (must be HTTP/HTTPS)"
-// @Param version query string false "Chart version (optional)"
+// @Param chartURL query string true "Chart URL (HTTP/HTTPS/OCI) or repo/chart reference of a registered repository"
+// @Param version query string false "Chart version (required for HTTP/HTTPS URLs, semver constraint for repo/chart and OCI)"
 // @Param namespace query string false "Namespace name (default: 'default')"
 // @Param values query string false "Chart values as JSON string"
 // @Success 200 {object} map[string]interface{} "Job started"
@@ -156,7 +160,8 @@ func (h *Handler) InstallChart(c echo.Context) error {
 	if chartURL == "" {
 		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "chartURL is required", "")
 	}
-	if version == "" {
+	// 저장소 참조("repo/chart")와 OCI 차트는 version을 semver 제약 조건으로 사용 (비어있으면 최신 정식 버전)
+	if version == "" && (strings.HasPrefix(chartURL, "http://") || strings.HasPrefix(chartURL, "https://")) {
 		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "version is required", "")
 	}
 
@@ -288,6 +293,49 @@ func (h *Handler) UpgradeChart(c echo.Context) error {
 	})
 }
 
+// RollbackChart : Helm 차트 비동기 롤백
+// @Summary Rollback Helm Chart Asynchronously
+// @Description Roll back a Helm release to a previous revision asynchronously, waiting for the rolled back resources to become ready, and return job ID for status tracking
+// @Tags helm
+// @Accept json
+// @Produce json
+// @Param name path string true "Chart name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'default')"
+// @Param revision query int false "Target revision (default: 0, previous revision)"
+// @Success 200 {object} map[string]interface{} "Job started"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /v1/helm/charts/{name}/rollback [post]
+func (h *Handler) RollbackChart(c echo.Context) error {
+	// 네임스페이스 결정
+	namespace := h.ResolveNamespace(c, "default")
+
+	// 리비전 파싱 (0이면 직전 리비전)
+	revision := 0
+	if revisionStr := c.QueryParam("revision"); revisionStr != "" {
+		var err error
+		revision, err = strconv.Atoi(revisionStr)
+		if err != nil {
+			return response.RespondWithErrorModel(c, 400, "INVALID_PARAMETER", "invalid revision parameter", err.Error())
+		}
+	}
+
+	// Config 생성 및 검증
+	config := config.RollbackChartConfig{
+		ReleaseName: c.Param("name"),
+		Namespace:   namespace,
+		Revision:    revision,
+	}
+	if err := h.ValidationManager.ValidateRollbackChartConfig(&config); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_PARAMETER", "invalid rollback request", err.Error())
+	}
+
+	return h.HandleResourceClient(c, "helm-rollback-async", func(client client.Client, ctx context.Context) (interface{}, error) {
+		return h.service.RollbackChartAsyncInternal(client, ctx, config)
+	})
+}
+
 // GetChartHistory : 차트 히스토리 조회
 // @Summary Get Chart History
 // @Description Get installation history of a specific Helm chart
@@ -369,6 +417,50 @@ func (h *Handler) GetJobStatus(c echo.Context) error {
 	return response.RespondWithData(c, 200, result)
 }
 
+// CancelJob : 진행 중인 작업 취소
+// @Summary Cancel Job
+// @Description Cancel a running job; cancelled installs are uninstalled and cancelled upgrades are rolled back
+// @Tags helm
+// @Accept json
+// @Produce json
+// @Param jobId path string true "Job ID"
+// @Success 200 {object} map[string]interface{} "Cancelled job"
+// @Failure 404 {object} map[string]interface{} "Job not found"
+// @Failure 409 {object} map[string]interface{} "Job already finished"
+// @Router /v1/helm/jobs/{jobId}/cancel [post]
+func (h *Handler) CancelJob(c echo.Context) error {
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "jobId is required", "")
+	}
+
+	result, err := h.service.CancelJobInternal(jobID)
+	if err != nil {
+		return h.HandleJobCancelError(c, err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// StreamJob : 작업 진행 상황 및 로그 실시간 스트리밍 (Server-Sent Events)
+// @Summary Stream Job Progress
+// @Description Stream job status and log lines as Server-Sent Events. Resume with the Last-Event-ID header.
+// @Tags helm
+// @Produce text/event-stream
+// @Param jobId path string true "Job ID"
+// @Param Last-Event-ID header string false "Number of log lines already received"
+// @Success 200 {string} string "Event stream"
+// @Failure 404 {object} map[string]interface{} "Job not found"
+// @Router /v1/helm/jobs/{jobId}/stream [get]
+func (h *Handler) StreamJob(c echo.Context) error {
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "jobId is required", "")
+	}
+
+	return h.BaseHandler.StreamJob(c, h.service.jobManager, jobID)
+}
+
 // GetJobLogs : 작업 로그 조회
 // @Summary Get Job Logs
 // @Description Get the logs of a specific job
@@ -411,3 +503,186 @@ func (h *Handler) GetAllJobs(c echo.Context) error {
 
 	return response.RespondWithData(c, 200, result)
 }
+
+// GetRepositories : 등록된 차트 저장소 목록 조회
+// @Summary Get Helm Repositories
+// @Description Get registered Helm chart repositories with the chart count of their cached index
+// @Tags helm
+// @Produce json
+// @Success 200 {object} map[string]interface{} "Repository list"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /v1/helm/repositories [get]
+func (h *Handler) GetRepositories(c echo.Context) error {
+	result, err := h.service.GetRepositoriesInternal()
+	if err != nil {
+		return response.RespondWithErrorModel(c, 500, "REPOSITORY_ERROR", "Failed to list repositories", err.Error())
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// AddRepository : 차트 저장소 등록
+// @Summary Add Helm Repository
+// @Description Register a classic Helm chart repository; its index is downloaded and cached on success. An existing repository with the same name is replaced.
+// @Tags helm
+// @Accept json
+// @Produce json
+// @Param request body helm.RepositoryEntry true "Repository configuration"
+// @Success 200 {object} helm.RepositoryInfo "Registered repository"
+// @Failure 400 {object} map[string]interface{} "Bad request or unreachable repository"
+// @Router /v1/helm/repositories [post]
+func (h *Handler) AddRepository(c echo.Context) error {
+	var entry helmclient.RepositoryEntry
+	if err := c.Bind(&entry); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+
+	result, err := h.service.AddRepositoryInternal(entry)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "REPOSITORY_ADD_FAILED", "Failed to add repository", err.Error())
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// UpdateRepositories : 차트 저장소 인덱스 갱신
+// @Summary Update Helm Repositories
+// @Description Download the latest index of the registered repositories (all repositories when no name is given)
+// @Tags helm
+// @Produce json
+// @Param name query string false "Comma separated repository names"
+// @Success 200 {object} map[string]interface{} "Update result per repository"
+// @Failure 404 {object} map[string]interface{} "Repository not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /v1/helm/repositories/update [post]
+func (h *Handler) UpdateRepositories(c echo.Context) error {
+	var names []string
+	for _, name := range strings.Split(c.QueryParam("name"), ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+
+	result, err := h.service.UpdateRepositoriesInternal(names)
+	if err != nil {
+		return h.respondRepositoryError(c, "Failed to update repositories", err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// RemoveRepository : 차트 저장소 등록 해제
+// @Summary Remove Helm Repository
+// @Description Remove a registered Helm chart repository and its cached index
+// @Tags helm
+// @Produce json
+// @Param name path string true "Repository name"
+// @Success 200 {object} map[string]interface{} "Repository removed"
+// @Failure 404 {object} map[string]interface{} "Repository not found"
+// @Failure 500 {object} map[string]interface{} "Internal server error"
+// @Router /v1/helm/repositories/{name} [delete]
+func (h *Handler) RemoveRepository(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "repository name is required", "")
+	}
+
+	result, err := h.service.RemoveRepositoryInternal(name)
+	if err != nil {
+		return h.respondRepositoryError(c, "Failed to remove repository", err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// SearchCharts : 등록된 저장소에서 차트 검색
+// @Summary Search Helm Charts
+// @Description Search charts in the cached indexes of the registered repositories. Results are "repo/chart" references usable as chartURL when installing.
+// @Tags helm
+// @Produce json
+// @Param keyword query string false "Keyword matched against chart name, description and keywords"
+// @Param version query string false "Semver constraint (e.g. '^1.2.0', '>=2.0.0 <3.0.0')"
+// @Param versions query boolean false "Return all matching versions instead of the latest one (default: false)"
+// @Success 200 {object} map[string]interface{} "Matching charts"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Router /v1/helm/search [get]
+func (h *Handler) SearchCharts(c echo.Context) error {
+	allVersions := false
+	if versionsStr := c.QueryParam("versions"); versionsStr != "" {
+		var err error
+		allVersions, err = strconv.ParseBool(versionsStr)
+		if err != nil {
+			return response.RespondWithErrorModel(c, 400, "INVALID_PARAMETER", "invalid versions parameter", err.Error())
+		}
+	}
+
+	result, err := h.service.SearchChartsInternal(c.QueryParam("keyword"), c.QueryParam("version"), allVersions)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_PARAMETER", "Failed to search charts", err.Error())
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// LoginRegistry : OCI 레지스트리 로그인
+// @Summary Login to OCI Registry
+// @Description Log in to an OCI registry; the credentials are stored and used when installing or upgrading oci:// charts
+// @Tags helm
+// @Accept json
+// @Produce json
+// @Param request body types.RegistryLoginRequest true "Registry credentials"
+// @Success 200 {object} map[string]interface{} "Logged in"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 401 {object} map[string]interface{} "Login failed"
+// @Router /v1/helm/registries/login [post]
+func (h *Handler) LoginRegistry(c echo.Context) error {
+	var req types.RegistryLoginRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if req.Host == "" || req.Username == "" || req.Password == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "host, username and password are required", "")
+	}
+
+	result, err := h.service.LoginRegistryInternal(req.Host, req.Username, req.Password, req.Insecure)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 401, "REGISTRY_LOGIN_FAILED", "Failed to login to registry", err.Error())
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// LogoutRegistry : OCI 레지스트리 로그아웃
+// @Summary Logout from OCI Registry
+// @Description Remove the stored credentials of an OCI registry
+// @Tags helm
+// @Accept json
+// @Produce json
+// @Param request body types.RegistryLogoutRequest true "Registry host"
+// @Success 200 {object} map[string]interface{} "Logged out"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Router /v1/helm/registries/logout [post]
+func (h *Handler) LogoutRegistry(c echo.Context) error {
+	var req types.RegistryLogoutRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if req.Host == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "host is required", "")
+	}
+
+	result, err := h.service.LogoutRegistryInternal(req.Host)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "REGISTRY_LOGOUT_FAILED", "Failed to logout from registry", err.Error())
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// respondRepositoryError : 저장소 에러 응답 (미등록 저장소는 404)
+func (h *Handler) respondRepositoryError(c echo.Context, message string, err error) error {
+	if errors.Is(err, helmclient.ErrRepositoryNotFound) {
+		return response.RespondWithErrorModel(c, 404, "REPOSITORY_NOT_FOUND", message, err.Error())
+	}
+	return response.RespondWithErrorModel(c, 500, "REPOSITORY_ERROR", message, err.Error())
+}
@@ -240,3 +240,199 @@ func TestHelmHandler_InvalidRequest(t *testing.T) {
 		t.Log("No error occurred, but this is acceptable for this test")
 	}
 }
+
+// TestHelmHandler_RollbackChart 차트 롤백 API 테스트
+func TestHelmHandler_RollbackChart(t *testing.T) {
+	// 테스트용 BaseHandler 생성 (Mock 클라이언트 사용)
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	helmHandler := NewHandler(baseHandler)
+
+	// Echo 인스턴스 생성
+	e := echo.New()
+
+	// 테스트 요청 데이터
+	reqData := map[string]interface{}{
+		"kubeconfig": "apiVersion: v1\nkind: Config",
+	}
+	reqBody, _ := json.Marshal(reqData)
+
+	// HTTP 요청 생성
+	req := httptest.NewRequest(http.MethodPost, "/charts/test-chart/rollback?revision=1", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetPath("/:name/rollback")
+	c.SetParamNames("name")
+	c.SetParamValues("test-chart")
+
+	// 핸들러 실행
+	err := helmHandler.RollbackChart(c)
+
+	// 응답 검증 (실제 Helm 연결이 없으므로 에러가 발생할 수 있음)
+	if err != nil {
+		t.Logf("Expected error due to no actual Helm connection: %v", err)
+	}
+}
+
+// TestHelmHandler_RollbackChartInvalidRevision 잘못된 롤백 리비전 테스트
+func TestHelmHandler_RollbackChartInvalidRevision(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	helmHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	for _, revision := range []string{"abc", "-1"} {
+		req := httptest.NewRequest(http.MethodPost, "/charts/test-chart/rollback?revision="+revision, bytes.NewReader([]byte(`{}`)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		c.SetPath("/:name/rollback")
+		c.SetParamNames("name")
+		c.SetParamValues("test-chart")
+
+		if err := helmHandler.RollbackChart(c); err != nil {
+			t.Fatalf("RollbackChart() error = %v", err)
+		}
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("revision %q: expected status %d, got %d", revision, http.StatusBadRequest, rec.Code)
+		}
+	}
+}
+
+// testRepositoryIndex 테스트용 차트 저장소 인덱스
+const testRepositoryIndex = `apiVersion: v1
+entries:
+  nginx:
+  - name: nginx
+    version: 2.0.0-rc.1
+    description: NGINX web server
+    urls: [nginx-2.0.0-rc.1.tgz]
+  - name: nginx
+    version: 1.1.0
+    appVersion: 1.25.0
+    description: NGINX web server
+    urls: [nginx-1.1.0.tgz]
+  - name: nginx
+    version: 1.0.0
+    description: NGINX web server
+    urls: [nginx-1.0.0.tgz]
+  redis:
+  - name: redis
+    version: 3.0.0
+    description: In-memory data store
+    keywords: [cache]
+    urls: [redis-3.0.0.tgz]
+`
+
+// TestHelmHandler_RepositoryLifecycle 차트 저장소 등록/검색/해제 테스트
+func TestHelmHandler_RepositoryLifecycle(t *testing.T) {
+	// Helm 설정 경로를 임시 디렉토리로 격리
+	dir := t.TempDir()
+	t.Setenv("HELM_REPOSITORY_CONFIG", dir+"/repositories.yaml")
+	t.Setenv("HELM_REPOSITORY_CACHE", dir+"/cache")
+	t.Setenv("HELM_REGISTRY_CONFIG", dir+"/registry/config.json")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/index.yaml" {
+			http.NotFound(w, r)
+			return
+		}
+		_, _ = w.Write([]byte(testRepositoryIndex))
+	}))
+	defer server.Close()
+
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	helmHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+	call := func(method, target string, body []byte, name string, fn func(echo.Context) error) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		c := e.NewContext(req, rec)
+		if name != "" {
+			c.SetParamNames("name")
+			c.SetParamValues(name)
+		}
+		if err := fn(c); err != nil {
+			t.Fatalf("%s %s error = %v", method, target, err)
+		}
+		return rec
+	}
+
+	// 저장소 등록
+	reqBody, _ := json.Marshal(map[string]interface{}{"name": "test", "url": server.URL})
+	rec := call(http.MethodPost, "/repositories", reqBody, "", helmHandler.AddRepository)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("AddRepository: expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	// 도달할 수 없는 저장소는 등록 실패
+	reqBody, _ = json.Marshal(map[string]interface{}{"name": "broken", "url": server.URL + "/missing"})
+	if rec := call(http.MethodPost, "/repositories", reqBody, "", helmHandler.AddRepository); rec.Code != http.StatusBadRequest {
+		t.Errorf("AddRepository(unreachable): expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	// 목록 조회
+	rec = call(http.MethodGet, "/repositories", nil, "", helmHandler.GetRepositories)
+	var list struct {
+		Data struct {
+			Count        int `json:"count"`
+			Repositories []struct {
+				Name       string `json:"name"`
+				ChartCount int    `json:"chartCount"`
+			} `json:"repositories"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &list); err != nil {
+		t.Fatalf("failed to decode repository list: %v", err)
+	}
+	if list.Data.Count != 1 || list.Data.Repositories[0].Name != "test" || list.Data.Repositories[0].ChartCount != 2 {
+		t.Errorf("unexpected repository list: %s", rec.Body.String())
+	}
+
+	// 버전 제약 조건으로 검색 (정식 버전 중 최신)
+	rec = call(http.MethodGet, "/search?keyword=nginx&version=%5E1.0.0", nil, "", helmHandler.SearchCharts)
+	var search struct {
+		Data struct {
+			Charts []struct {
+				Name    string `json:"name"`
+				Version string `json:"version"`
+			} `json:"charts"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &search); err != nil {
+		t.Fatalf("failed to decode search result: %v", err)
+	}
+	if len(search.Data.Charts) != 1 || search.Data.Charts[0].Name != "test/nginx" || search.Data.Charts[0].Version != "1.1.0" {
+		t.Errorf("unexpected search result: %s", rec.Body.String())
+	}
+
+	// 키워드 검색
+	rec = call(http.MethodGet, "/search?keyword=cache", nil, "", helmHandler.SearchCharts)
+	if err := json.Unmarshal(rec.Body.Bytes(), &search); err != nil {
+		t.Fatalf("failed to decode search result: %v", err)
+	}
+	if len(search.Data.Charts) != 1 || search.Data.Charts[0].Name != "test/redis" {
+		t.Errorf("unexpected keyword search result: %s", rec.Body.String())
+	}
+
+	// 잘못된 버전 제약 조건
+	if rec := call(http.MethodGet, "/search?version=not-a-version", nil, "", helmHandler.SearchCharts); rec.Code != http.StatusBadRequest {
+		t.Errorf("SearchCharts(invalid constraint): expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	// 저장소 등록 해제
+	if rec := call(http.MethodDelete, "/repositories/test", nil, "test", helmHandler.RemoveRepository); rec.Code != http.StatusOK {
+		t.Errorf("RemoveRepository: expected status %d, got %d", http.StatusOK, rec.Code)
+	}
+	if rec := call(http.MethodDelete, "/repositories/test", nil, "test", helmHandler.RemoveRepository); rec.Code != http.StatusNotFound {
+		t.Errorf("RemoveRepository(missing): expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+}
@@ -9,14 +9,17 @@ import (
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/job"
 	"github.com/taking/kubemigrate/pkg/client"
+	helmclient "github.com/taking/kubemigrate/pkg/client/helm"
 	"github.com/taking/kubemigrate/pkg/config"
+	"helm.sh/helm/v3/pkg/release"
 	v1 "k8s.io/api/core/v1"
 )
 
 // Service : Helm 관련 비즈니스 로직 서비스
 type Service struct {
 	*handler.BaseHandler
-	jobManager job.JobManager
+	jobManager   job.JobManager
+	repositories helmclient.RepositoryManager
 }
 
 // NewService : 새로운 Helm 서비스 생성
@@ -24,10 +27,18 @@ func NewService(base *handler.BaseHandler) *Service {
 	// ConfigManager를 활용하여 워커 수 설정
 	workerCount := base.GetConfigInt("HELM_WORKER_COUNT", 5)
 
-	return &Service{
-		BaseHandler: base,
-		jobManager:  job.NewMemoryJobManagerWithWorkers(workerCount),
+	s := &Service{
+		BaseHandler:  base,
+		jobManager:   base.NewJobManager("helm", workerCount),
+		repositories: helmclient.NewRepositoryManager(),
 	}
+
+	// 재시작으로 중단된 작업 정리 (Helm 작업은 재연결하지 않음)
+	for _, interrupted := range s.jobManager.InterruptedJobs() {
+		s.jobManager.FailJob(interrupted.JobID, fmt.Errorf("job interrupted by server restart"))
+	}
+
+	return s
 }
 
 // GetChartsInternal : Helm 차트 목록 조회 (내부 로직)
@@ -219,6 +230,9 @@ func (s *Service) InstallChartAsyncInternal(client client.Client, ctx context.Co
 
 // installChartInternal : 백그라운드에서 차트 설치
 func (s *Service) installChartInternal(client client.Client, ctx context.Context, jobID string, config config.InstallChartConfig) {
+	jobCtx, cancel := s.jobManager.JobContext(jobID, s.GetConfigDuration("HELM_JOB_TIMEOUT", 15*time.Minute))
+	defer cancel()
+
 	// 1. 다운로드 단계
 	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, "Downloading chart...")
 	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Starting download of chart: %s", config.ChartURL))
@@ -232,6 +246,10 @@ func (s *Service) installChartInternal(client client.Client, ctx context.Context
 		return client.Helm().InstallChart(config.ReleaseName, config.ChartURL, config.Version, config.Namespace, config.Values)
 	})
 
+	if job.IsCancelled(jobCtx) {
+		s.compensateInstall(client, jobID, config.ReleaseName, config.Namespace)
+		return
+	}
 	if err != nil {
 		s.jobManager.FailJob(jobID, err)
 		return
@@ -242,7 +260,11 @@ func (s *Service) installChartInternal(client client.Client, ctx context.Context
 	s.jobManager.AddJobLog(jobID, "Install command executed, verifying completion...")
 
 	// 5. 완료 대기 (최대 5분)
-	if err := s.waitForInstallComplete(client, config.ReleaseName, config.Namespace, jobID, 5*time.Minute); err != nil {
+	if err := s.waitForInstallComplete(client, jobCtx, config.ReleaseName, config.Namespace, jobID, 5*time.Minute); err != nil {
+		if job.IsCancelled(jobCtx) {
+			s.compensateInstall(client, jobID, config.ReleaseName, config.Namespace)
+			return
+		}
 		s.jobManager.FailJob(jobID, err)
 		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Install verification failed: %s", err.Error()))
 		return
@@ -296,10 +318,20 @@ func (s *Service) UpgradeChartAsyncInternal(client client.Client, ctx context.Co
 
 // upgradeChartInternal : 백그라운드에서 차트 업그레이드
 func (s *Service) upgradeChartInternal(client client.Client, ctx context.Context, jobID string, config config.UpgradeChartConfig) {
+	jobCtx, cancel := s.jobManager.JobContext(jobID, s.GetConfigDuration("HELM_JOB_TIMEOUT", 15*time.Minute))
+	defer cancel()
+
 	// 1. 업그레이드 준비 단계
 	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, "Preparing upgrade...")
 	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Starting upgrade of chart: %s", config.ReleaseName))
 
+	// 취소 시 롤백할 현재 리비전 기록
+	previousRevision := 0
+	if installed, rel, err := client.Helm().IsChartInstalled(config.ReleaseName); err == nil && installed && rel != nil {
+		previousRevision = rel.Version
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Current revision: %d", previousRevision))
+	}
+
 	// 2. 업그레이드 실행 단계
 	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 50, "Upgrading chart...")
 	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Upgrading chart %s in namespace %s", config.ReleaseName, config.Namespace))
@@ -309,6 +341,10 @@ func (s *Service) upgradeChartInternal(client client.Client, ctx context.Context
 		return client.Helm().UpgradeChart(config.ReleaseName, config.ChartPath, "", config.Namespace, config.Values)
 	})
 
+	if job.IsCancelled(jobCtx) {
+		s.compensateUpgrade(client, jobID, config.ReleaseName, config.Namespace, previousRevision)
+		return
+	}
 	if err != nil {
 		s.jobManager.FailJob(jobID, err)
 		return
@@ -319,7 +355,11 @@ func (s *Service) upgradeChartInternal(client client.Client, ctx context.Context
 	s.jobManager.AddJobLog(jobID, "Upgrade command executed, verifying completion...")
 
 	// 5. 완료 대기 (최대 5분)
-	if err := s.waitForUpgradeComplete(client, config.ReleaseName, config.Namespace, jobID, 5*time.Minute); err != nil {
+	if err := s.waitForUpgradeComplete(client, jobCtx, config.ReleaseName, config.Namespace, jobID, 5*time.Minute); err != nil {
+		if job.IsCancelled(jobCtx) {
+			s.compensateUpgrade(client, jobID, config.ReleaseName, config.Namespace, previousRevision)
+			return
+		}
 		s.jobManager.FailJob(jobID, err)
 		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Upgrade verification failed: %s", err.Error()))
 		return
@@ -341,6 +381,103 @@ func (s *Service) upgradeChartInternal(client client.Client, ctx context.Context
 	s.jobManager.AddJobLog(jobID, "Upgrade completed successfully")
 }
 
+// RollbackChartAsyncInternal : Helm 차트 비동기 롤백 (내부 로직)
+func (s *Service) RollbackChartAsyncInternal(client client.Client, ctx context.Context, config config.RollbackChartConfig) (interface{}, error) {
+	// Job ID 생성
+	jobID := fmt.Sprintf("rollback-%d", time.Now().UnixNano())
+
+	// Job 생성
+	metadata := map[string]interface{}{
+		"releaseName": config.ReleaseName,
+		"namespace":   config.Namespace,
+		"revision":    config.Revision,
+	}
+
+	job := s.jobManager.CreateJob(jobID, metadata)
+
+	// 백그라운드에서 롤백 시작
+	go s.rollbackChartInternal(client, ctx, jobID, config)
+
+	// 즉시 응답 반환
+	return map[string]interface{}{
+		"status":    "processing",
+		"jobId":     jobID,
+		"message":   "Chart rollback started",
+		"statusUrl": fmt.Sprintf("/api/v1/helm/charts/status/%s", jobID),
+		"logsUrl":   fmt.Sprintf("/api/v1/helm/charts/logs/%s", jobID),
+		"job":       job,
+	}, nil
+}
+
+// rollbackChartInternal : 백그라운드에서 차트 롤백
+func (s *Service) rollbackChartInternal(client client.Client, ctx context.Context, jobID string, config config.RollbackChartConfig) {
+	jobCtx, cancel := s.jobManager.JobContext(jobID, s.GetConfigDuration("HELM_JOB_TIMEOUT", 15*time.Minute))
+	defer cancel()
+
+	// 1. 롤백 준비 단계 (현재 리비전 확인)
+	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, "Preparing rollback...")
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Starting rollback of chart: %s", config.ReleaseName))
+
+	current, err := client.Helm().GetChart(jobCtx, config.ReleaseName, config.Namespace, 0)
+	if err != nil {
+		s.jobManager.FailJob(jobID, fmt.Errorf("failed to get release '%s': %w", config.ReleaseName, err))
+		return
+	}
+	if config.Revision != 0 && config.Revision >= current.Version {
+		s.jobManager.FailJob(jobID, fmt.Errorf("revision %d is not older than the current revision %d", config.Revision, current.Version))
+		return
+	}
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Current revision: %d", current.Version))
+
+	// 2. 롤백 실행 단계
+	target := "previous revision"
+	if config.Revision != 0 {
+		target = fmt.Sprintf("revision %d", config.Revision)
+	}
+	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 50, "Rolling back chart...")
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Rolling back chart %s in namespace %s to %s", config.ReleaseName, config.Namespace, target))
+
+	// 3. 실제 롤백 실행 (리소스 Ready 대기 포함, 재시도 없음 - 실패한 롤백도 새 리비전을 남김)
+	if err := client.Helm().RollbackChart(jobCtx, config.ReleaseName, config.Namespace, config.Revision); err != nil {
+		s.jobManager.FailJob(jobID, err)
+		return
+	}
+
+	// 4. 완료 확인 (백그라운드에서 폴링)
+	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 70, "Verifying rollback...")
+	s.jobManager.AddJobLog(jobID, "Rollback command executed, verifying completion...")
+
+	// 5. 완료 대기 (최대 5분)
+	rel, err := s.waitForRollbackComplete(client, jobCtx, config.ReleaseName, config.Namespace, jobID, current.Version, 5*time.Minute)
+	if err != nil {
+		if job.IsCancelled(jobCtx) {
+			// 롤백은 이미 적용되었으므로 대기만 중단
+			s.jobManager.AddJobLog(jobID, "Stopped waiting for rollback; the rollback itself is not reverted")
+			return
+		}
+		s.jobManager.FailJob(jobID, err)
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Rollback verification failed: %s", err.Error()))
+		return
+	}
+
+	// 6. 최종 완료
+	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 90, "Rollback completed successfully")
+	s.jobManager.AddJobLog(jobID, "Chart rolled back successfully")
+
+	// 7. 결과 저장
+	result := map[string]interface{}{
+		"releaseName":      config.ReleaseName,
+		"namespace":        config.Namespace,
+		"previousRevision": current.Version,
+		"revision":         rel.Version,
+		"status":           "rolled back",
+		"rolledBackAt":     time.Now().Format(time.RFC3339),
+	}
+
+	s.jobManager.CompleteJob(jobID, result)
+	s.jobManager.AddJobLog(jobID, "Rollback completed successfully")
+}
+
 // UninstallChartAsyncInternal : Helm 차트 비동기 제거 (내부 로직)
 func (s *Service) UninstallChartAsyncInternal(client client.Client, ctx context.Context, releaseName, namespace string, dryRun bool) (interface{}, error) {
 	// Job ID 생성
@@ -371,6 +508,9 @@ func (s *Service) UninstallChartAsyncInternal(client client.Client, ctx context.
 
 // uninstallChartInternal : 백그라운드에서 차트 제거
 func (s *Service) uninstallChartInternal(client client.Client, ctx context.Context, jobID, releaseName, namespace string, dryRun bool) {
+	jobCtx, cancel := s.jobManager.JobContext(jobID, s.GetConfigDuration("HELM_JOB_TIMEOUT", 15*time.Minute))
+	defer cancel()
+
 	// 1. 제거 준비 단계
 	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, "Preparing uninstall...")
 	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Starting uninstall of chart: %s", releaseName))
@@ -394,7 +534,12 @@ func (s *Service) uninstallChartInternal(client client.Client, ctx context.Conte
 	s.jobManager.AddJobLog(jobID, "Uninstall command executed, verifying completion...")
 
 	// 5. 완료 대기 (최대 5분)
-	if err := s.waitForUninstallComplete(client, releaseName, namespace, jobID, 5*time.Minute); err != nil {
+	if err := s.waitForUninstallComplete(client, jobCtx, releaseName, namespace, jobID, 5*time.Minute); err != nil {
+		if job.IsCancelled(jobCtx) {
+			// 제거는 되돌릴 수 없으므로 대기만 중단
+			s.jobManager.AddJobLog(jobID, "Stopped waiting for uninstall; already removed resources are not restored")
+			return
+		}
 		s.jobManager.FailJob(jobID, err)
 		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Uninstall verification failed: %s", err.Error()))
 		return
@@ -418,14 +563,16 @@ func (s *Service) uninstallChartInternal(client client.Client, ctx context.Conte
 }
 
 // waitForUninstallComplete : Uninstall 완료 대기 (Kubernetes 리소스 기반 확인)
-func (s *Service) waitForUninstallComplete(client client.Client, releaseName, namespace, jobID string, timeout time.Duration) error {
+func (s *Service) waitForUninstallComplete(client client.Client, ctx context.Context, releaseName, namespace, jobID string, timeout time.Duration) error {
 	ticker := time.NewTicker(3 * time.Second)
 	defer ticker.Stop()
 
 	deadline := time.Now().Add(timeout)
 
-	for { //nolint:gosimple
+	for {
 		select {
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-ticker.C:
 			// 1. Helm Release 확인
 			installed, _, err := client.Helm().IsChartInstalled(releaseName)
@@ -495,14 +642,16 @@ func (s *Service) isReleaseResourcesRemoved(client client.Client, releaseName, n
 }
 
 // waitForInstallComplete : Install 완료 대기 (Kubernetes 리소스 기반 확인)
-func (s *Service) waitForInstallComplete(client client.Client, releaseName, namespace, jobID string, timeout time.Duration) error {
+func (s *Service) waitForInstallComplete(client client.Client, ctx context.Context, releaseName, namespace, jobID string, timeout time.Duration) error {
 	ticker := time.NewTicker(3 * time.Second)
 	defer ticker.Stop()
 
 	deadline := time.Now().Add(timeout)
 
-	for { //nolint:gosimple
+	for {
 		select {
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-ticker.C:
 			// 1. Helm Release 확인
 			installed, _, err := client.Helm().IsChartInstalled(releaseName)
@@ -537,14 +686,16 @@ func (s *Service) waitForInstallComplete(client client.Client, releaseName, name
 }
 
 // waitForUpgradeComplete : Upgrade 완료 대기 (Kubernetes 리소스 기반 확인)
-func (s *Service) waitForUpgradeComplete(client client.Client, releaseName, namespace, jobID string, timeout time.Duration) error {
+func (s *Service) waitForUpgradeComplete(client client.Client, ctx context.Context, releaseName, namespace, jobID string, timeout time.Duration) error {
 	ticker := time.NewTicker(3 * time.Second)
 	defer ticker.Stop()
 
 	deadline := time.Now().Add(timeout)
 
-	for { //nolint:gosimple
+	for {
 		select {
+		case <-ctx.Done():
+			return ctx.Err()
 		case <-ticker.C:
 			// 1. Helm Release 확인
 			installed, _, err := client.Helm().IsChartInstalled(releaseName)
@@ -578,6 +729,46 @@ func (s *Service) waitForUpgradeComplete(client client.Client, releaseName, name
 	}
 }
 
+// waitForRollbackComplete : Rollback 완료 대기 (새 리비전이 deployed 상태가 될 때까지)
+func (s *Service) waitForRollbackComplete(client client.Client, ctx context.Context, releaseName, namespace, jobID string, previousRevision int, timeout time.Duration) (*release.Release, error) {
+	ticker := time.NewTicker(3 * time.Second)
+	defer ticker.Stop()
+
+	deadline := time.Now().Add(timeout)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+			// 1. 최신 리비전 확인
+			rel, err := client.Helm().GetChart(ctx, releaseName, namespace, 0)
+			if err != nil {
+				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Error checking chart status: %s", err.Error()))
+				continue
+			}
+
+			// 2. 롤백으로 생성된 리비전 상태 확인
+			if rel.Version > previousRevision && rel.Info != nil {
+				switch rel.Info.Status {
+				case release.StatusDeployed:
+					s.jobManager.AddJobLog(jobID, fmt.Sprintf("Revision %d deployed", rel.Version))
+					return rel, nil
+				case release.StatusFailed:
+					return nil, fmt.Errorf("rollback revision %d failed: %s", rel.Version, rel.Info.Description)
+				}
+			}
+
+			// 3. 타임아웃 확인
+			if time.Now().After(deadline) {
+				return nil, fmt.Errorf("rollback timeout after %v", timeout)
+			}
+
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Rollback in progress... (remaining: %v)", time.Until(deadline).Round(time.Second)))
+		}
+	}
+}
+
 // isReleaseResourcesInstalled : Kubernetes 리소스 기반으로 Release 설치 확인
 func (s *Service) isReleaseResourcesInstalled(client client.Client, releaseName, namespace string) (bool, error) {
 	ctx := context.Background()
@@ -638,6 +829,143 @@ func (s *Service) GetJobLogsInternal(jobID string) (interface{}, error) {
 	}, nil
 }
 
+// CancelJobInternal : 진행 중인 작업 취소 (내부 로직)
+func (s *Service) CancelJobInternal(jobID string) (interface{}, error) {
+	if err := s.jobManager.CancelJob(jobID); err != nil {
+		return nil, err
+	}
+
+	job, _ := s.jobManager.GetJob(jobID)
+	return job, nil
+}
+
+// compensateInstall : 취소된 설치 작업의 릴리스 제거
+func (s *Service) compensateInstall(client client.Client, jobID, releaseName, namespace string) {
+	installed, _, err := client.Helm().IsChartInstalled(releaseName)
+	if err != nil {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Warning: failed to check release after cancellation: %v", err))
+		return
+	}
+	if !installed {
+		s.jobManager.AddJobLog(jobID, "Release was not installed, nothing to clean up")
+		return
+	}
+
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Uninstalling release %s after cancellation", releaseName))
+	if err := client.Helm().UninstallChart(releaseName, namespace, false); err != nil {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Warning: failed to uninstall release after cancellation: %v", err))
+		return
+	}
+	s.jobManager.AddJobLog(jobID, "Release uninstalled after cancellation")
+}
+
+// compensateUpgrade : 취소된 업그레이드 작업을 이전 리비전으로 롤백
+func (s *Service) compensateUpgrade(client client.Client, jobID, releaseName, namespace string, previousRevision int) {
+	if previousRevision == 0 {
+		s.jobManager.AddJobLog(jobID, "Warning: previous revision unknown, skipping rollback")
+		return
+	}
+
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Rolling back release %s to revision %d after cancellation", releaseName, previousRevision))
+
+	// 작업 컨텍스트는 이미 취소되었으므로 보상 롤백은 별도 기한으로 실행
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+	if err := client.Helm().RollbackChart(ctx, releaseName, namespace, previousRevision); err != nil {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Warning: failed to rollback release after cancellation: %v", err))
+		return
+	}
+	s.jobManager.AddJobLog(jobID, "Release rolled back after cancellation")
+}
+
+// GetRepositoriesInternal : 등록된 차트 저장소 목록 조회 (내부 로직)
+func (s *Service) GetRepositoriesInternal() (interface{}, error) {
+	repositories, err := s.repositories.ListRepositories()
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"repositories": repositories,
+		"count":        len(repositories),
+	}, nil
+}
+
+// AddRepositoryInternal : 차트 저장소 등록 (내부 로직)
+func (s *Service) AddRepositoryInternal(entry helmclient.RepositoryEntry) (interface{}, error) {
+	return s.repositories.AddRepository(entry)
+}
+
+// UpdateRepositoriesInternal : 차트 저장소 인덱스 갱신 (내부 로직)
+func (s *Service) UpdateRepositoriesInternal(names []string) (interface{}, error) {
+	repositories, err := s.repositories.UpdateRepositories(names...)
+	if err != nil {
+		return nil, err
+	}
+
+	failed := 0
+	for _, repository := range repositories {
+		if repository.Error != "" {
+			failed++
+		}
+	}
+
+	return map[string]interface{}{
+		"repositories": repositories,
+		"count":        len(repositories),
+		"failed":       failed,
+	}, nil
+}
+
+// RemoveRepositoryInternal : 차트 저장소 등록 해제 (내부 로직)
+func (s *Service) RemoveRepositoryInternal(name string) (interface{}, error) {
+	if err := s.repositories.RemoveRepository(name); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"name":   name,
+		"status": "removed",
+	}, nil
+}
+
+// SearchChartsInternal : 등록된 저장소에서 차트 검색 (내부 로직)
+func (s *Service) SearchChartsInternal(keyword, version string, allVersions bool) (interface{}, error) {
+	charts, err := s.repositories.SearchCharts(keyword, version, allVersions)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"charts": charts,
+		"count":  len(charts),
+	}, nil
+}
+
+// LoginRegistryInternal : OCI 레지스트리 로그인 (내부 로직)
+func (s *Service) LoginRegistryInternal(host, username, password string, insecure bool) (interface{}, error) {
+	if err := s.repositories.LoginRegistry(host, username, password, insecure); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"host":   host,
+		"status": "logged in",
+	}, nil
+}
+
+// LogoutRegistryInternal : OCI 레지스트리 로그아웃 (내부 로직)
+func (s *Service) LogoutRegistryInternal(host string) (interface{}, error) {
+	if err := s.repositories.LogoutRegistry(host); err != nil {
+		return nil, err
+	}
+
+	return map[string]interface{}{
+		"host":   host,
+		"status": "logged out",
+	}, nil
+}
+
 // GetAllJobsInternal : 모든 작업 조회 (내부 로직)
 func (s *Service) GetAllJobsInternal() (interface{}, error) {
 	jobs := s.jobManager.GetAllJobs()
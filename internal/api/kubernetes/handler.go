@@ -3,11 +3,16 @@ package kubernetes
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/labstack/echo/v4"
 	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/response"
 	"github.com/taking/kubemigrate/pkg/client"
+	k8sclient "github.com/taking/kubemigrate/pkg/client/kubernetes"
 	"github.com/taking/kubemigrate/pkg/types"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // Handler : Kubernetes 관련 HTTP 핸들러
@@ -52,41 +57,73 @@ func (h *Handler) HealthCheck(c echo.Context) error {
 
 // GetResources : Kubernetes 리소스 조회
 // @Summary Get Kubernetes Resources
-// @Description Get Kubernetes resources by kind, name (optional) and namespace
+// @Description Get any Kubernetes resource kind (including CRDs) resolved through API discovery. Secret data, stringData and the last-applied-configuration annotation are removed for the viewer role.
 // @Tags kubernetes
 // @Accept json
-// @Produce json
+// @Produce json,application/yaml
 // @Param request body config.KubeConfig true "Kubernetes configuration"
-// @Param kind path string true "Resource kind (pods, configmaps, secrets, storage-classes)"
+// @Param kind path string true "Resource kind: plural, singular, Kind, short name or resource.group (e.g. pods, deploy, certificates.cert-manager.io)"
 // @Param name path string false "Resource name (empty for list, specific name for single resource)"
 // @Param namespace query string false "Namespace name (default: 'default', all namespaces: 'all')"
+// @Param labelSelector query string false "Label selector (e.g. app=nginx,tier!=cache)"
+// @Param fieldSelector query string false "Field selector (e.g. status.phase=Running)"
+// @Param limit query int false "Page size for list requests"
+// @Param continue query string false "Continue token from the previous page (metadata.continue)"
+// @Param output query string false "Response format: json (default) or yaml"
 // @Success 200 {object} response.SuccessResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /v1/kubernetes/{kind}/{name} [get]
 func (h *Handler) GetResources(c echo.Context) error {
-	return h.HandleResourceClient(c, "resources", func(client client.Client, ctx context.Context) (interface{}, error) {
+	query, err := h.resolveResourceQuery(c)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_QUERY", "Invalid resource query", err.Error())
+	}
+
+	if output := c.QueryParam("output"); output != "" && output != "json" && output != "yaml" {
+		return response.RespondWithErrorModel(c, 400, "INVALID_QUERY", "Invalid resource query", fmt.Sprintf("unsupported output format: %s", output))
+	}
+
+	return h.HandleManifestClient(c, "resources", func(client client.Client, ctx context.Context) (interface{}, error) {
 		// 네임스페이스 결정
 		// "all"이면 모든 네임스페이스 조회,""이면 3번째 파라미터 값을 네임스페이스로 사용
 		namespace := h.ResolveNamespace(c, "default")
 
-		// GET 요청에서는 body 바인딩 없이 query parameter만 사용
-		// 리소스 종류, 이름, 네임스페이스 결정
+		// 리소스 종류와 이름 (discovery로 종류 해석)
 		kind := c.Param("kind")
 		name := c.Param("name")
 
-		// 클라이언트 통합 메서드 사용
-		switch kind {
-		case "pods":
-			return client.Kubernetes().GetPods(ctx, namespace, name)
-		case "configmaps":
-			return client.Kubernetes().GetConfigMaps(ctx, namespace, name)
-		case "secrets":
-			return client.Kubernetes().GetSecrets(ctx, namespace, name)
-		case "storage-classes":
-			return client.Kubernetes().GetStorageClasses(ctx, name)
-		default:
-			return nil, fmt.Errorf("unsupported resource kind: %s", kind)
+		resources, err := client.Kubernetes().GetResources(ctx, kind, namespace, name, query)
+		if err != nil || h.CanReadSecretData(c) {
+			return resources, err
 		}
+		// viewer에게는 Secret 데이터를 제외하고 응답
+		return handler.RedactSecretData(resources), nil
 	})
 }
+
+// resolveResourceQuery : 셀렉터 및 페이지네이션 쿼리 파라미터 검증
+func (h *Handler) resolveResourceQuery(c echo.Context) (k8sclient.ResourceQuery, error) {
+	query := k8sclient.ResourceQuery{
+		LabelSelector: c.QueryParam("labelSelector"),
+		FieldSelector: c.QueryParam("fieldSelector"),
+		Continue:      c.QueryParam("continue"),
+	}
+
+	if _, err := labels.Parse(query.LabelSelector); err != nil {
+		return query, fmt.Errorf("invalid labelSelector: %w", err)
+	}
+	if _, err := fields.ParseSelector(query.FieldSelector); err != nil {
+		return query, fmt.Errorf("invalid fieldSelector: %w", err)
+	}
+
+	if limit := c.QueryParam("limit"); limit != "" {
+		value, err := strconv.ParseInt(limit, 10, 64)
+		if err != nil || value < 0 {
+			return query, fmt.Errorf("invalid limit: %s", limit)
+		}
+		query.Limit = value
+	}
+
+	return query, nil
+}
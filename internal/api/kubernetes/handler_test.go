@@ -5,11 +5,14 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/auth"
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/middleware"
 )
 
 // TestKubernetesHandler_HealthCheck 헬스체크 API 테스트
@@ -189,3 +192,140 @@ func TestKubernetesHandler_GetResources_QueryParams(t *testing.T) {
 		})
 	}
 }
+
+// TestKubernetesHandler_GetResources_DynamicQuery 셀렉터, 페이지네이션, YAML 출력 테스트
+func TestKubernetesHandler_GetResources_DynamicQuery(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	kubernetesHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	testCases := []struct {
+		name           string
+		kind           string
+		query          string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "페이지네이션 continue 토큰",
+			kind:           "deployments.apps",
+			query:          "?limit=1&labelSelector=app%3Dnginx",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "mock-continue-token",
+		},
+		{
+			name:           "YAML 출력",
+			kind:           "pods",
+			query:          "?output=yaml",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "kind: PodList",
+		},
+		{
+			name:           "지원하지 않는 리소스",
+			kind:           "unsupported",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "UNSUPPORTED_RESOURCE",
+		},
+		{
+			name:           "잘못된 라벨 셀렉터",
+			kind:           "pods",
+			query:          "?labelSelector=app%3D%3D%3Dx",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_QUERY",
+		},
+		{
+			name:           "잘못된 limit",
+			kind:           "pods",
+			query:          "?limit=-1",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_QUERY",
+		},
+		{
+			name:           "지원하지 않는 출력 형식",
+			kind:           "pods",
+			query:          "?output=xml",
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   "INVALID_QUERY",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reqBody, _ := json.Marshal(map[string]interface{}{
+				"kubeconfig": "apiVersion: v1\nkind: Config",
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/kubernetes/"+tc.kind+tc.query, bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetPath("/api/v1/kubernetes/:kind")
+			c.SetParamNames("kind")
+			c.SetParamValues(tc.kind)
+
+			if err := kubernetesHandler.GetResources(c); err != nil {
+				t.Fatalf("GetResources() error = %v", err)
+			}
+			if rec.Code != tc.expectedStatus {
+				t.Errorf("Expected status %d, got %d: %s", tc.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tc.expectedBody) {
+				t.Errorf("Expected body to contain %q, got %s", tc.expectedBody, rec.Body.String())
+			}
+		})
+	}
+}
+
+// roleAuthenticator : 고정 역할의 주체를 반환하는 테스트용 인증기
+type roleAuthenticator auth.Role
+
+func (a roleAuthenticator) Authenticate(*http.Request) (*auth.Principal, error) {
+	return &auth.Principal{Subject: "test", Role: auth.Role(a), Method: "token"}, nil
+}
+
+// TestKubernetesHandler_GetResources_SecretData viewer에게 Secret 데이터를 제외하고 응답하는지 테스트
+func TestKubernetesHandler_GetResources_SecretData(t *testing.T) {
+	baseHandler := handler.NewBaseHandlerWithMock(nil)
+	kubernetesHandler := NewHandler(baseHandler)
+
+	tests := []struct {
+		role       auth.Role
+		expectData bool
+	}{
+		{role: auth.RoleViewer, expectData: false},
+		{role: auth.RoleOperator, expectData: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role), func(t *testing.T) {
+			body, _ := json.Marshal(map[string]string{"kubeconfig": "apiVersion: v1\nkind: Config"})
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/kubernetes/secrets/test-secret?namespace=app", bytes.NewReader(body))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			c.SetPath("/api/v1/kubernetes/:kind/:name")
+			c.SetParamNames("kind", "name")
+			c.SetParamValues("secrets", "test-secret")
+
+			handle := middleware.Authenticate(roleAuthenticator(tt.role))(kubernetesHandler.GetResources)
+			if err := handle(c); err != nil {
+				t.Fatalf("GetResources() error = %v", err)
+			}
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+			}
+
+			responseBody := rec.Body.String()
+			hasData := strings.Contains(responseBody, `"password"`)
+			if hasData != tt.expectData {
+				t.Errorf("Expected secret data present = %v, got body %s", tt.expectData, responseBody)
+			}
+			if !tt.expectData && strings.Contains(responseBody, "last-applied-configuration") {
+				t.Errorf("Expected last-applied annotation to be removed for viewer, got %s", responseBody)
+			}
+		})
+	}
+}
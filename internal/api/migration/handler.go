@@ -0,0 +1,230 @@
+package migration
+
+import (
+	"errors"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/response"
+	"github.com/taking/kubemigrate/pkg/types"
+)
+
+// Handler : 마이그레이션 관련 HTTP 핸들러
+type Handler struct {
+	*handler.BaseHandler
+	service *Service
+}
+
+// NewHandler : 새로운 마이그레이션 핸들러 생성
+func NewHandler(base *handler.BaseHandler) *Handler {
+	return &Handler{
+		BaseHandler: base,
+		service:     NewService(base),
+	}
+}
+
+// StartMigration : 클러스터 간 마이그레이션 시작
+// @Summary Start Cluster Migration
+// @Description Install Velero on both clusters, back up the source, wait for the backup to sync and restore it on the target
+// @Tags migration
+// @Accept json
+// @Produce json
+// @Param request body types.MigrationRequest true "Migration request"
+// @Success 200 {object} types.MigrationResult "Migration started"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Registered cluster/storage not found"
+// @Router /v1/migrations [post]
+func (h *Handler) StartMigration(c echo.Context) error {
+	var req types.MigrationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+
+	// 등록된 클러스터/스토리지 참조
+	if err := h.resolveMigrationRefs(&req); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
+	result, err := h.service.StartMigrationInternal(req)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_MIGRATION_REQUEST", "Invalid migration request", err.Error())
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// AnalyzeCompatibility : 마이그레이션 사전 호환성 분석
+// @Summary Analyze Migration Compatibility
+// @Description Compare source and target clusters before a migration: version skew, removed/deprecated API versions in use, missing CRDs, StorageClasses/CSI drivers and node capacity. Errors block the restore, warnings need review.
+// @Tags migration
+// @Accept json
+// @Produce json
+// @Param request body types.CompatibilityRequest true "Compatibility analysis request"
+// @Success 200 {object} types.CompatibilityReport "Compatibility report"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Registered cluster not found"
+// @Failure 503 {object} map[string]interface{} "Cluster unreachable"
+// @Router /v1/migrations/analyze [post]
+func (h *Handler) AnalyzeCompatibility(c echo.Context) error {
+	var req types.CompatibilityRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+
+	// 등록된 클러스터 참조
+	if err := h.ResolveClusterRef(req.SourceClusterID, &req.Source); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+	if err := h.ResolveClusterRef(req.TargetClusterID, &req.Target); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
+	if err := h.service.ValidateCompatibilityRequest(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_ANALYZE_REQUEST", "Invalid compatibility analysis request", err.Error())
+	}
+
+	result, err := h.service.AnalyzeCompatibilityInternal(c.Request().Context(), req)
+	if err != nil {
+		return h.HandleConnectionError(c, "migration", "analyze compatibility", err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// GetMigrations : 모든 마이그레이션 조회
+// @Summary Get Migrations
+// @Description Get all migrations and their step status
+// @Tags migration
+// @Produce json
+// @Success 200 {object} map[string]interface{} "All migrations"
+// @Router /v1/migrations [get]
+func (h *Handler) GetMigrations(c echo.Context) error {
+	result, err := h.service.GetMigrationsInternal()
+	if err != nil {
+		return response.RespondWithErrorModel(c, 500, "MIGRATIONS_FETCH_FAILED", "Failed to get migrations", err.Error())
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// GetMigration : 마이그레이션 상태 조회
+// @Summary Get Migration
+// @Description Get the status, logs and per-step progress of a migration
+// @Tags migration
+// @Produce json
+// @Param migrationId path string true "Migration ID"
+// @Success 200 {object} map[string]interface{} "Migration status"
+// @Failure 404 {object} map[string]interface{} "Migration not found"
+// @Router /v1/migrations/{migrationId} [get]
+func (h *Handler) GetMigration(c echo.Context) error {
+	migrationID := c.Param("migrationId")
+	if migrationID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "migrationId is required", "")
+	}
+
+	result, err := h.service.GetMigrationInternal(migrationID)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 404, "MIGRATION_NOT_FOUND", err.Error(), "")
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// StreamMigration : 마이그레이션 진행 상황 실시간 스트리밍 (Server-Sent Events)
+// @Summary Stream Migration Progress
+// @Description Stream migration status and log lines as Server-Sent Events. Resume with the Last-Event-ID header.
+// @Tags migration
+// @Produce text/event-stream
+// @Param migrationId path string true "Migration ID"
+// @Param Last-Event-ID header string false "Number of log lines already received"
+// @Success 200 {string} string "Event stream"
+// @Failure 404 {object} map[string]interface{} "Migration not found"
+// @Router /v1/migrations/{migrationId}/stream [get]
+func (h *Handler) StreamMigration(c echo.Context) error {
+	migrationID := c.Param("migrationId")
+	if migrationID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "migrationId is required", "")
+	}
+
+	return h.BaseHandler.StreamJob(c, h.service.jobManager, migrationID)
+}
+
+// CancelMigration : 진행 중인 마이그레이션 취소
+// @Summary Cancel Migration
+// @Description Cancel a running migration. A restore in progress is deleted; resources already restored are kept.
+// @Tags migration
+// @Produce json
+// @Param migrationId path string true "Migration ID"
+// @Success 200 {object} map[string]interface{} "Cancelled migration"
+// @Failure 404 {object} map[string]interface{} "Migration not found"
+// @Failure 409 {object} map[string]interface{} "Migration already finished"
+// @Router /v1/migrations/{migrationId}/cancel [post]
+func (h *Handler) CancelMigration(c echo.Context) error {
+	migrationID := c.Param("migrationId")
+	if migrationID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "migrationId is required", "")
+	}
+
+	result, err := h.service.CancelMigrationInternal(migrationID)
+	if err != nil {
+		return h.HandleJobCancelError(c, err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// ResumeMigration : 실패한 마이그레이션 재개
+// @Summary Resume Migration
+// @Description Resume a failed migration from its first incomplete step. The original request body must be sent again.
+// @Tags migration
+// @Accept json
+// @Produce json
+// @Param migrationId path string true "Migration ID"
+// @Param request body types.MigrationRequest true "Original migration request"
+// @Success 200 {object} types.MigrationResult "Migration resumed"
+// @Failure 400 {object} map[string]interface{} "Bad request"
+// @Failure 404 {object} map[string]interface{} "Migration not found"
+// @Failure 409 {object} map[string]interface{} "Migration not resumable or request differs from the original"
+// @Router /v1/migrations/{migrationId}/resume [post]
+func (h *Handler) ResumeMigration(c echo.Context) error {
+	migrationID := c.Param("migrationId")
+	if migrationID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "migrationId is required", "")
+	}
+
+	var req types.MigrationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+
+	// 등록된 클러스터/스토리지 참조
+	if err := h.resolveMigrationRefs(&req); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
+	result, err := h.service.ResumeMigrationInternal(migrationID, req)
+	switch {
+	case err == nil:
+		return response.RespondWithData(c, 200, result)
+	case errors.Is(err, job.ErrJobNotFound):
+		return response.RespondWithErrorModel(c, 404, "MIGRATION_NOT_FOUND", err.Error(), "")
+	case errors.Is(err, ErrMigrationNotResumable):
+		return response.RespondWithErrorModel(c, 409, "MIGRATION_NOT_RESUMABLE", err.Error(), "")
+	case errors.Is(err, ErrMigrationMismatch):
+		return response.RespondWithErrorModel(c, 409, "MIGRATION_MISMATCH", err.Error(), "")
+	default:
+		return response.RespondWithErrorModel(c, 400, "INVALID_MIGRATION_REQUEST", "Invalid migration request", err.Error())
+	}
+}
+
+// resolveMigrationRefs : 등록 ID(sourceClusterId/targetClusterId/storageId)로 원본·대상 kubeconfig와 MinIO 설정을 채움
+func (h *Handler) resolveMigrationRefs(req *types.MigrationRequest) error {
+	if err := h.ResolveClusterRef(req.SourceClusterID, &req.Source); err != nil {
+		return err
+	}
+	if err := h.ResolveClusterRef(req.TargetClusterID, &req.Target); err != nil {
+		return err
+	}
+	return h.ResolveStorageRef(req.StorageID, &req.MinioConfig)
+}
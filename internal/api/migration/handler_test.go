@@ -0,0 +1,294 @@
+package migration
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/registry"
+	"github.com/taking/kubemigrate/internal/validator"
+	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
+)
+
+// TestMigrationHandler_StartMigrationInvalid 잘못된 마이그레이션 요청 테스트
+func TestMigrationHandler_StartMigrationInvalid(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	migrationHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	// includeNamespaces 누락
+	reqBody, _ := json.Marshal(map[string]interface{}{
+		"name":   "app-migration",
+		"source": map[string]interface{}{"kubeconfig": "apiVersion: v1\nkind: Config"},
+		"target": map[string]interface{}{"kubeconfig": "apiVersion: v1\nkind: Config"},
+		"minio": map[string]interface{}{
+			"endpoint":  "localhost:9000",
+			"accessKey": "minioadmin",
+			"secretKey": "minioadmin123",
+		},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/migrations", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if err := migrationHandler.StartMigration(c); err != nil {
+		t.Fatalf("StartMigration() error = %v", err)
+	}
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+	if len(migrationHandler.service.jobManager.GetAllJobs()) != 0 {
+		t.Error("Expected no migration job to be created for an invalid request")
+	}
+}
+
+// TestMigrationHandler_AnalyzeCompatibilityInvalid 잘못된 호환성 분석 요청 테스트
+func TestMigrationHandler_AnalyzeCompatibilityInvalid(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	migrationHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	// target kubeconfig 누락
+	reqBody, _ := json.Marshal(map[string]interface{}{
+		"source":            map[string]interface{}{"kubeconfig": "apiVersion: v1\nkind: Config"},
+		"includeNamespaces": []string{"app"},
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/migrations/analyze", bytes.NewReader(reqBody))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if err := migrationHandler.AnalyzeCompatibility(c); err != nil {
+		t.Fatalf("AnalyzeCompatibility() error = %v", err)
+	}
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+}
+
+// TestMigrationHandler_ResumeMigrationNotFound 존재하지 않는 마이그레이션 재개 테스트
+func TestMigrationHandler_ResumeMigrationNotFound(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	migrationHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/migrations/missing/resume", bytes.NewReader([]byte(`{"name":"app-migration"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("migrationId")
+	c.SetParamValues("missing")
+
+	if err := migrationHandler.ResumeMigration(c); err != nil {
+		t.Fatalf("ResumeMigration() error = %v", err)
+	}
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+}
+
+// TestMigrationHandler_ResumeMigrationMismatch 최초 요청과 다른 재개 요청 거부 테스트
+func TestMigrationHandler_ResumeMigrationMismatch(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	migrationHandler := NewHandler(baseHandler)
+
+	const sourceKubeConfig = "apiVersion: v1\nkind: Config\ncurrent-context: source"
+	const targetKubeConfig = "apiVersion: v1\nkind: Config\ncurrent-context: target"
+
+	// 단계 정보 없이 실패한 마이그레이션 (일치하는 요청은 단계 확인에서 409 MIGRATION_NOT_RESUMABLE)
+	manager := migrationHandler.service.jobManager
+	manager.CreateJob("migration-1", map[string]interface{}{
+		"name":              "app-migration",
+		"sourceCluster":     validator.KubeConfigFingerprint(sourceKubeConfig),
+		"targetCluster":     validator.KubeConfigFingerprint(targetKubeConfig),
+		"backupName":        "app-migration",
+		"veleroNamespace":   "velero",
+		"includeNamespaces": []interface{}{"app", "db"},
+		"minioEndpoint":     "localhost:9000",
+	})
+	manager.FailJob("migration-1", errors.New("backup failed"))
+
+	request := func(override map[string]interface{}) map[string]interface{} {
+		body := map[string]interface{}{
+			"name":              "app-migration",
+			"source":            map[string]interface{}{"kubeconfig": sourceKubeConfig},
+			"target":            map[string]interface{}{"kubeconfig": targetKubeConfig},
+			"minio":             map[string]interface{}{"endpoint": "localhost:9000", "accessKey": "minioadmin", "secretKey": "minioadmin123"},
+			"includeNamespaces": []string{"db", "app"},
+		}
+		for key, value := range override {
+			body[key] = value
+		}
+		return body
+	}
+
+	tests := []struct {
+		name     string
+		body     map[string]interface{}
+		wantCode string
+	}{
+		{name: "일치하는 요청", body: request(nil), wantCode: "MIGRATION_NOT_RESUMABLE"},
+		{name: "다른 이름", body: request(map[string]interface{}{"name": "other-migration"}), wantCode: "MIGRATION_MISMATCH"},
+		{name: "다른 원본 클러스터", body: request(map[string]interface{}{"source": map[string]interface{}{"kubeconfig": targetKubeConfig}}), wantCode: "MIGRATION_MISMATCH"},
+		{name: "다른 대상 클러스터", body: request(map[string]interface{}{"target": map[string]interface{}{"kubeconfig": sourceKubeConfig}}), wantCode: "MIGRATION_MISMATCH"},
+		{name: "다른 네임스페이스", body: request(map[string]interface{}{"includeNamespaces": []string{"app"}}), wantCode: "MIGRATION_MISMATCH"},
+		{name: "다른 Velero 네임스페이스", body: request(map[string]interface{}{"veleroNamespace": "velero-dr"}), wantCode: "MIGRATION_MISMATCH"},
+	}
+
+	e := echo.New()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqBody, _ := json.Marshal(tt.body)
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/migrations/migration-1/resume", bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("migrationId")
+			c.SetParamValues("migration-1")
+
+			if err := migrationHandler.ResumeMigration(c); err != nil {
+				t.Fatalf("ResumeMigration() error = %v", err)
+			}
+			if rec.Code != http.StatusConflict || !strings.Contains(rec.Body.String(), tt.wantCode) {
+				t.Errorf("Expected 409 %s, got %d: %s", tt.wantCode, rec.Code, rec.Body.String())
+			}
+		})
+	}
+
+	if info, _ := manager.GetJob("migration-1"); info.Status != job.JobStatusFailed {
+		t.Errorf("Expected rejected resume to leave the job failed, got %s", info.Status)
+	}
+}
+
+// TestMigrationHandler_RegistryRefs 등록 ID(sourceClusterId/targetClusterId/storageId)만으로 마이그레이션 요청 테스트
+func TestMigrationHandler_RegistryRefs(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	migrationHandler := NewHandler(baseHandler)
+
+	ctx := context.Background()
+	for _, id := range []string{"prod-a", "dr-b"} {
+		if _, err := baseHandler.Registry.CreateCluster(ctx, registry.Cluster{ID: id, KubeConfig: "apiVersion: v1\nkind: Config"}); err != nil {
+			t.Fatalf("CreateCluster() error = %v", err)
+		}
+	}
+	if _, err := baseHandler.Registry.CreateStorage(ctx, registry.Storage{ID: "backup-minio", Minio: config.MinioConfig{
+		Endpoint: "localhost:9000", AccessKey: "minioadmin", SecretKey: "minioadmin123",
+	}}); err != nil {
+		t.Fatalf("CreateStorage() error = %v", err)
+	}
+
+	e := echo.New()
+	post := func(target string, body map[string]interface{}, handle func(echo.Context) error) *httptest.ResponseRecorder {
+		reqBody, _ := json.Marshal(body)
+		req := httptest.NewRequest(http.MethodPost, target, bytes.NewReader(reqBody))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		if err := handle(e.NewContext(req, rec)); err != nil {
+			t.Fatalf("handler error = %v", err)
+		}
+		return rec
+	}
+
+	// 등록 ID만으로 마이그레이션 시작
+	rec := post("/api/v1/migrations", map[string]interface{}{
+		"name":              "app-migration",
+		"sourceClusterId":   "prod-a",
+		"targetClusterId":   "dr-b",
+		"storageId":         "backup-minio",
+		"includeNamespaces": []string{"app"},
+	}, migrationHandler.StartMigration)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d: %s", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	for _, info := range migrationHandler.service.jobManager.GetAllJobs() {
+		_ = migrationHandler.service.jobManager.CancelJob(info.JobID)
+	}
+
+	// 등록되지 않은 클러스터 ID
+	rec = post("/api/v1/migrations/analyze", map[string]interface{}{
+		"sourceClusterId":   "missing",
+		"targetClusterId":   "dr-b",
+		"includeNamespaces": []string{"app"},
+	}, migrationHandler.AnalyzeCompatibility)
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status %d, got %d: %s", http.StatusNotFound, rec.Code, rec.Body.String())
+	}
+}
+
+// TestBuildRestore 마이그레이션 복원 스펙 생성 테스트
+func TestBuildRestore(t *testing.T) {
+	restorePVs := false
+	req := types.MigrationRequest{
+		Name:              "app-migration",
+		VeleroNamespace:   "velero-system",
+		IncludeNamespaces: []string{"app"},
+		NamespaceMappings: map[string]string{"app": "app-copy"},
+		RestorePVs:        &restorePVs,
+	}
+
+	restore, err := buildRestore(req)
+	if err != nil {
+		t.Fatalf("buildRestore() error = %v", err)
+	}
+	if restore.Name != "app-migration-restore" || restore.Spec.BackupName != "app-migration" {
+		t.Errorf("Unexpected restore names: %s / %s", restore.Name, restore.Spec.BackupName)
+	}
+	if restore.Namespace != "velero-system" {
+		t.Errorf("Expected restore namespace velero-system, got %s", restore.Namespace)
+	}
+	if restore.Spec.RestorePVs == nil || *restore.Spec.RestorePVs {
+		t.Error("Expected restorePVs to be false")
+	}
+
+	req.ExistingResourcePolicy = "replace"
+	if _, err := buildRestore(req); err == nil {
+		t.Error("Expected error for unsupported existingResourcePolicy")
+	}
+}
+
+// TestStepsFromMetadata 저장된 단계 목록 복원 테스트
+func TestStepsFromMetadata(t *testing.T) {
+	steps := newSteps()
+	steps[0].Status = stepCompleted
+
+	// 파일 저장소에서 불러온 경우와 동일하게 JSON 왕복
+	raw, _ := json.Marshal(steps)
+	var stored interface{}
+	_ = json.Unmarshal(raw, &stored)
+
+	restored, err := stepsFromMetadata(stored)
+	if err != nil {
+		t.Fatalf("stepsFromMetadata() error = %v", err)
+	}
+	if restored[0].Status != stepCompleted || restored[1].Status != stepPending {
+		t.Errorf("Unexpected restored steps: %+v", restored)
+	}
+
+	if _, err := stepsFromMetadata(nil); err == nil {
+		t.Error("Expected error for missing steps")
+	}
+}
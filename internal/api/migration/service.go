@@ -0,0 +1,723 @@
+// Package migration 클러스터 간 마이그레이션 워크플로우를 관리합니다.
+package migration
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/taking/kubemigrate/internal/analyzer"
+	"github.com/taking/kubemigrate/internal/api/velero"
+	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/installer"
+	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/validator"
+	"github.com/taking/kubemigrate/pkg/client"
+	"github.com/taking/kubemigrate/pkg/client/kubernetes"
+	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// 마이그레이션 단계
+const (
+	StepSourceVelero = "source-velero" // 원본 클러스터 Velero 설치/확인
+	StepTargetVelero = "target-velero" // 대상 클러스터 Velero 설치/확인
+	StepBackup       = "backup"        // 원본 클러스터 백업 생성 및 완료 대기
+	StepBackupSync   = "backup-sync"   // 대상 클러스터 BSL 백업 동기화 대기
+	StepRestore      = "restore"       // 대상 클러스터 복원 생성 및 완료 대기
+)
+
+// 단계 상태
+const (
+	stepPending   = "pending"
+	stepRunning   = "running"
+	stepCompleted = "completed"
+	stepFailed    = "failed"
+)
+
+// migrationSteps : 실행 순서대로 정렬된 단계 목록
+var migrationSteps = []string{StepSourceVelero, StepTargetVelero, StepBackup, StepBackupSync, StepRestore}
+
+// ErrMigrationNotResumable : 재개할 수 없는 상태의 마이그레이션
+var ErrMigrationNotResumable = errors.New("migration is not resumable")
+
+// ErrMigrationMismatch : 재개 요청이 최초 마이그레이션 요청과 다름
+var ErrMigrationMismatch = errors.New("resume request does not match the original migration")
+
+// Service : 마이그레이션 비즈니스 로직 서비스
+type Service struct {
+	*handler.BaseHandler
+	jobManager job.JobManager
+	installer  *installer.Service
+	analyzer   *analyzer.Service
+}
+
+// NewService : 새로운 마이그레이션 서비스 생성
+func NewService(base *handler.BaseHandler) *Service {
+	workerCount := base.GetConfigInt("MIGRATION_WORKER_COUNT", 2)
+
+	s := &Service{
+		BaseHandler: base,
+		jobManager:  base.NewJobManager("migration", workerCount),
+		installer:   installer.NewService(),
+		analyzer:    analyzer.NewService(),
+	}
+
+	// 재시작으로 중단된 마이그레이션은 실패 처리 (resume API로 이어서 진행 가능)
+	for _, interrupted := range s.jobManager.InterruptedJobs() {
+		s.jobManager.FailJob(interrupted.JobID, fmt.Errorf("migration interrupted by server restart, resume to continue"))
+	}
+
+	return s
+}
+
+// ValidateMigrationRequest : 마이그레이션 요청 검증 및 기본값 적용
+func (s *Service) ValidateMigrationRequest(req *types.MigrationRequest) error {
+	if errs := validation.IsDNS1123Subdomain(req.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid migration name %q: %v", req.Name, errs)
+	}
+	if len(req.IncludeNamespaces) == 0 {
+		return fmt.Errorf("includeNamespaces is required")
+	}
+	if req.VeleroNamespace == "" {
+		req.VeleroNamespace = "velero"
+	}
+	if errs := validation.IsDNS1123Label(req.VeleroNamespace); len(errs) > 0 {
+		return fmt.Errorf("invalid veleroNamespace %q: %v", req.VeleroNamespace, errs)
+	}
+
+	if err := s.ValidationManager.ValidateKubeConfig(&req.Source); err != nil {
+		return fmt.Errorf("source kubeconfig validation failed: %w", err)
+	}
+	if err := s.ValidationManager.ValidateKubeConfig(&req.Target); err != nil {
+		return fmt.Errorf("target kubeconfig validation failed: %w", err)
+	}
+	if err := s.ValidationManager.ValidateMinioConfig(&req.MinioConfig); err != nil {
+		return fmt.Errorf("minio config validation failed: %w", err)
+	}
+	if req.VeleroInstall != nil {
+		if err := s.ValidationManager.ValidateVeleroInstallOptions(req.VeleroInstall); err != nil {
+			return fmt.Errorf("velero install options validation failed: %w", err)
+		}
+	}
+
+	if req.TTL != "" {
+		if _, err := time.ParseDuration(req.TTL); err != nil {
+			return fmt.Errorf("invalid ttl %q: %w", req.TTL, err)
+		}
+	}
+
+	// 복원 스펙 검증 (네임스페이스 매핑, existingResourcePolicy 등)
+	if _, err := buildRestore(*req); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// StartMigrationInternal : 마이그레이션 시작 (비동기)
+func (s *Service) StartMigrationInternal(req types.MigrationRequest) (*types.MigrationResult, error) {
+	if err := s.ValidateMigrationRequest(&req); err != nil {
+		return nil, err
+	}
+
+	jobID := fmt.Sprintf("migration-%d", time.Now().UnixNano())
+	steps := newSteps()
+
+	// Job 생성 (민감한 정보 제외, 클러스터는 재개 요청 확인용 kubeconfig 지문만 저장)
+	metadata := map[string]interface{}{
+		"name":                 req.Name,
+		"sourceCluster":        validator.KubeConfigFingerprint(req.Source.KubeConfig),
+		"targetCluster":        validator.KubeConfigFingerprint(req.Target.KubeConfig),
+		"backupName":           backupName(req),
+		"restoreName":          restoreName(req),
+		"veleroNamespace":      req.VeleroNamespace,
+		"includeNamespaces":    req.IncludeNamespaces,
+		"namespaceMappings":    req.NamespaceMappings,
+		"storageClassMappings": req.StorageClassMappings,
+		"minioEndpoint":        req.MinioConfig.Endpoint,
+		"steps":                steps,
+	}
+	_ = s.jobManager.CreateJob(jobID, metadata)
+
+	go s.runMigration(jobID, req, steps)
+
+	return s.migrationResult(jobID, req, steps, "Migration started"), nil
+}
+
+// ResumeMigrationInternal : 실패한 마이그레이션을 완료되지 않은 단계부터 재개
+// 자격 증명은 저장하지 않으므로 최초 요청과 동일한 본문을 다시 전달해야 합니다.
+func (s *Service) ResumeMigrationInternal(jobID string, req types.MigrationRequest) (*types.MigrationResult, error) {
+	snapshot, exists := s.jobManager.Snapshot(jobID)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", job.ErrJobNotFound, jobID)
+	}
+	if snapshot.Status != job.JobStatusFailed {
+		return nil, fmt.Errorf("%w: only failed migrations can be resumed (status: %s)", ErrMigrationNotResumable, snapshot.Status)
+	}
+
+	if err := s.ValidateMigrationRequest(&req); err != nil {
+		return nil, err
+	}
+
+	// 다른 클러스터나 네임스페이스로 완료된 단계 이후를 이어서 실행하지 않도록 최초 요청과 비교
+	if err := matchResumeRequest(snapshot.Metadata, req); err != nil {
+		return nil, err
+	}
+
+	steps, err := stepsFromMetadata(snapshot.Metadata["steps"])
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMigrationNotResumable, err)
+	}
+
+	// 실패 상태인 작업을 원자적으로 가져와 동시에 들어온 재개 요청이 중복 실행되지 않도록 함
+	if !s.jobManager.TransitionJobStatus(jobID, job.JobStatusFailed, job.JobStatusProcessing, "Resuming migration...") {
+		return nil, fmt.Errorf("%w: migration %s is already being resumed", ErrMigrationNotResumable, jobID)
+	}
+	s.jobManager.AddJobLog(jobID, "Resuming migration from the first incomplete step")
+
+	go s.runMigration(jobID, req, steps)
+
+	return s.migrationResult(jobID, req, steps, "Migration resumed"), nil
+}
+
+// GetMigrationInternal : 마이그레이션 상태 조회
+func (s *Service) GetMigrationInternal(jobID string) (interface{}, error) {
+	snapshot, exists := s.jobManager.Snapshot(jobID)
+	if !exists {
+		return nil, fmt.Errorf("%w: %s", job.ErrJobNotFound, jobID)
+	}
+	return snapshot, nil
+}
+
+// GetMigrationsInternal : 모든 마이그레이션 조회
+func (s *Service) GetMigrationsInternal() (interface{}, error) {
+	jobs := s.jobManager.GetAllJobs()
+	return map[string]interface{}{
+		"migrations": jobs,
+		"count":      len(jobs),
+	}, nil
+}
+
+// CancelMigrationInternal : 진행 중인 마이그레이션 취소
+func (s *Service) CancelMigrationInternal(jobID string) (interface{}, error) {
+	if err := s.jobManager.CancelJob(jobID); err != nil {
+		return nil, err
+	}
+
+	snapshot, _ := s.jobManager.Snapshot(jobID)
+	return snapshot, nil
+}
+
+// ValidateCompatibilityRequest : 호환성 분석 요청 검증
+func (s *Service) ValidateCompatibilityRequest(req *types.CompatibilityRequest) error {
+	if len(req.IncludeNamespaces) == 0 {
+		return fmt.Errorf("includeNamespaces is required")
+	}
+	if err := s.ValidationManager.ValidateKubeConfig(&req.Source); err != nil {
+		return fmt.Errorf("source kubeconfig validation failed: %w", err)
+	}
+	if err := s.ValidationManager.ValidateKubeConfig(&req.Target); err != nil {
+		return fmt.Errorf("target kubeconfig validation failed: %w", err)
+	}
+	return nil
+}
+
+// AnalyzeCompatibilityInternal : 원본/대상 클러스터 사전 호환성 분석 (동기)
+func (s *Service) AnalyzeCompatibilityInternal(ctx context.Context, req types.CompatibilityRequest) (*types.CompatibilityReport, error) {
+	sourceClient, err := kubernetes.NewClientWithConfig(req.Source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create source cluster client: %w", err)
+	}
+	targetClient, err := kubernetes.NewClientWithConfig(req.Target)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create target cluster client: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.GetConfigDuration("MIGRATION_ANALYZE_TIMEOUT", 2*time.Minute))
+	defer cancel()
+
+	return s.analyzer.Analyze(ctx, sourceClient, targetClient, analyzer.Options{
+		IncludeNamespaces:       req.IncludeNamespaces,
+		ExcludeResources:        req.ExcludeResources,
+		IncludeClusterResources: req.IncludeClusterResources,
+		StorageClassMappings:    req.StorageClassMappings,
+	})
+}
+
+// runMigration : 백그라운드에서 마이그레이션 단계 실행
+func (s *Service) runMigration(jobID string, req types.MigrationRequest, steps []types.MigrationStep) {
+	timeout := s.GetConfigDuration("MIGRATION_TIMEOUT", 2*time.Hour)
+	ctx, cancel := s.jobManager.JobContext(jobID, timeout)
+	defer cancel()
+
+	sourceClient, err := newClusterClient(req.Source, req.MinioConfig)
+	if err != nil {
+		s.jobManager.FailJob(jobID, fmt.Errorf("failed to create source cluster client: %w", err))
+		return
+	}
+	targetClient, err := newClusterClient(req.Target, req.MinioConfig)
+	if err != nil {
+		s.jobManager.FailJob(jobID, fmt.Errorf("failed to create target cluster client: %w", err))
+		return
+	}
+
+	for i := range steps {
+		step := &steps[i]
+		if step.Status == stepCompleted {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Skipping completed step: %s", step.Name))
+			continue
+		}
+
+		now := time.Now()
+		step.Status, step.Message, step.StartedAt, step.CompletedAt = stepRunning, "", &now, nil
+		s.recordSteps(jobID, steps)
+		s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, i*100/len(steps),
+			fmt.Sprintf("Step %d/%d: %s", i+1, len(steps), step.Name))
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Starting step: %s", step.Name))
+
+		message, err := s.runStep(ctx, jobID, step.Name, req, sourceClient, targetClient)
+
+		completedAt := time.Now()
+		step.CompletedAt = &completedAt
+		if err != nil {
+			step.Status, step.Message = stepFailed, err.Error()
+			s.recordSteps(jobID, steps)
+			if job.IsCancelled(ctx) {
+				return
+			}
+			s.jobManager.FailJob(jobID, fmt.Errorf("migration step %s failed: %w", step.Name, err))
+			return
+		}
+
+		step.Status, step.Message = stepCompleted, message
+		s.recordSteps(jobID, steps)
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Step %s completed: %s", step.Name, message))
+	}
+
+	s.jobManager.CompleteJob(jobID, map[string]interface{}{
+		"name":        req.Name,
+		"backupName":  backupName(req),
+		"restoreName": restoreName(req),
+		"steps":       steps,
+		"completedAt": time.Now(),
+	})
+}
+
+// runStep : 단일 단계 실행
+func (s *Service) runStep(
+	ctx context.Context,
+	jobID, step string,
+	req types.MigrationRequest,
+	sourceClient, targetClient client.Client,
+) (string, error) {
+	switch step {
+	case StepSourceVelero:
+		return s.ensureVelero(ctx, sourceClient, req)
+	case StepTargetVelero:
+		return s.ensureVelero(ctx, targetClient, req)
+	case StepBackup:
+		return s.createBackup(ctx, jobID, sourceClient, req)
+	case StepBackupSync:
+		return s.waitForBackupSync(ctx, jobID, targetClient, req)
+	case StepRestore:
+		return s.createRestore(ctx, jobID, targetClient, req)
+	default:
+		return "", fmt.Errorf("unknown migration step: %s", step)
+	}
+}
+
+// ensureVelero : Velero 설치 확인 (이미 정상 설치된 경우 건너뜀)
+func (s *Service) ensureVelero(ctx context.Context, client client.Client, req types.MigrationRequest) (string, error) {
+	installConfig := installer.VeleroInstallConfig{
+		MinioConfig: req.MinioConfig,
+		Namespace:   req.VeleroNamespace,
+		Force:       req.ForceInstall,
+	}
+	if req.VeleroInstall != nil {
+		installConfig.Options = *req.VeleroInstall
+	}
+
+	result, err := s.installer.InstallVelero(ctx, client, installConfig)
+	if err != nil {
+		return "", err
+	}
+	return result.Message, nil
+}
+
+// createBackup : 원본 클러스터에 백업 생성 후 완료 대기 (재개 시 기존 백업 재사용)
+func (s *Service) createBackup(ctx context.Context, jobID string, client client.Client, req types.MigrationRequest) (string, error) {
+	name := backupName(req)
+
+	if _, err := client.Velero().GetBackup(ctx, req.VeleroNamespace, name); err == nil {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Backup %s already exists, waiting for completion", name))
+	} else if apierrors.IsNotFound(err) {
+		if err := client.Velero().CreateBackup(ctx, req.VeleroNamespace, buildBackup(req)); err != nil {
+			return "", fmt.Errorf("failed to create backup: %w", err)
+		}
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Backup %s created", name))
+	} else {
+		return "", fmt.Errorf("failed to check backup: %w", err)
+	}
+
+	var phase velerov1.BackupPhase
+	err := s.poll(ctx, s.GetConfigDuration("MIGRATION_BACKUP_TIMEOUT", time.Hour), func() (bool, error) {
+		backup, err := client.Velero().GetBackup(ctx, req.VeleroNamespace, name)
+		if err != nil {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to check backup status: %v", err))
+			return false, nil
+		}
+
+		phase = backup.Status.Phase
+		switch phase {
+		case velerov1.BackupPhaseCompleted, velerov1.BackupPhasePartiallyFailed:
+			return true, nil
+		case velerov1.BackupPhaseFailed, velerov1.BackupPhaseFailedValidation:
+			return false, fmt.Errorf("backup %s finished with phase %s: %s", name, phase, backup.Status.FailureReason)
+		}
+
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Backup status: %s, waiting...", phase))
+		return false, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Backup %s finished with phase %s", name, phase), nil
+}
+
+// waitForBackupSync : 대상 클러스터 BSL이 공유 스토리지에서 백업을 동기화할 때까지 대기
+func (s *Service) waitForBackupSync(ctx context.Context, jobID string, client client.Client, req types.MigrationRequest) (string, error) {
+	name := backupName(req)
+
+	err := s.poll(ctx, s.GetConfigDuration("MIGRATION_SYNC_TIMEOUT", 15*time.Minute), func() (bool, error) {
+		backup, err := client.Velero().GetBackup(ctx, req.VeleroNamespace, name)
+		if err != nil {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Backup %s not yet visible on target cluster, waiting for BSL sync...", name))
+			return false, nil
+		}
+
+		switch backup.Status.Phase {
+		case velerov1.BackupPhaseCompleted, velerov1.BackupPhasePartiallyFailed:
+			return true, nil
+		}
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Synced backup status: %s, waiting...", backup.Status.Phase))
+		return false, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("backup %s was not synced to target cluster: %w", name, err)
+	}
+
+	return fmt.Sprintf("Backup %s synced to target cluster", name), nil
+}
+
+// createRestore : 대상 클러스터에 복원 생성 후 완료 대기 (재개 시 기존 복원 재사용)
+func (s *Service) createRestore(ctx context.Context, jobID string, client client.Client, req types.MigrationRequest) (string, error) {
+	name := restoreName(req)
+
+	restore, err := buildRestore(req)
+	if err != nil {
+		return "", err
+	}
+
+	// StorageClass 매핑 ConfigMap (Velero change-storage-class 플러그인 설정)
+	if len(req.StorageClassMappings) > 0 {
+		if err := s.applyStorageClassConfigMap(ctx, client, req); err != nil {
+			return "", err
+		}
+		defer func() {
+			cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cleanupCancel()
+			if err := client.Kubernetes().DeleteConfigMap(cleanupCtx, req.VeleroNamespace, storageClassConfigMapName); err != nil {
+				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Warning: Failed to cleanup StorageClass ConfigMap: %v", err))
+			}
+		}()
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("StorageClass mappings applied: %v", req.StorageClassMappings))
+	}
+
+	if _, err := client.Velero().GetRestore(ctx, req.VeleroNamespace, name); err == nil {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore %s already exists, waiting for completion", name))
+	} else if apierrors.IsNotFound(err) {
+		if err := client.Velero().CreateRestore(ctx, req.VeleroNamespace, restore); err != nil {
+			return "", fmt.Errorf("failed to create restore: %w", err)
+		}
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore %s created", name))
+	} else {
+		return "", fmt.Errorf("failed to check restore: %w", err)
+	}
+
+	var phase velerov1.RestorePhase
+	err = s.poll(ctx, s.GetConfigDuration("MIGRATION_RESTORE_TIMEOUT", time.Hour), func() (bool, error) {
+		current, err := client.Velero().GetRestore(ctx, req.VeleroNamespace, name)
+		if err != nil {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to check restore status: %v", err))
+			return false, nil
+		}
+
+		phase = current.Status.Phase
+		switch phase {
+		case velerov1.RestorePhaseCompleted, velerov1.RestorePhasePartiallyFailed:
+			return true, nil
+		case velerov1.RestorePhaseFailed, velerov1.RestorePhaseFailedValidation:
+			return false, fmt.Errorf("restore %s finished with phase %s: %s", name, phase, current.Status.FailureReason)
+		}
+
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore status: %s, waiting...", phase))
+		return false, nil
+	})
+	if job.IsCancelled(ctx) {
+		// 취소 시 Restore 리소스 정리 (이미 복원된 리소스는 되돌리지 않음)
+		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 2*time.Minute)
+		defer cleanupCancel()
+		if deleteErr := client.Velero().DeleteRestore(cleanupCtx, req.VeleroNamespace, name); deleteErr != nil {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Warning: failed to delete restore after cancellation: %v", deleteErr))
+		} else {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore %s deleted after cancellation", name))
+		}
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("Restore %s finished with phase %s", name, phase), nil
+}
+
+// storageClassConfigMapName : Velero StorageClass 변경 플러그인 ConfigMap 이름
+const storageClassConfigMapName = "change-storage-class-config"
+
+// applyStorageClassConfigMap : StorageClass 매핑 ConfigMap 생성 (기존 ConfigMap은 교체)
+func (s *Service) applyStorageClassConfigMap(ctx context.Context, client client.Client, req types.MigrationRequest) error {
+	_ = client.Kubernetes().DeleteConfigMap(ctx, req.VeleroNamespace, storageClassConfigMapName)
+
+	configMap := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      storageClassConfigMapName,
+			Namespace: req.VeleroNamespace,
+			Labels: map[string]string{
+				"velero.io/plugin-config":        "",
+				"velero.io/change-storage-class": "RestoreItemAction",
+			},
+		},
+		Data: req.StorageClassMappings,
+	}
+
+	if err := client.Kubernetes().CreateConfigMap(ctx, configMap); err != nil {
+		return fmt.Errorf("failed to create StorageClass ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// poll : 조건이 충족되거나 에러/타임아웃/취소될 때까지 주기적으로 확인
+func (s *Service) poll(ctx context.Context, timeout time.Duration, check func() (bool, error)) error {
+	interval := s.GetConfigDuration("MIGRATION_POLL_INTERVAL", 10*time.Second)
+	deadline := time.Now().Add(timeout)
+
+	for {
+		done, err := check()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return fmt.Errorf("timed out after %v", timeout)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
+
+// recordSteps : 단계 진행 상태를 작업 메타데이터에 기록
+func (s *Service) recordSteps(jobID string, steps []types.MigrationStep) {
+	s.jobManager.SetJobMetadata(jobID, "steps", append([]types.MigrationStep(nil), steps...))
+}
+
+// migrationResult : 마이그레이션 시작/재개 응답 생성
+func (s *Service) migrationResult(jobID string, req types.MigrationRequest, steps []types.MigrationStep, message string) *types.MigrationResult {
+	return &types.MigrationResult{
+		Status:      "processing",
+		MigrationID: jobID,
+		Name:        req.Name,
+		BackupName:  backupName(req),
+		RestoreName: restoreName(req),
+		Message:     message,
+		StatusUrl:   fmt.Sprintf("/api/v1/migrations/%s", jobID),
+		StreamUrl:   fmt.Sprintf("/api/v1/migrations/%s/stream", jobID),
+		Steps:       steps,
+		CreatedAt:   time.Now(),
+	}
+}
+
+// newClusterClient : 클러스터별 통합 클라이언트 생성
+func newClusterClient(kubeConfig config.KubeConfig, minioConfig config.MinioConfig) (client.Client, error) {
+	veleroConfig := config.VeleroConfig{KubeConfig: kubeConfig, MinioConfig: minioConfig}
+	return client.NewClientWithConfig(&kubeConfig, &kubeConfig, &veleroConfig, &minioConfig)
+}
+
+// newSteps : 초기 단계 목록 생성
+func newSteps() []types.MigrationStep {
+	steps := make([]types.MigrationStep, 0, len(migrationSteps))
+	for _, name := range migrationSteps {
+		steps = append(steps, types.MigrationStep{Name: name, Status: stepPending})
+	}
+	return steps
+}
+
+// stepsFromMetadata : 작업 메타데이터의 단계 목록 복원 (저장소에서 불러온 경우 JSON 형태)
+func stepsFromMetadata(value interface{}) ([]types.MigrationStep, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration steps: %w", err)
+	}
+
+	var steps []types.MigrationStep
+	if err := json.Unmarshal(raw, &steps); err != nil {
+		return nil, fmt.Errorf("failed to read migration steps: %w", err)
+	}
+	if len(steps) != len(migrationSteps) {
+		return nil, fmt.Errorf("migration steps are missing or incomplete")
+	}
+	return steps, nil
+}
+
+// matchResumeRequest : 재개 요청의 원본/대상 클러스터, 네임스페이스, 백업 이름이 최초 요청과 같은지 확인
+// 지문 등 이전 버전에서 저장하지 않은 항목은 비교하지 않습니다
+func matchResumeRequest(metadata map[string]interface{}, req types.MigrationRequest) error {
+	checks := []struct {
+		field     string
+		requested string
+	}{
+		{field: "name", requested: req.Name},
+		{field: "backupName", requested: backupName(req)},
+		{field: "veleroNamespace", requested: req.VeleroNamespace},
+		{field: "sourceCluster", requested: validator.KubeConfigFingerprint(req.Source.KubeConfig)},
+		{field: "targetCluster", requested: validator.KubeConfigFingerprint(req.Target.KubeConfig)},
+		{field: "minioEndpoint", requested: req.MinioConfig.Endpoint},
+	}
+	for _, check := range checks {
+		stored, _ := metadata[check.field].(string)
+		if stored != "" && stored != check.requested {
+			return fmt.Errorf("%w: %s differs from the original request", ErrMigrationMismatch, check.field)
+		}
+	}
+
+	if stored, ok := metadata["includeNamespaces"]; ok {
+		namespaces, err := stringsFromMetadata(stored)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrMigrationNotResumable, err)
+		}
+		if !sameStringSet(namespaces, req.IncludeNamespaces) {
+			return fmt.Errorf("%w: includeNamespaces %v differs from the original %v", ErrMigrationMismatch, req.IncludeNamespaces, namespaces)
+		}
+	}
+
+	return nil
+}
+
+// stringsFromMetadata : 작업 메타데이터의 문자열 목록 복원 (파일 저장소에서 다시 읽으면 []interface{})
+func stringsFromMetadata(value interface{}) ([]string, error) {
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read migration metadata: %w", err)
+	}
+
+	var values []string
+	if err := json.Unmarshal(raw, &values); err != nil {
+		return nil, fmt.Errorf("failed to read migration metadata: %w", err)
+	}
+	return values, nil
+}
+
+// sameStringSet : 순서와 무관하게 같은 문자열 집합인지 확인
+func sameStringSet(a, b []string) bool {
+	set := make(map[string]struct{}, len(a))
+	for _, value := range a {
+		set[value] = struct{}{}
+	}
+	other := make(map[string]struct{}, len(b))
+	for _, value := range b {
+		if _, ok := set[value]; !ok {
+			return false
+		}
+		other[value] = struct{}{}
+	}
+	return len(set) == len(other)
+}
+
+// backupName : 마이그레이션 백업 이름
+func backupName(req types.MigrationRequest) string {
+	return req.Name
+}
+
+// restoreName : 마이그레이션 복원 이름
+func restoreName(req types.MigrationRequest) string {
+	return req.Name + "-restore"
+}
+
+// buildBackup : 마이그레이션 요청으로부터 Velero Backup 생성
+func buildBackup(req types.MigrationRequest) *velerov1.Backup {
+	backup := &velerov1.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      backupName(req),
+			Namespace: req.VeleroNamespace,
+			Labels:    map[string]string{"kubemigrate.io/migration": req.Name},
+		},
+		Spec: velerov1.BackupSpec{
+			IncludedNamespaces:       req.IncludeNamespaces,
+			ExcludedResources:        req.ExcludeResources,
+			IncludeClusterResources:  req.IncludeClusterResources,
+			DefaultVolumesToFsBackup: req.DefaultVolumesToFsBackup,
+		},
+	}
+
+	if req.TTL != "" {
+		if ttl, err := time.ParseDuration(req.TTL); err == nil {
+			backup.Spec.TTL = metav1.Duration{Duration: ttl}
+		}
+	}
+	if len(req.LabelSelector) > 0 {
+		backup.Spec.LabelSelector = &metav1.LabelSelector{MatchLabels: req.LabelSelector}
+	}
+
+	return backup
+}
+
+// buildRestore : 마이그레이션 요청으로부터 Velero Restore 생성 (Velero 복원 API와 동일한 검증 적용)
+func buildRestore(req types.MigrationRequest) (*velerov1.Restore, error) {
+	restorePVs := true
+	if req.RestorePVs != nil {
+		restorePVs = *req.RestorePVs
+	}
+	includeClusterResources := req.IncludeClusterResources != nil && *req.IncludeClusterResources
+
+	restore, err := velero.BuildRestore(types.RestoreRequest{
+		Name:                    restoreName(req),
+		BackupName:              backupName(req),
+		IncludeNamespaces:       req.IncludeNamespaces,
+		ExcludeResources:        req.ExcludeResources,
+		LabelSelector:           req.LabelSelector,
+		IncludeClusterResources: includeClusterResources,
+		RestorePVs:              restorePVs,
+		NamespaceMappings:       req.NamespaceMappings,
+		ExistingResourcePolicy:  req.ExistingResourcePolicy,
+		Metadata:                map[string]string{"kubemigrate.io/migration": req.Name},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	restore.Namespace = req.VeleroNamespace
+	return restore, nil
+}
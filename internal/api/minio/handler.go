@@ -2,17 +2,30 @@ package minio
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/logger"
+	"github.com/taking/kubemigrate/internal/response"
 	"github.com/taking/kubemigrate/pkg/client"
+	"github.com/taking/kubemigrate/pkg/client/minio"
+	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
 )
 
 // Handler : MinIO 관련 HTTP 핸들러
 type Handler struct {
 	*handler.BaseHandler
-	service *Service
+	service    *Service
+	jobManager job.JobManager // 스트리밍 업로드/복제 진행 상황 추적
 }
 
 // NewHandler : 새로운 MinIO 핸들러 생성
@@ -20,6 +33,7 @@ func NewHandler(base *handler.BaseHandler) *Handler {
 	return &Handler{
 		BaseHandler: base,
 		service:     NewService(base),
+		jobManager:  base.NewJobManager("minio", base.GetConfigInt("MINIO_WORKER_COUNT", 2)),
 	}
 }
 
@@ -101,6 +115,7 @@ func (h *Handler) CheckBucketExists(c echo.Context) error {
 // @Produce json
 // @Param request body config.MinioConfig true "MinIO configuration"
 // @Param bucket path string true "Bucket name"
+// @Param objectLock query bool false "Enable object lock (WORM) and versioning, only possible at creation time (default: false)"
 // @Success 200 {object} response.SuccessResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
@@ -113,7 +128,8 @@ func (h *Handler) CreateBucket(c echo.Context) error {
 			return nil, echo.NewHTTPError(400, "bucket parameter is required")
 		}
 
-		return h.service.CreateBucketInternal(client, ctx, bucketName)
+		objectLock := h.StringToBoolOrDefault(c.QueryParam("objectLock"), false)
+		return h.service.CreateBucketInternal(client, ctx, bucketName, objectLock)
 	})
 }
 
@@ -141,6 +157,244 @@ func (h *Handler) DeleteBucket(c echo.Context) error {
 	})
 }
 
+// GetBucketVersioning : 버킷 버전 관리 상태 조회
+// @Summary Get Bucket Versioning
+// @Description Get the versioning status of a MinIO bucket
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param request body config.MinioConfig true "MinIO configuration"
+// @Param bucket path string true "Bucket name"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/versioning [get]
+func (h *Handler) GetBucketVersioning(c echo.Context) error {
+	return h.HandleResourceClient(c, "bucket-versioning", func(client client.Client, ctx context.Context) (interface{}, error) {
+		return h.service.GetBucketVersioningInternal(client, ctx, c.Param("bucket"))
+	})
+}
+
+// SetBucketVersioning : 버킷 버전 관리 활성화/일시 중지
+// @Summary Set Bucket Versioning
+// @Description Enable or suspend versioning of a MinIO bucket. Versioning cannot be suspended on object lock buckets.
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param bucket path string true "Bucket name"
+// @Param request body types.BucketVersioningRequest true "Versioning setting with minio configuration"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/versioning [put]
+func (h *Handler) SetBucketVersioning(c echo.Context) error {
+	var req types.BucketVersioningRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+
+	return h.applyBucketSetting(c, req.MinioConfig, "bucket versioning update", func(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+		return h.service.SetBucketVersioningInternal(client, ctx, bucketName, req.Enabled)
+	})
+}
+
+// GetBucketRetention : 버킷 Object Lock 기본 보존 설정 조회
+// @Summary Get Bucket Retention
+// @Description Get the object lock status and default retention of a MinIO bucket
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param request body config.MinioConfig true "MinIO configuration"
+// @Param bucket path string true "Bucket name"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/retention [get]
+func (h *Handler) GetBucketRetention(c echo.Context) error {
+	return h.HandleResourceClient(c, "bucket-retention", func(client client.Client, ctx context.Context) (interface{}, error) {
+		return h.service.GetBucketRetentionInternal(client, ctx, c.Param("bucket"))
+	})
+}
+
+// SetBucketRetention : 버킷 Object Lock 기본 보존 설정 변경
+// @Summary Set Bucket Retention
+// @Description Set the default retention (WORM) of an object lock bucket. An empty mode clears the default retention.
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param bucket path string true "Bucket name"
+// @Param request body types.BucketRetentionRequest true "Retention setting with minio configuration"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/retention [put]
+func (h *Handler) SetBucketRetention(c echo.Context) error {
+	var req types.BucketRetentionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.service.ValidateBucketRetentionRequest(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_RETENTION", "Invalid bucket retention specification", err.Error())
+	}
+
+	return h.applyBucketSetting(c, req.MinioConfig, "bucket retention update", func(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+		return h.service.SetBucketRetentionInternal(client, ctx, bucketName, req)
+	})
+}
+
+// GetBucketLifecycle : 버킷 수명 주기 규칙 조회
+// @Summary Get Bucket Lifecycle
+// @Description Get the lifecycle rules of a MinIO bucket
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param request body config.MinioConfig true "MinIO configuration"
+// @Param bucket path string true "Bucket name"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/lifecycle [get]
+func (h *Handler) GetBucketLifecycle(c echo.Context) error {
+	return h.HandleResourceClient(c, "bucket-lifecycle", func(client client.Client, ctx context.Context) (interface{}, error) {
+		return h.service.GetBucketLifecycleInternal(client, ctx, c.Param("bucket"))
+	})
+}
+
+// SetBucketLifecycle : 버킷 수명 주기 규칙 교체
+// @Summary Set Bucket Lifecycle
+// @Description Replace the lifecycle rules (expiration, noncurrent version expiration, incomplete upload cleanup) of a MinIO bucket
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param bucket path string true "Bucket name"
+// @Param request body types.BucketLifecycleRequest true "Lifecycle rules with minio configuration"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/lifecycle [put]
+func (h *Handler) SetBucketLifecycle(c echo.Context) error {
+	var req types.BucketLifecycleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.service.ValidateBucketLifecycleRequest(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_LIFECYCLE", "Invalid bucket lifecycle specification", err.Error())
+	}
+
+	return h.applyBucketSetting(c, req.MinioConfig, "bucket lifecycle update", func(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+		return h.service.SetBucketLifecycleInternal(client, ctx, bucketName, req.Rules)
+	})
+}
+
+// DeleteBucketLifecycle : 버킷 수명 주기 설정 삭제
+// @Summary Delete Bucket Lifecycle
+// @Description Remove all lifecycle rules of a MinIO bucket
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param request body config.MinioConfig true "MinIO configuration"
+// @Param bucket path string true "Bucket name"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/lifecycle [delete]
+func (h *Handler) DeleteBucketLifecycle(c echo.Context) error {
+	return h.HandleResourceClient(c, "delete-bucket-lifecycle", func(client client.Client, ctx context.Context) (interface{}, error) {
+		return h.service.SetBucketLifecycleInternal(client, ctx, c.Param("bucket"), nil)
+	})
+}
+
+// GetBucketPolicy : 버킷 정책 조회
+// @Summary Get Bucket Policy
+// @Description Get the bucket policy of a MinIO bucket (null if no policy is set)
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param request body config.MinioConfig true "MinIO configuration"
+// @Param bucket path string true "Bucket name"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/policy [get]
+func (h *Handler) GetBucketPolicy(c echo.Context) error {
+	return h.HandleResourceClient(c, "bucket-policy", func(client client.Client, ctx context.Context) (interface{}, error) {
+		return h.service.GetBucketPolicyInternal(client, ctx, c.Param("bucket"))
+	})
+}
+
+// SetBucketPolicy : 버킷 정책 교체
+// @Summary Set Bucket Policy
+// @Description Replace the S3 bucket policy of a MinIO bucket
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param bucket path string true "Bucket name"
+// @Param request body types.BucketPolicyRequest true "Bucket policy with minio configuration"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/policy [put]
+func (h *Handler) SetBucketPolicy(c echo.Context) error {
+	var req types.BucketPolicyRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.service.ValidateBucketPolicy(req.Policy); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_POLICY", "Invalid bucket policy", err.Error())
+	}
+
+	return h.applyBucketSetting(c, req.MinioConfig, "bucket policy update", func(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+		return h.service.SetBucketPolicyInternal(client, ctx, bucketName, req.Policy)
+	})
+}
+
+// DeleteBucketPolicy : 버킷 정책 삭제
+// @Summary Delete Bucket Policy
+// @Description Remove the bucket policy of a MinIO bucket
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param request body config.MinioConfig true "MinIO configuration"
+// @Param bucket path string true "Bucket name"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/policy [delete]
+func (h *Handler) DeleteBucketPolicy(c echo.Context) error {
+	return h.HandleResourceClient(c, "delete-bucket-policy", func(client client.Client, ctx context.Context) (interface{}, error) {
+		return h.service.SetBucketPolicyInternal(client, ctx, c.Param("bucket"), nil)
+	})
+}
+
+// applyBucketSetting : 버킷 설정 변경 요청 공통 처리 (클라이언트 생성, 타임아웃, 응답)
+func (h *Handler) applyBucketSetting(
+	c echo.Context,
+	minioConfig config.MinioConfig,
+	operation string,
+	apply func(client.Client, context.Context, string) (interface{}, error),
+) error {
+	bucketName := c.Param("bucket")
+	if bucketName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "bucket parameter is required", "")
+	}
+
+	unifiedClient, err := h.NewMinioClient(minioConfig)
+	if err != nil {
+		return h.HandleConnectionError(c, "minio", "client creation", err)
+	}
+
+	// 컨텍스트 생성 (타임아웃 설정)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	result, err := apply(unifiedClient, ctx, bucketName)
+	if err != nil {
+		return h.HandleInternalError(c, "minio", operation, err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
 // GetObjects : MinIO 객체 목록 조회
 // @Summary Get Objects
 // @Description Get a list of objects in a MinIO bucket
@@ -165,35 +419,206 @@ func (h *Handler) GetObjects(c echo.Context) error {
 	})
 }
 
-// GetObject : MinIO 객체 다운로드
+// GetObject : MinIO 객체 스트리밍 다운로드
 // @Summary Get Object
-// @Description Download an object from MinIO bucket
+// @Description Stream an object from a MinIO bucket. Supports single byte ranges (Range, If-Range) for resumable downloads and HEAD requests for metadata. Downloads require the operator role.
 // @Tags minio
-// @Accept json
-// @Produce json
-// @Param request body config.MinioConfig true "MinIO configuration"
+// @Produce octet-stream
 // @Param bucket path string true "Bucket name"
 // @Param object path string true "Object name"
-// @Success 200 {object} response.SuccessResponse
+// @Param X-Minio-Endpoint header string false "MinIO endpoint (or minio configuration in the JSON body)"
+// @Param X-Minio-Access-Key header string false "MinIO access key"
+// @Param X-Minio-Secret-Key header string false "MinIO secret key"
+// @Param X-Minio-Use-SSL header bool false "Use SSL"
+// @Param storageId query string false "Registered storage ID (instead of MinIO configuration)"
+// @Param X-Storage-Id header string false "Registered storage ID (alternative to the storageId query parameter)"
+// @Param Range header string false "Byte range (e.g. bytes=1048576-)"
+// @Param If-Range header string false "ETag the range request is valid for"
+// @Success 200 {file} file "Object content"
+// @Success 206 {file} file "Partial object content"
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 416 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /v1/minio/buckets/{bucket}/objects/{object} [get]
 func (h *Handler) GetObject(c echo.Context) error {
-	return h.HandleResourceClient(c, "get-object", func(client client.Client, ctx context.Context) (interface{}, error) {
-		// 버킷 이름과 객체 이름 가져오기
-		bucketName := c.Param("bucket")
-		objectName := c.Param("*")
-		if bucketName == "" || objectName == "" {
-			return nil, echo.NewHTTPError(400, "bucket and object parameters are required")
-		}
+	bucketName := c.Param("bucket")
+	objectName := c.Param("*")
+	if bucketName == "" || objectName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "bucket and object parameters are required", "")
+	}
+
+	minioConfig, storageID, err := h.bindStreamConfig(c, true)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.ResolveStorageRef(storageID, &minioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+	unifiedClient, err := h.NewMinioClient(minioConfig)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_MINIO_CONFIG", "Invalid MinIO configuration", err.Error())
+	}
+
+	// 대용량 객체 전송이 서버 ReadTimeout/WriteTimeout에 끊기지 않도록 쓰기마다 유휴 데드라인 연장
+	deadline := h.NewIdleDeadline(c)
+
+	req := c.Request()
+	withBody := req.Method != http.MethodHead
+	download, err := h.service.OpenObjectInternal(unifiedClient, req.Context(), bucketName, objectName,
+		req.Header.Get("Range"), req.Header.Get("If-Range"), withBody)
+	if errors.Is(err, ErrRangeNotSatisfiable) {
+		c.Response().Header().Set("Content-Range", fmt.Sprintf("bytes */%d", download.Info.Size))
+		return response.RespondWithErrorModel(c, http.StatusRequestedRangeNotSatisfiable, "RANGE_NOT_SATISFIABLE", err.Error(), "")
+	}
+	if err != nil {
+		return h.HandleInternalError(c, "minio", "object download", err)
+	}
+	if download.Reader != nil {
+		defer download.Reader.Close()
+	}
 
-		return h.service.GetObjectInternal(client, ctx, bucketName, objectName)
+	// 응답 헤더 설정
+	info := download.Info
+	header := c.Response().Header()
+	contentType := info.ContentType
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+	header.Set(echo.HeaderContentType, contentType)
+	header.Set("Accept-Ranges", "bytes")
+	if info.ETag != "" {
+		header.Set("ETag", fmt.Sprintf("%q", info.ETag))
+	}
+	if !info.LastModified.IsZero() {
+		header.Set(echo.HeaderLastModified, info.LastModified.UTC().Format(http.TimeFormat))
+	}
+
+	status := http.StatusOK
+	length := info.Size
+	if download.Range != nil {
+		status = http.StatusPartialContent
+		length = download.Range.End - download.Range.Start + 1
+		header.Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", download.Range.Start, download.Range.End, info.Size))
+	}
+	header.Set(echo.HeaderContentLength, strconv.FormatInt(length, 10))
+	c.Response().WriteHeader(status)
+
+	if !withBody {
+		return nil
+	}
+
+	// 헤더 전송 후에는 에러 응답을 보낼 수 없으므로 로그만 기록
+	if _, err := io.Copy(deadline.Writer(c.Response()), download.Reader); err != nil {
+		logger.Error("Object download interrupted",
+			logger.String("bucket", bucketName),
+			logger.String("object", objectName),
+			logger.String("error", err.Error()),
+		)
+	}
+	return nil
+}
+
+// UploadObject : MinIO 객체 스트리밍 업로드
+// @Summary Upload Object (streaming)
+// @Description Stream the raw request body to MinIO using multipart chunks. Progress is reported as a job (X-Job-Id response header, or the uploadId query parameter chosen by the client).
+// @Tags minio
+// @Accept octet-stream
+// @Produce json
+// @Param bucket path string true "Bucket name"
+// @Param object path string true "Object name"
+// @Param X-Minio-Endpoint header string false "MinIO endpoint (required unless storageId is given)"
+// @Param X-Minio-Access-Key header string false "MinIO access key"
+// @Param X-Minio-Secret-Key header string false "MinIO secret key"
+// @Param X-Minio-Use-SSL header bool false "Use SSL"
+// @Param storageId query string false "Registered storage ID (instead of X-Minio-* headers)"
+// @Param X-Storage-Id header string false "Registered storage ID (alternative to the storageId query parameter)"
+// @Param uploadId query string false "Job ID used to track upload progress (default: generated)"
+// @Param partSizeMB query int false "Multipart chunk size in MiB (5-5120, default: 64)"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/buckets/{bucket}/objects/{object} [put]
+func (h *Handler) UploadObject(c echo.Context) error {
+	bucketName := c.Param("bucket")
+	objectName := c.Param("*")
+	if bucketName == "" || objectName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "bucket and object parameters are required", "")
+	}
+
+	// 요청 본문은 업로드 데이터이므로 설정은 헤더로만 전달
+	minioConfig, storageID, err := h.bindStreamConfig(c, false)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST", "Invalid MinIO configuration headers", err.Error())
+	}
+	if err := h.ResolveStorageRef(storageID, &minioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+	unifiedClient, err := h.NewMinioClient(minioConfig)
+	if err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_MINIO_CONFIG", "Invalid MinIO configuration", err.Error())
+	}
+
+	partSizeMB := h.ResolveInt(c, "partSizeMB", h.GetConfigInt("MINIO_UPLOAD_PART_SIZE_MB", 64))
+	if partSizeMB < 5 || partSizeMB > 5120 {
+		return response.RespondWithErrorModel(c, 400, "INVALID_PARAMETER", "partSizeMB must be between 5 and 5120", "")
+	}
+
+	jobID := c.QueryParam("uploadId")
+	if jobID == "" {
+		jobID = fmt.Sprintf("minio-upload-%d", time.Now().UnixNano())
+	}
+
+	req := c.Request()
+	objectSize := req.ContentLength // chunked 전송이면 -1
+	contentType := req.Header.Get(echo.HeaderContentType)
+	if contentType == "" {
+		contentType = echo.MIMEOctetStream
+	}
+
+	// 같은 uploadId로 동시에 요청해도 하나만 생성되도록 조회와 생성을 한 번에 처리
+	if _, created := h.jobManager.CreateJobIfAbsent(jobID, map[string]interface{}{
+		job.MetadataKeyType: "minio-upload", // uploadId는 사용자가 지정할 수 있으므로 종류를 명시
+		"bucket":            bucketName,
+		"object":            objectName,
+		"size":              objectSize,
+		"contentType":       contentType,
+	}); !created {
+		return response.RespondWithErrorModel(c, http.StatusConflict, "JOB_ALREADY_EXISTS", fmt.Sprintf("job '%s' already exists", jobID), "")
+	}
+	h.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 0, "Uploading object")
+	c.Response().Header().Set("X-Job-Id", jobID)
+
+	// 대용량 본문 수신이 서버 ReadTimeout/WriteTimeout에 끊기지 않도록 읽기마다 유휴 데드라인 연장 (상한은 MINIO_UPLOAD_TIMEOUT)
+	deadline := h.NewIdleDeadline(c)
+
+	// 작업 취소 시 업로드 중단
+	ctx, cancel := h.jobManager.JobContext(jobID, h.GetConfigDuration("MINIO_UPLOAD_TIMEOUT", 6*time.Hour))
+	defer cancel()
+
+	progress := newUploadProgress(h.jobManager, jobID, objectSize)
+	result, err := h.service.PutObjectStreamInternal(unifiedClient, ctx, bucketName, objectName, deadline.Reader(req.Body), objectSize, minio.PutObjectOptions{
+		ContentType: contentType,
+		PartSize:    uint64(partSizeMB) * 1024 * 1024,
+		Progress:    progress.report,
 	})
+	if err != nil {
+		h.jobManager.FailJob(jobID, err)
+		return h.HandleInternalError(c, "minio", "object upload", err)
+	}
+
+	h.jobManager.CompleteJob(jobID, result)
+	result["jobId"] = jobID
+	result["statusUrl"] = fmt.Sprintf("/api/v1/minio/status/%s", jobID)
+
+	return response.RespondWithData(c, 200, result)
 }
 
 // PutObject : 객체 업로드
 // @Summary Put Object
-// @Description Upload an object to MinIO bucket
+// @Description Upload an object to MinIO bucket using a multipart form. Use PUT with a raw request body for large objects.
 // @Tags minio
 // @Accept multipart/form-data
 // @Produce json
@@ -204,7 +629,7 @@ func (h *Handler) GetObject(c echo.Context) error {
 // @Success 200 {object} response.SuccessResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /v1/minio/buckets/{bucket}/objects/{object} [put]
+// @Router /v1/minio/buckets/{bucket}/objects/{object} [post]
 func (h *Handler) PutObject(c echo.Context) error {
 	return h.HandleResourceClient(c, "put-object", func(client client.Client, ctx context.Context) (interface{}, error) {
 		// 버킷 이름과 객체 이름 가져오기
@@ -256,7 +681,7 @@ func (h *Handler) DeleteObject(c echo.Context) error {
 // @Success 200 {object} response.SuccessResponse
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
-// @Router /v1/minio/buckets/{bucket}/objects/{object}/stat [get]
+// @Router /v1/minio/buckets/{bucket}/stat/{object} [get]
 func (h *Handler) StatObject(c echo.Context) error {
 	return h.HandleResourceClient(c, "stat-object", func(client client.Client, ctx context.Context) (interface{}, error) {
 		// 버킷 이름과 객체 이름 가져오기
@@ -302,7 +727,7 @@ func (h *Handler) CopyObject(c echo.Context) error {
 
 // PresignedGetObject : MinIO 객체 미리 서명된 다운로드 URL 생성
 // @Summary Presigned Get Object
-// @Description Generate a presigned URL for downloading an object
+// @Description Generate a presigned URL for downloading an object (operator role)
 // @Tags minio
 // @Accept json
 // @Produce json
@@ -411,3 +836,233 @@ func (h *Handler) ListObjectsInFolder(c echo.Context) error {
 		return h.service.ListObjectsInFolderInternal(client, ctx, bucketName, folderPath)
 	})
 }
+
+// StartReplication : 버킷 간 객체 복제 시작
+// @Summary Start Replication
+// @Description Mirror objects under the given prefixes from a source bucket to a destination bucket, possibly on another MinIO endpoint, as an async job. Unchanged objects are skipped and transferred data is verified against the source ETag.
+// @Tags minio
+// @Accept json
+// @Produce json
+// @Param request body types.ReplicationRequest true "Source and destination with prefixes"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/minio/replications [post]
+func (h *Handler) StartReplication(c echo.Context) error {
+	var req types.ReplicationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.service.ValidateReplicationRequest(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REPLICATION", "Invalid replication specification", err.Error())
+	}
+
+	sourceClient, err := h.NewMinioClient(req.Source.MinioConfig)
+	if err != nil {
+		return h.HandleConnectionError(c, "minio", "source client creation", err)
+	}
+	destinationClient, err := h.NewMinioClient(req.Destination.MinioConfig)
+	if err != nil {
+		return h.HandleConnectionError(c, "minio", "destination client creation", err)
+	}
+
+	jobID := fmt.Sprintf("minio-replication-%d", time.Now().UnixNano())
+	jobInfo := h.jobManager.CreateJob(jobID, map[string]interface{}{
+		"operation":   "replication",
+		"source":      fmt.Sprintf("%s/%s", req.Source.MinioConfig.Endpoint, req.Source.Bucket),
+		"destination": fmt.Sprintf("%s/%s", req.Destination.MinioConfig.Endpoint, req.Destination.Bucket),
+		"prefixes":    req.Prefixes,
+	})
+
+	go h.runReplication(jobID, sourceClient, destinationClient, req)
+
+	return response.RespondWithData(c, 200, map[string]interface{}{
+		"status":    "processing",
+		"jobId":     jobID,
+		"message":   "Replication started",
+		"statusUrl": fmt.Sprintf("/api/v1/minio/status/%s", jobID),
+		"streamUrl": fmt.Sprintf("/api/v1/minio/jobs/%s/stream", jobID),
+		"job":       jobInfo,
+	})
+}
+
+// runReplication : 백그라운드에서 복제 실행 후 작업 상태 반영
+func (h *Handler) runReplication(jobID string, source, destination client.Client, req types.ReplicationRequest) {
+	ctx, cancel := h.jobManager.JobContext(jobID, h.GetConfigDuration("MINIO_REPLICATION_TIMEOUT", 24*time.Hour))
+	defer cancel()
+
+	h.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 0, "Listing source objects")
+
+	// 진행률이 바뀔 때만 갱신 (호출은 직렬화되어 있음)
+	lastPercent := -1
+	result, err := h.service.ReplicateInternal(ctx, source, destination, req, func(progress ReplicationProgress) {
+		percent := progress.Percent()
+		if percent == lastPercent {
+			return
+		}
+		lastPercent = percent
+		if percent >= 100 {
+			// 완료 처리는 CompleteJob에서 수행
+			percent = 99
+		}
+		h.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, percent,
+			fmt.Sprintf("Replicated %d of %d objects (%d skipped, %d failed)",
+				progress.CopiedObjects+progress.SkippedObjects, progress.TotalObjects,
+				progress.SkippedObjects, progress.FailedObjects))
+		h.jobManager.SetJobMetadata(jobID, "progress", progress)
+	})
+	if err != nil {
+		if result != nil {
+			h.jobManager.SetJobMetadata(jobID, "result", result)
+		}
+		h.jobManager.FailJob(jobID, err)
+		return
+	}
+
+	h.jobManager.CompleteJob(jobID, result)
+	h.jobManager.AddJobLog(jobID, fmt.Sprintf("Replicated %d objects (%d bytes), skipped %d unchanged objects",
+		result.CopiedObjects, result.CopiedBytes, result.SkippedObjects))
+}
+
+// GetJobStatus : 업로드/복제 작업 상태 조회
+// @Summary Get Job Status
+// @Description Get the progress of a streaming upload or replication job
+// @Tags minio
+// @Produce json
+// @Param jobId path string true "Job ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /v1/minio/status/{jobId} [get]
+func (h *Handler) GetJobStatus(c echo.Context) error {
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "jobId is required", "")
+	}
+
+	jobInfo, exists := h.jobManager.GetJob(jobID)
+	if !exists {
+		return response.RespondWithErrorModel(c, 404, "JOB_NOT_FOUND", fmt.Sprintf("job not found: %s", jobID), "")
+	}
+
+	return response.RespondWithData(c, 200, jobInfo)
+}
+
+// CancelJob : 진행 중인 업로드/복제 취소
+// @Summary Cancel Job
+// @Description Abort a running streaming upload or replication. Uploaded multipart chunks are discarded; objects already replicated are kept.
+// @Tags minio
+// @Produce json
+// @Param jobId path string true "Job ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /v1/minio/jobs/{jobId}/cancel [post]
+func (h *Handler) CancelJob(c echo.Context) error {
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "jobId is required", "")
+	}
+
+	if err := h.jobManager.CancelJob(jobID); err != nil {
+		return h.HandleJobCancelError(c, err)
+	}
+
+	jobInfo, _ := h.jobManager.GetJob(jobID)
+	return response.RespondWithData(c, 200, jobInfo)
+}
+
+// StreamJob : 업로드/복제 진행 상황 실시간 스트리밍 (Server-Sent Events)
+// @Summary Stream Job Progress
+// @Description Stream upload or replication progress as Server-Sent Events. Resume with the Last-Event-ID header.
+// @Tags minio
+// @Produce text/event-stream
+// @Param jobId path string true "Job ID"
+// @Param Last-Event-ID header string false "Number of log lines already received"
+// @Success 200 {string} string "Event stream"
+// @Failure 404 {object} map[string]interface{} "Job not found"
+// @Router /v1/minio/jobs/{jobId}/stream [get]
+func (h *Handler) StreamJob(c echo.Context) error {
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "jobId is required", "")
+	}
+
+	return h.BaseHandler.StreamJob(c, h.jobManager, jobID)
+}
+
+// bindStreamConfig : 스트리밍 API용 MinIO 설정과 등록 스토리지 ID
+// 설정은 X-Minio-* 헤더(allowBody이면 JSON 본문도 허용), 스토리지 ID는 storageId 쿼리 파라미터 또는 X-Storage-Id 헤더로 전달
+func (h *Handler) bindStreamConfig(c echo.Context, allowBody bool) (config.MinioConfig, string, error) {
+	header := c.Request().Header
+	minioConfig := config.MinioConfig{
+		Endpoint:  header.Get("X-Minio-Endpoint"),
+		AccessKey: header.Get("X-Minio-Access-Key"),
+		SecretKey: header.Get("X-Minio-Secret-Key"),
+		UseSSL:    h.StringToBoolOrDefault(header.Get("X-Minio-Use-SSL"), false),
+	}
+	storageID := c.QueryParam("storageId")
+	if storageID == "" {
+		storageID = header.Get("X-Storage-Id")
+	}
+
+	if minioConfig.Endpoint == "" && storageID == "" && allowBody && c.Request().ContentLength != 0 {
+		var req struct {
+			config.MinioConfig
+			StorageID string `json:"storageId"`
+		}
+		if err := c.Bind(&req); err != nil {
+			return minioConfig, storageID, err
+		}
+		minioConfig, storageID = req.MinioConfig, req.StorageID
+	}
+
+	return minioConfig, storageID, nil
+}
+
+// 업로드 진행 상황 갱신 간격 (크기를 모르는 업로드)
+const uploadProgressInterval = 16 * 1024 * 1024
+
+// uploadProgress : 업로드 진행 상황을 작업 관리자에 반영 (진행률 1% 또는 16MiB 단위로 갱신)
+type uploadProgress struct {
+	mu         sync.Mutex
+	jobManager job.JobManager
+	jobID      string
+	total      int64 // -1이면 크기 미상
+	lastReport int64
+	lastPct    int
+}
+
+// newUploadProgress : 업로드 진행 상황 추적기 생성
+func newUploadProgress(jobManager job.JobManager, jobID string, total int64) *uploadProgress {
+	return &uploadProgress{jobManager: jobManager, jobID: jobID, total: total}
+}
+
+// report : 누적 업로드 바이트 반영
+func (p *uploadProgress) report(uploaded int64) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.total > 0 {
+		pct := int(uploaded * 100 / p.total)
+		if pct >= 100 {
+			// 완료 처리는 업로드 응답 후 CompleteJob에서 수행
+			pct = 99
+		}
+		if pct <= p.lastPct && uploaded-p.lastReport < uploadProgressInterval {
+			return
+		}
+		p.lastPct = pct
+		p.lastReport = uploaded
+		p.jobManager.UpdateJobStatus(p.jobID, job.JobStatusProcessing, pct,
+			fmt.Sprintf("Uploaded %d of %d bytes", uploaded, p.total))
+		p.jobManager.SetJobMetadata(p.jobID, "uploadedBytes", uploaded)
+		return
+	}
+
+	if uploaded-p.lastReport < uploadProgressInterval {
+		return
+	}
+	p.lastReport = uploaded
+	p.jobManager.UpdateJobStatus(p.jobID, job.JobStatusProcessing, 0, fmt.Sprintf("Uploaded %d bytes", uploaded))
+	p.jobManager.SetJobMetadata(p.jobID, "uploadedBytes", uploaded)
+}
@@ -2,14 +2,28 @@ package minio
 
 import (
 	"bytes"
+	"context"
+	"crypto/md5"
 	"encoding/json"
+	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	miniosdk "github.com/minio/minio-go/v7"
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/middleware"
+	"github.com/taking/kubemigrate/internal/mocks"
+	"github.com/taking/kubemigrate/internal/registry"
+	minioclient "github.com/taking/kubemigrate/pkg/client/minio"
+	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
 )
 
 // TestMinioHandler_HealthCheck 헬스체크 API 테스트
@@ -261,3 +275,634 @@ func TestMinioHandler_InvalidRequest(t *testing.T) {
 		t.Log("No error occurred, but this is acceptable for this test")
 	}
 }
+
+// TestMinioHandler_GetObjectRange Range 요청 스트리밍 다운로드 테스트
+func TestMinioHandler_GetObjectRange(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	minioHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	tests := []struct {
+		name         string
+		rangeHeader  string
+		ifRange      string
+		wantStatus   int
+		wantBody     string
+		contentRange string
+	}{
+		{name: "전체 객체", wantStatus: http.StatusOK, wantBody: "test data"},
+		{name: "이어받기", rangeHeader: "bytes=5-", wantStatus: http.StatusPartialContent, wantBody: "data", contentRange: "bytes 5-8/9"},
+		{name: "접미사 Range", rangeHeader: "bytes=-4", wantStatus: http.StatusPartialContent, wantBody: "data", contentRange: "bytes 5-8/9"},
+		{name: "ETag 불일치 시 전체 객체", rangeHeader: "bytes=5-", ifRange: `"other-etag"`, wantStatus: http.StatusOK, wantBody: "test data"},
+		{name: "범위 초과", rangeHeader: "bytes=100-", wantStatus: http.StatusRequestedRangeNotSatisfiable, contentRange: "bytes */9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/minio/buckets/backups/objects/cluster-a/backup.tar.gz", nil)
+			req.Header.Set("X-Minio-Endpoint", "localhost:9000")
+			req.Header.Set("X-Minio-Access-Key", "minioadmin")
+			req.Header.Set("X-Minio-Secret-Key", "minioadmin123")
+			if tt.rangeHeader != "" {
+				req.Header.Set("Range", tt.rangeHeader)
+			}
+			if tt.ifRange != "" {
+				req.Header.Set("If-Range", tt.ifRange)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("bucket", "*")
+			c.SetParamValues("backups", "cluster-a/backup.tar.gz")
+
+			if err := minioHandler.GetObject(c); err != nil {
+				t.Fatalf("GetObject() error = %v", err)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("Expected status %d, got %d (%s)", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+			if tt.wantBody != "" && rec.Body.String() != tt.wantBody {
+				t.Errorf("Expected body %q, got %q", tt.wantBody, rec.Body.String())
+			}
+			if got := rec.Header().Get("Content-Range"); got != tt.contentRange {
+				t.Errorf("Expected Content-Range %q, got %q", tt.contentRange, got)
+			}
+			if tt.wantStatus != http.StatusRequestedRangeNotSatisfiable && rec.Header().Get("ETag") != `"mock-etag"` {
+				t.Errorf("Expected ETag header, got %q", rec.Header().Get("ETag"))
+			}
+		})
+	}
+}
+
+// TestMinioHandler_UploadObject 원본 본문 스트리밍 업로드 및 작업 진행 상황 테스트
+func TestMinioHandler_UploadObject(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	minioHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+	payload := bytes.Repeat([]byte("x"), 1024)
+
+	req := httptest.NewRequest(http.MethodPut, "/api/v1/minio/buckets/backups/objects/backup.tar.gz?uploadId=upload-1", bytes.NewReader(payload))
+	req.Header.Set(echo.HeaderContentType, "application/gzip")
+	req.Header.Set("X-Minio-Endpoint", "localhost:9000")
+	req.Header.Set("X-Minio-Access-Key", "minioadmin")
+	req.Header.Set("X-Minio-Secret-Key", "minioadmin123")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+	c.SetParamNames("bucket", "*")
+	c.SetParamValues("backups", "backup.tar.gz")
+
+	if err := minioHandler.UploadObject(c); err != nil {
+		t.Fatalf("UploadObject() error = %v", err)
+	}
+	if rec.Code != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d (%s)", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	if rec.Header().Get("X-Job-Id") != "upload-1" {
+		t.Errorf("Expected X-Job-Id header upload-1, got %q", rec.Header().Get("X-Job-Id"))
+	}
+
+	jobInfo, exists := minioHandler.jobManager.GetJob("upload-1")
+	if !exists {
+		t.Fatal("Expected upload job to be created")
+	}
+	if jobInfo.Status != job.JobStatusCompleted {
+		t.Errorf("Expected job status completed, got %s", jobInfo.Status)
+	}
+	if jobInfo.Metadata["uploadedBytes"] != int64(len(payload)) {
+		t.Errorf("Expected uploadedBytes %d, got %v", len(payload), jobInfo.Metadata["uploadedBytes"])
+	}
+
+	// 같은 uploadId 재사용 불가
+	req = httptest.NewRequest(http.MethodPut, "/api/v1/minio/buckets/backups/objects/backup.tar.gz?uploadId=upload-1", bytes.NewReader(payload))
+	req.Header.Set("X-Minio-Endpoint", "localhost:9000")
+	req.Header.Set("X-Minio-Access-Key", "minioadmin")
+	req.Header.Set("X-Minio-Secret-Key", "minioadmin123")
+	rec = httptest.NewRecorder()
+	c = e.NewContext(req, rec)
+	c.SetParamNames("bucket", "*")
+	c.SetParamValues("backups", "backup.tar.gz")
+
+	if err := minioHandler.UploadObject(c); err != nil {
+		t.Fatalf("UploadObject() error = %v", err)
+	}
+	if rec.Code != http.StatusConflict {
+		t.Errorf("Expected status %d for duplicate uploadId, got %d", http.StatusConflict, rec.Code)
+	}
+}
+
+// TestMinioHandler_UploadObjectConcurrentID 같은 uploadId로 동시에 업로드하면 하나만 진행되는지 테스트
+func TestMinioHandler_UploadObjectConcurrentID(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	minioHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+	const uploads = 8
+	codes := make([]int, uploads)
+
+	var wg sync.WaitGroup
+	for i := 0; i < uploads; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/minio/buckets/backups/objects/backup.tar.gz?uploadId=shared-upload", strings.NewReader("test data"))
+			req.Header.Set("X-Minio-Endpoint", "localhost:9000")
+			req.Header.Set("X-Minio-Access-Key", "minioadmin")
+			req.Header.Set("X-Minio-Secret-Key", "minioadmin123")
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("bucket", "*")
+			c.SetParamValues("backups", "backup.tar.gz")
+
+			if err := minioHandler.UploadObject(c); err != nil {
+				t.Errorf("UploadObject() error = %v", err)
+			}
+			codes[i] = rec.Code
+		}(i)
+	}
+	wg.Wait()
+
+	accepted := 0
+	for _, code := range codes {
+		switch code {
+		case http.StatusOK:
+			accepted++
+		case http.StatusConflict:
+		default:
+			t.Errorf("Expected status 200 or 409, got %d", code)
+		}
+	}
+	if accepted != 1 {
+		t.Errorf("Expected exactly one upload to be accepted, got %d", accepted)
+	}
+}
+
+// TestMinioHandler_StreamingStorageRef 스트리밍 업로드/다운로드의 storageId 해석 테스트
+func TestMinioHandler_StreamingStorageRef(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	minioHandler := NewHandler(baseHandler)
+
+	if _, err := baseHandler.Registry.CreateStorage(context.Background(), registry.Storage{ID: "backup-minio", Minio: config.MinioConfig{
+		Endpoint: "localhost:9000", AccessKey: "minioadmin", SecretKey: "minioadmin123",
+	}}); err != nil {
+		t.Fatalf("CreateStorage() error = %v", err)
+	}
+
+	e := echo.New()
+	tests := []struct {
+		name       string
+		method     string
+		target     string
+		headers    map[string]string
+		wantStatus int
+	}{
+		{name: "업로드 (쿼리)", method: http.MethodPut, target: "?storageId=backup-minio&uploadId=ref-upload-1", wantStatus: http.StatusOK},
+		{name: "업로드 (헤더)", method: http.MethodPut, target: "?uploadId=ref-upload-2", headers: map[string]string{"X-Storage-Id": "backup-minio"}, wantStatus: http.StatusOK},
+		{name: "업로드 미등록 ID", method: http.MethodPut, target: "?storageId=unknown&uploadId=ref-upload-3", wantStatus: http.StatusNotFound},
+		{name: "업로드 설정과 ID 동시 지정", method: http.MethodPut, target: "?storageId=backup-minio&uploadId=ref-upload-4", headers: map[string]string{"X-Minio-Endpoint": "localhost:9000"}, wantStatus: http.StatusBadRequest},
+		{name: "다운로드 (쿼리)", method: http.MethodGet, target: "?storageId=backup-minio", wantStatus: http.StatusOK},
+		{name: "다운로드 (헤더)", method: http.MethodGet, headers: map[string]string{"X-Storage-Id": "backup-minio"}, wantStatus: http.StatusOK},
+		{name: "다운로드 미등록 ID", method: http.MethodGet, target: "?storageId=unknown", wantStatus: http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var body io.Reader
+			if tt.method == http.MethodPut {
+				body = strings.NewReader("test data")
+			}
+			req := httptest.NewRequest(tt.method, "/api/v1/minio/buckets/backups/objects/backup.tar.gz"+tt.target, body)
+			for key, value := range tt.headers {
+				req.Header.Set(key, value)
+			}
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("bucket", "*")
+			c.SetParamValues("backups", "backup.tar.gz")
+
+			var err error
+			if tt.method == http.MethodPut {
+				err = minioHandler.UploadObject(c)
+			} else {
+				err = minioHandler.GetObject(c)
+			}
+			if err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d (%s)", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestMinioHandler_SetBucketLifecycle 버킷 수명 주기 규칙 교체 및 검증 테스트
+func TestMinioHandler_SetBucketLifecycle(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	minioHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+	minioConfig := map[string]interface{}{
+		"endpoint":  "localhost:9000",
+		"accessKey": "minioadmin",
+		"secretKey": "minioadmin123",
+	}
+
+	tests := []struct {
+		name       string
+		rules      []map[string]interface{}
+		wantStatus int
+	}{
+		{
+			name:       "만료 규칙",
+			rules:      []map[string]interface{}{{"prefix": "backups/", "enabled": true, "expirationDays": 30, "abortIncompleteUploadDays": 7}},
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "동작이 없는 규칙",
+			rules:      []map[string]interface{}{{"id": "noop", "enabled": true}},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "중복 규칙 ID",
+			rules: []map[string]interface{}{
+				{"id": "expire", "enabled": true, "expirationDays": 30},
+				{"id": "expire", "enabled": true, "noncurrentExpirationDays": 7},
+			},
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name:       "만료 일수와 삭제 마커 정리 동시 지정",
+			rules:      []map[string]interface{}{{"enabled": true, "expirationDays": 30, "expireDeleteMarkers": true}},
+			wantStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqBody, _ := json.Marshal(map[string]interface{}{"minio": minioConfig, "rules": tt.rules})
+			req := httptest.NewRequest(http.MethodPut, "/api/v1/minio/buckets/backups/lifecycle", bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+			c.SetParamNames("bucket")
+			c.SetParamValues("backups")
+
+			if err := minioHandler.SetBucketLifecycle(c); err != nil {
+				t.Fatalf("SetBucketLifecycle() error = %v", err)
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("Expected status %d, got %d (%s)", tt.wantStatus, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestService_ValidateBucketRetentionRequest 버킷 보존 설정 검증 테스트
+func TestService_ValidateBucketRetentionRequest(t *testing.T) {
+	service := NewService(nil)
+
+	tests := []struct {
+		name     string
+		req      types.BucketRetentionRequest
+		wantErr  bool
+		wantUnit string
+	}{
+		{name: "기본 보존 해제", req: types.BucketRetentionRequest{}, wantErr: false},
+		{name: "단위 기본값", req: types.BucketRetentionRequest{Mode: "compliance", Validity: 30}, wantErr: false, wantUnit: "DAYS"},
+		{name: "연 단위", req: types.BucketRetentionRequest{Mode: "GOVERNANCE", Validity: 1, Unit: "years"}, wantErr: false, wantUnit: "YEARS"},
+		{name: "지원하지 않는 모드", req: types.BucketRetentionRequest{Mode: "LEGAL_HOLD", Validity: 30}, wantErr: true},
+		{name: "보존 기간 누락", req: types.BucketRetentionRequest{Mode: "COMPLIANCE"}, wantErr: true},
+		{name: "지원하지 않는 단위", req: types.BucketRetentionRequest{Mode: "COMPLIANCE", Validity: 30, Unit: "MONTHS"}, wantErr: true},
+		{name: "모드 없이 보존 기간 지정", req: types.BucketRetentionRequest{Validity: 30}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := service.ValidateBucketRetentionRequest(&tt.req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ValidateBucketRetentionRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantUnit != "" && tt.req.Unit != tt.wantUnit {
+				t.Errorf("Expected unit %s, got %s", tt.wantUnit, tt.req.Unit)
+			}
+		})
+	}
+}
+
+// memoryMinioClient : 복제 테스트용 메모리 기반 MinIO 클라이언트
+type memoryMinioClient struct {
+	*mocks.MockMinioClient
+	mu      sync.Mutex
+	objects map[string]minioclient.ObjectInfo
+	data    map[string]string
+	puts    int
+}
+
+func newMemoryMinioClient() *memoryMinioClient {
+	return &memoryMinioClient{
+		MockMinioClient: &mocks.MockMinioClient{},
+		objects:         map[string]minioclient.ObjectInfo{},
+		data:            map[string]string{},
+	}
+}
+
+func (m *memoryMinioClient) add(key, data, etag string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.objects[key] = minioclient.ObjectInfo{Key: key, Size: int64(len(data)), ETag: etag}
+	m.data[key] = data
+}
+
+func (m *memoryMinioClient) ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) ([]minioclient.ObjectInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var objects []minioclient.ObjectInfo
+	for key, object := range m.objects {
+		if strings.HasPrefix(key, prefix) {
+			objects = append(objects, object)
+		}
+	}
+	return objects, nil
+}
+
+func (m *memoryMinioClient) HeadObject(ctx context.Context, bucketName, objectName string) (*minioclient.ObjectInfo, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	object, exists := m.objects[objectName]
+	if !exists {
+		return nil, miniosdk.ErrorResponse{Code: "NoSuchKey"}
+	}
+	return &object, nil
+}
+
+func (m *memoryMinioClient) GetObjectStream(ctx context.Context, bucketName, objectName string, opts minioclient.GetObjectOptions) (io.ReadCloser, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return io.NopCloser(strings.NewReader(m.data[objectName])), nil
+}
+
+func (m *memoryMinioClient) PutObjectStream(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minioclient.PutObjectOptions) (*minioclient.ObjectInfo, error) {
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.puts++
+	m.data[objectName] = string(data)
+	m.objects[objectName] = minioclient.ObjectInfo{
+		Key:          objectName,
+		Size:         int64(len(data)),
+		ETag:         fmt.Sprintf("replica-%d", m.puts),
+		UserMetadata: opts.UserMetadata,
+	}
+	object := m.objects[objectName]
+	return &object, nil
+}
+
+func (m *memoryMinioClient) DeleteObject(ctx context.Context, bucketName, objectName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.objects, objectName)
+	delete(m.data, objectName)
+	return nil
+}
+
+// memoryClient : memoryMinioClient를 반환하는 통합 클라이언트
+type memoryClient struct {
+	*mocks.MockClient
+	minio *memoryMinioClient
+}
+
+func (c *memoryClient) Minio() minioclient.Client {
+	return c.minio
+}
+
+// TestService_ReplicateInternal 증분 복제 및 체크섬 검증 테스트
+func TestService_ReplicateInternal(t *testing.T) {
+	service := NewService(nil)
+	source := newMemoryMinioClient()
+	destination := newMemoryMinioClient()
+
+	source.add("backups/daily/velero-backup.json", "backup metadata", "9a5b1ab1d7e9ff3c08bd6e6bd3d1ab3d")
+	source.add("backups/daily/resources.tar.gz", "resources", "5d41402abc4b2a76b9719d911017c592-2")
+	source.add("restic/pack-1", "pack", fmt.Sprintf("%x", md5.Sum([]byte("pack"))))
+	source.add("other/ignored", "ignored", "ignored-etag")
+
+	req := types.ReplicationRequest{
+		Source:      types.ReplicationEndpoint{MinioConfig: config.MinioConfig{Endpoint: "dc1:9000"}, Bucket: "velero"},
+		Destination: types.ReplicationEndpoint{MinioConfig: config.MinioConfig{Endpoint: "dc2:9000"}, Bucket: "velero"},
+		Prefixes:    []string{"backups/daily/", "/restic/", "backups/"},
+		Concurrency: 2,
+	}
+	if err := service.ValidateReplicationRequest(&req); err != nil {
+		t.Fatalf("ValidateReplicationRequest() error = %v", err)
+	}
+
+	sourceClient := &memoryClient{MockClient: mocks.NewMockClient(), minio: source}
+	destinationClient := &memoryClient{MockClient: mocks.NewMockClient(), minio: destination}
+
+	// 첫 번째 실행: 단일 파트 객체의 MD5가 원본 ETag와 다르면 실패하고 대상 객체는 삭제됨
+	result, err := service.ReplicateInternal(context.Background(), sourceClient, destinationClient, req, nil)
+	if err == nil {
+		t.Fatal("Expected checksum mismatch error")
+	}
+	if result.TotalObjects != 3 || result.CopiedObjects != 2 || result.FailedObjects != 1 {
+		t.Fatalf("Unexpected result: %+v", result.ReplicationProgress)
+	}
+	if len(result.Failures) != 1 || result.Failures[0].Key != "backups/daily/velero-backup.json" {
+		t.Errorf("Unexpected failures: %v", result.Failures)
+	}
+	if _, exists := destination.objects["backups/daily/velero-backup.json"]; exists {
+		t.Error("Expected corrupt replica to be removed")
+	}
+	if _, exists := destination.objects["other/ignored"]; exists {
+		t.Error("Expected objects outside the prefixes to be ignored")
+	}
+
+	// 두 번째 실행: 원본 ETag를 바로잡으면 실패한 객체만 복사하고 나머지는 건너뜀
+	source.add("backups/daily/velero-backup.json", "backup metadata", fmt.Sprintf("%x", md5.Sum([]byte("backup metadata"))))
+	var last ReplicationProgress
+	result, err = service.ReplicateInternal(context.Background(), sourceClient, destinationClient, req, func(p ReplicationProgress) {
+		last = p
+	})
+	if err != nil {
+		t.Fatalf("ReplicateInternal() error = %v", err)
+	}
+	if result.CopiedObjects != 1 || result.SkippedObjects != 2 {
+		t.Errorf("Expected 1 copied and 2 skipped objects, got %+v", result.ReplicationProgress)
+	}
+	if last.Percent() != 100 {
+		t.Errorf("Expected final progress 100%%, got %d", last.Percent())
+	}
+	// 첫 번째 실행 3회 (검증 실패 1회 포함) + 두 번째 실행 1회
+	if destination.puts != 4 {
+		t.Errorf("Expected 4 uploads in total, got %d", destination.puts)
+	}
+}
+
+// TestService_ValidateReplicationRequest 복제 요청 검증 테스트
+func TestService_ValidateReplicationRequest(t *testing.T) {
+	service := NewService(nil)
+	endpoint := func(host, bucket string) types.ReplicationEndpoint {
+		return types.ReplicationEndpoint{MinioConfig: config.MinioConfig{Endpoint: host}, Bucket: bucket}
+	}
+
+	req := types.ReplicationRequest{Source: endpoint("dc1:9000", "velero"), Destination: endpoint("dc1:9000", "velero-copy")}
+	if err := service.ValidateReplicationRequest(&req); err != nil {
+		t.Fatalf("ValidateReplicationRequest() error = %v", err)
+	}
+	if req.Concurrency != defaultReplicationConcurrency || !*req.VerifyChecksum || !*req.SkipUnchanged {
+		t.Errorf("Expected defaults to be applied, got %+v", req)
+	}
+	if len(req.Prefixes) != 1 || req.Prefixes[0] != "" {
+		t.Errorf("Expected whole bucket prefix, got %v", req.Prefixes)
+	}
+
+	same := types.ReplicationRequest{Source: endpoint("dc1:9000", "velero"), Destination: endpoint("dc1:9000", "velero")}
+	if err := service.ValidateReplicationRequest(&same); err == nil {
+		t.Error("Expected error for identical source and destination")
+	}
+
+	tooMany := types.ReplicationRequest{Source: endpoint("dc1:9000", "velero"), Destination: endpoint("dc2:9000", "velero"), Concurrency: 64}
+	if err := service.ValidateReplicationRequest(&tooMany); err == nil {
+		t.Error("Expected error for concurrency above the limit")
+	}
+}
+
+// slowReader : 지정한 간격으로 조금씩 데이터를 보내는 업로드 본문
+type slowReader struct {
+	remaining int
+	chunk     int
+	interval  time.Duration
+}
+
+func (r *slowReader) Read(p []byte) (int, error) {
+	if r.remaining == 0 {
+		return 0, io.EOF
+	}
+	time.Sleep(r.interval)
+	n := min(len(p), r.chunk, r.remaining)
+	copy(p, bytes.Repeat([]byte("x"), n))
+	r.remaining -= n
+	return n, nil
+}
+
+// TestMinioHandler_StreamingBypassesTimeouts 객체 업로드/다운로드가 요청 타임아웃, 서버 Read/WriteTimeout, Gzip을 거치지 않고
+// 유휴 제한 시간(STREAM_IDLE_TIMEOUT)만 적용되는지 테스트
+func TestMinioHandler_StreamingBypassesTimeouts(t *testing.T) {
+	t.Setenv("STREAM_IDLE_TIMEOUT", "250ms")
+
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	minioHandler := NewHandler(baseHandler)
+
+	cfg := &config.Config{
+		Timeouts: config.TimeoutConfig{Request: 100 * time.Millisecond},
+		Auth:     config.AuthConfig{Mode: "none"},
+	}
+	e := echo.New()
+	middleware.SetupMiddleware(e, cfg, nil)
+	e.PUT("/api/v1/minio/buckets/:bucket/objects/*", minioHandler.UploadObject)
+	e.GET("/api/v1/minio/buckets/:bucket/objects/*", minioHandler.GetObject)
+
+	server := httptest.NewUnstartedServer(e)
+	server.Config.ReadTimeout = 300 * time.Millisecond
+	server.Config.WriteTimeout = 300 * time.Millisecond
+	server.Start()
+	defer server.Close()
+
+	setHeaders := func(req *http.Request) {
+		req.Header.Set("X-Minio-Endpoint", "localhost:9000")
+		req.Header.Set("X-Minio-Access-Key", "minioadmin")
+		req.Header.Set("X-Minio-Secret-Key", "minioadmin123")
+	}
+
+	// 타임아웃보다 오래 걸리는 chunked 업로드 (1초 이상)
+	body := &slowReader{remaining: 10 * 64 * 1024, chunk: 64 * 1024, interval: 100 * time.Millisecond}
+	req, err := http.NewRequest(http.MethodPut, server.URL+"/api/v1/minio/buckets/backups/objects/backup.tar.gz?uploadId=slow-upload", body)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	setHeaders(req)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("Upload request error = %v", err)
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("Expected status %d, got %d (%s)", http.StatusOK, resp.StatusCode, respBody)
+	}
+
+	var uploaded struct {
+		Data struct {
+			UploadInfo struct {
+				Size int64 `json:"size"`
+			} `json:"uploadInfo"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &uploaded); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if want := int64(10 * 64 * 1024); uploaded.Data.UploadInfo.Size != want {
+		t.Errorf("Expected uploaded size %d, got %d", want, uploaded.Data.UploadInfo.Size)
+	}
+
+	// 다운로드는 압축 없이 Content-Length 그대로 전송
+	req, err = http.NewRequest(http.MethodGet, server.URL+"/api/v1/minio/buckets/backups/objects/backup.tar.gz", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	setHeaders(req)
+	req.Header.Set("Accept-Encoding", "gzip")
+	resp, err = http.DefaultTransport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("Download request error = %v", err)
+	}
+	defer resp.Body.Close()
+	if got := resp.Header.Get(echo.HeaderContentEncoding); got != "" {
+		t.Errorf("Expected uncompressed object download, got Content-Encoding %q", got)
+	}
+	if data, _ := io.ReadAll(resp.Body); string(data) != "test data" {
+		t.Errorf("Expected object content %q, got %q", "test data", data)
+	}
+	// 유휴 제한 시간보다 오래 멈춘 업로드는 연결이 끊기고 작업은 실패 처리
+	body = &slowReader{remaining: 2 * 64 * 1024, chunk: 64 * 1024, interval: 800 * time.Millisecond}
+	req, err = http.NewRequest(http.MethodPut, server.URL+"/api/v1/minio/buckets/backups/objects/backup.tar.gz?uploadId=stalled-upload", body)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	setHeaders(req)
+	if resp, err := http.DefaultClient.Do(req); err == nil {
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			t.Fatal("Expected stalled upload to be cut off by the idle timeout")
+		}
+	}
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		jobInfo, exists := minioHandler.jobManager.GetJob("stalled-upload")
+		if exists && jobInfo.Status == job.JobStatusFailed {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("Expected stalled upload job to fail, got %+v", jobInfo)
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+}
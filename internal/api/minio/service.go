@@ -2,12 +2,23 @@ package minio
 
 import (
 	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/pkg/client"
 	"github.com/taking/kubemigrate/pkg/client/minio"
+	"github.com/taking/kubemigrate/pkg/types"
 )
 
 // Service : MinIO 관련 비즈니스 로직
@@ -48,18 +59,19 @@ func (s *Service) CheckBucketExistsInternal(client client.Client, ctx context.Co
 	}, nil
 }
 
-// CreateBucketInternal : MinIO 버킷 생성 (내부 로직)
-func (s *Service) CreateBucketInternal(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+// CreateBucketInternal : MinIO 버킷 생성 (내부 로직, objectLock이면 Object Lock 활성화)
+func (s *Service) CreateBucketInternal(client client.Client, ctx context.Context, bucketName string, objectLock bool) (interface{}, error) {
 	// MinIO 버킷 생성
-	err := client.Minio().MakeBucket(ctx, bucketName, minio.MakeBucketOptions{})
+	err := client.Minio().MakeBucket(ctx, bucketName, minio.MakeBucketOptions{ObjectLocking: objectLock})
 	if err != nil {
 		return nil, fmt.Errorf("failed to create bucket: %w", err)
 	}
 
 	return map[string]interface{}{
-		"bucket":  bucketName,
-		"message": fmt.Sprintf("Bucket '%s' created successfully", bucketName),
-		"status":  "created",
+		"bucket":     bucketName,
+		"objectLock": objectLock,
+		"message":    fmt.Sprintf("Bucket '%s' created successfully", bucketName),
+		"status":     "created",
 	}, nil
 }
 
@@ -78,6 +90,227 @@ func (s *Service) DeleteBucketInternal(client client.Client, ctx context.Context
 	}, nil
 }
 
+// ===== 버킷 설정 관련 =====
+
+// 버킷 보존 설정 허용 값
+var (
+	supportedRetentionModes = map[string]bool{"GOVERNANCE": true, "COMPLIANCE": true}
+	supportedValidityUnits  = map[string]bool{"DAYS": true, "YEARS": true}
+)
+
+// GetBucketVersioningInternal : 버킷 버전 관리 상태 조회 (내부 로직)
+func (s *Service) GetBucketVersioningInternal(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+	status, err := client.Minio().GetBucketVersioning(ctx, bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket versioning: %w", err)
+	}
+
+	return map[string]interface{}{
+		"bucket":  bucketName,
+		"status":  status,
+		"enabled": status == "Enabled",
+	}, nil
+}
+
+// SetBucketVersioningInternal : 버킷 버전 관리 활성화/일시 중지 (내부 로직)
+func (s *Service) SetBucketVersioningInternal(client client.Client, ctx context.Context, bucketName string, enabled bool) (interface{}, error) {
+	if !enabled {
+		// Object Lock 버킷은 버전 관리를 일시 중지할 수 없음
+		retention, err := client.Minio().GetBucketRetention(ctx, bucketName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get bucket object lock configuration: %w", err)
+		}
+		if retention.ObjectLockEnabled {
+			return nil, fmt.Errorf("versioning cannot be suspended on bucket '%s' with object lock enabled", bucketName)
+		}
+	}
+
+	if err := client.Minio().SetBucketVersioning(ctx, bucketName, enabled); err != nil {
+		return nil, fmt.Errorf("failed to set bucket versioning: %w", err)
+	}
+
+	return s.GetBucketVersioningInternal(client, ctx, bucketName)
+}
+
+// ValidateBucketRetentionRequest : 버킷 보존 설정 요청 검증 (Unit 기본값 채움)
+func (s *Service) ValidateBucketRetentionRequest(req *types.BucketRetentionRequest) error {
+	req.Mode = strings.ToUpper(strings.TrimSpace(req.Mode))
+	req.Unit = strings.ToUpper(strings.TrimSpace(req.Unit))
+
+	// 기본 보존 해제
+	if req.Mode == "" {
+		if req.Validity != 0 || req.Unit != "" {
+			return fmt.Errorf("mode is required when validity or unit is set")
+		}
+		return nil
+	}
+
+	if !supportedRetentionModes[req.Mode] {
+		return fmt.Errorf("unsupported retention mode '%s': must be GOVERNANCE or COMPLIANCE", req.Mode)
+	}
+	if req.Validity == 0 {
+		return fmt.Errorf("validity must be greater than 0")
+	}
+	if req.Unit == "" {
+		req.Unit = "DAYS"
+	}
+	if !supportedValidityUnits[req.Unit] {
+		return fmt.Errorf("unsupported validity unit '%s': must be DAYS or YEARS", req.Unit)
+	}
+	return nil
+}
+
+// GetBucketRetentionInternal : 버킷 Object Lock 기본 보존 설정 조회 (내부 로직)
+func (s *Service) GetBucketRetentionInternal(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+	retention, err := client.Minio().GetBucketRetention(ctx, bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket retention: %w", err)
+	}
+
+	return map[string]interface{}{
+		"bucket":    bucketName,
+		"retention": retention,
+	}, nil
+}
+
+// SetBucketRetentionInternal : 버킷 Object Lock 기본 보존 설정 변경 (내부 로직)
+func (s *Service) SetBucketRetentionInternal(client client.Client, ctx context.Context, bucketName string, req types.BucketRetentionRequest) (interface{}, error) {
+	// Object Lock은 버킷 생성 시에만 활성화할 수 있음
+	retention, err := client.Minio().GetBucketRetention(ctx, bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket object lock configuration: %w", err)
+	}
+	if !retention.ObjectLockEnabled {
+		return nil, fmt.Errorf("object lock is not enabled on bucket '%s': recreate the bucket with objectLock=true", bucketName)
+	}
+
+	if err := client.Minio().SetBucketRetention(ctx, bucketName, req.Mode, req.Validity, req.Unit); err != nil {
+		return nil, fmt.Errorf("failed to set bucket retention: %w", err)
+	}
+
+	return s.GetBucketRetentionInternal(client, ctx, bucketName)
+}
+
+// ValidateBucketLifecycleRequest : 버킷 수명 주기 규칙 검증 (빈 규칙 ID는 'rule-N'으로 채움)
+func (s *Service) ValidateBucketLifecycleRequest(req *types.BucketLifecycleRequest) error {
+	ids := make(map[string]bool, len(req.Rules))
+	for i := range req.Rules {
+		rule := &req.Rules[i]
+		if rule.ID == "" {
+			rule.ID = fmt.Sprintf("rule-%d", i+1)
+		}
+		if len(rule.ID) > 255 {
+			return fmt.Errorf("rule id '%s' must be at most 255 characters", rule.ID)
+		}
+		if ids[rule.ID] {
+			return fmt.Errorf("duplicate rule id '%s'", rule.ID)
+		}
+		ids[rule.ID] = true
+
+		if rule.ExpirationDays < 0 || rule.NoncurrentExpirationDays < 0 || rule.AbortIncompleteUploadDays < 0 {
+			return fmt.Errorf("rule '%s': days must not be negative", rule.ID)
+		}
+		if rule.ExpirationDays > 0 && rule.ExpireDeleteMarkers {
+			// S3는 Expiration에 Days와 ExpiredObjectDeleteMarker를 동시에 허용하지 않음
+			return fmt.Errorf("rule '%s': expirationDays and expireDeleteMarkers cannot be combined", rule.ID)
+		}
+		if rule.ExpirationDays == 0 && rule.NoncurrentExpirationDays == 0 &&
+			rule.AbortIncompleteUploadDays == 0 && !rule.ExpireDeleteMarkers {
+			return fmt.Errorf("rule '%s': at least one action is required", rule.ID)
+		}
+	}
+	return nil
+}
+
+// GetBucketLifecycleInternal : 버킷 수명 주기 규칙 조회 (내부 로직)
+func (s *Service) GetBucketLifecycleInternal(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+	rules, err := client.Minio().GetBucketLifecycle(ctx, bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket lifecycle: %w", err)
+	}
+
+	return map[string]interface{}{
+		"bucket": bucketName,
+		"rules":  rules,
+	}, nil
+}
+
+// SetBucketLifecycleInternal : 버킷 수명 주기 규칙 교체 (내부 로직, 빈 목록이면 삭제)
+func (s *Service) SetBucketLifecycleInternal(client client.Client, ctx context.Context, bucketName string, rules []minio.LifecycleRule) (interface{}, error) {
+	if err := client.Minio().SetBucketLifecycle(ctx, bucketName, rules); err != nil {
+		return nil, fmt.Errorf("failed to set bucket lifecycle: %w", err)
+	}
+
+	if len(rules) == 0 {
+		return map[string]interface{}{
+			"bucket":  bucketName,
+			"message": fmt.Sprintf("Lifecycle configuration of bucket '%s' removed", bucketName),
+			"status":  "deleted",
+		}, nil
+	}
+	return s.GetBucketLifecycleInternal(client, ctx, bucketName)
+}
+
+// ValidateBucketPolicy : 버킷 정책 JSON 검증 (빈 정책은 삭제로 처리)
+func (s *Service) ValidateBucketPolicy(policy json.RawMessage) error {
+	if len(policy) == 0 || string(policy) == "null" {
+		return nil
+	}
+
+	var document struct {
+		Version   string          `json:"Version"`
+		Statement json.RawMessage `json:"Statement"`
+	}
+	if err := json.Unmarshal(policy, &document); err != nil {
+		return fmt.Errorf("policy must be a JSON object: %w", err)
+	}
+	if len(document.Statement) == 0 || string(document.Statement) == "null" {
+		return fmt.Errorf("policy must contain a Statement")
+	}
+	return nil
+}
+
+// GetBucketPolicyInternal : 버킷 정책 조회 (내부 로직)
+func (s *Service) GetBucketPolicyInternal(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
+	policy, err := client.Minio().GetBucketPolicy(ctx, bucketName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bucket policy: %w", err)
+	}
+
+	var document interface{}
+	if policy != "" {
+		if err := json.Unmarshal([]byte(policy), &document); err != nil {
+			return nil, fmt.Errorf("failed to parse bucket policy: %w", err)
+		}
+	}
+
+	return map[string]interface{}{
+		"bucket": bucketName,
+		"policy": document,
+	}, nil
+}
+
+// SetBucketPolicyInternal : 버킷 정책 교체 (내부 로직, 빈 정책이면 삭제)
+func (s *Service) SetBucketPolicyInternal(client client.Client, ctx context.Context, bucketName string, policy json.RawMessage) (interface{}, error) {
+	policyString := ""
+	if len(policy) > 0 && string(policy) != "null" {
+		policyString = string(policy)
+	}
+
+	if err := client.Minio().SetBucketPolicy(ctx, bucketName, policyString); err != nil {
+		return nil, fmt.Errorf("failed to set bucket policy: %w", err)
+	}
+
+	if policyString == "" {
+		return map[string]interface{}{
+			"bucket":  bucketName,
+			"message": fmt.Sprintf("Policy of bucket '%s' removed", bucketName),
+			"status":  "deleted",
+		}, nil
+	}
+	return s.GetBucketPolicyInternal(client, ctx, bucketName)
+}
+
 // GetObjectsInternal : MinIO 객체 목록 조회 (내부 로직)
 func (s *Service) GetObjectsInternal(client client.Client, ctx context.Context, bucketName string) (interface{}, error) {
 	// MinIO 객체 목록 조회
@@ -89,19 +322,124 @@ func (s *Service) GetObjectsInternal(client client.Client, ctx context.Context,
 	return objects, nil
 }
 
-// GetObjectInternal : MinIO 객체 다운로드 (내부 로직)
-func (s *Service) GetObjectInternal(client client.Client, ctx context.Context, bucketName, objectName string) (interface{}, error) {
-	// MinIO 객체 다운로드
-	object, err := client.Minio().GetObject(ctx, bucketName, objectName)
+// ErrRangeNotSatisfiable : 요청한 Range가 객체 범위를 벗어남 (HTTP 416)
+var ErrRangeNotSatisfiable = errors.New("requested range not satisfiable")
+
+// ObjectDownload : 스트리밍 다운로드 대상 객체
+type ObjectDownload struct {
+	Info   *minio.ObjectInfo
+	Range  *minio.ObjectRange // nil이면 전체 객체
+	Reader io.ReadCloser      // 본문 없이 요청한 경우(HEAD) nil
+}
+
+// OpenObjectInternal : MinIO 객체 스트리밍 다운로드 준비 (Range, If-Range 처리)
+func (s *Service) OpenObjectInternal(
+	client client.Client,
+	ctx context.Context,
+	bucketName, objectName, rangeHeader, ifRange string,
+	withBody bool,
+) (*ObjectDownload, error) {
+	info, err := client.Minio().HeadObject(ctx, bucketName, objectName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get object info: %w", err)
+	}
+
+	download := &ObjectDownload{Info: info}
+
+	// If-Range가 현재 ETag와 다르면 객체가 바뀐 것이므로 전체 객체 전송 (RFC 7233)
+	if rangeHeader != "" && (ifRange == "" || strings.Trim(ifRange, `"`) == info.ETag) {
+		objectRange, err := parseRangeHeader(rangeHeader, info.Size)
+		if err != nil {
+			return download, err
+		}
+		download.Range = objectRange
+	}
+
+	if !withBody {
+		return download, nil
+	}
+
+	// 이어받기 중 객체가 교체되면 실패하도록 ETag 고정
+	reader, err := client.Minio().GetObjectStream(ctx, bucketName, objectName, minio.GetObjectOptions{
+		Range:     download.Range,
+		MatchETag: info.ETag,
+	})
 	if err != nil {
 		return nil, fmt.Errorf("failed to get object: %w", err)
 	}
+	download.Reader = reader
+
+	return download, nil
+}
+
+// parseRangeHeader : 단일 bytes Range 헤더 해석 (형식 오류나 다중 Range는 무시하고 전체 객체 전송)
+func parseRangeHeader(header string, size int64) (*minio.ObjectRange, error) {
+	spec, ok := strings.CutPrefix(strings.TrimSpace(header), "bytes=")
+	if !ok || strings.Contains(spec, ",") {
+		return nil, nil
+	}
+
+	startStr, endStr, ok := strings.Cut(strings.TrimSpace(spec), "-")
+	if !ok {
+		return nil, nil
+	}
+
+	// 접미사 Range (bytes=-N : 마지막 N바이트)
+	if startStr == "" {
+		suffix, err := strconv.ParseInt(endStr, 10, 64)
+		if err != nil || suffix < 0 {
+			return nil, nil
+		}
+		if suffix == 0 || size == 0 {
+			return nil, ErrRangeNotSatisfiable
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return &minio.ObjectRange{Start: size - suffix, End: size - 1}, nil
+	}
+
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start < 0 {
+		return nil, nil
+	}
+	if start >= size {
+		return nil, ErrRangeNotSatisfiable
+	}
+
+	end := size - 1
+	if endStr != "" {
+		end, err = strconv.ParseInt(endStr, 10, 64)
+		if err != nil || end < start {
+			return nil, nil
+		}
+		if end >= size {
+			end = size - 1
+		}
+	}
+
+	return &minio.ObjectRange{Start: start, End: end}, nil
+}
+
+// PutObjectStreamInternal : MinIO 객체 스트리밍 업로드 (요청 본문을 multipart 청크로 전송)
+func (s *Service) PutObjectStreamInternal(
+	client client.Client,
+	ctx context.Context,
+	bucketName, objectName string,
+	body io.Reader,
+	objectSize int64,
+	opts minio.PutObjectOptions,
+) (map[string]interface{}, error) {
+	uploadInfo, err := client.Minio().PutObjectStream(ctx, bucketName, objectName, body, objectSize, opts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to upload object: %w", err)
+	}
 
 	return map[string]interface{}{
-		"bucket":  bucketName,
-		"object":  objectName,
-		"content": object,
-		"status":  "downloaded",
+		"bucket":     bucketName,
+		"object":     objectName,
+		"uploadInfo": uploadInfo,
+		"status":     "uploaded",
 	}, nil
 }
 
@@ -275,3 +613,329 @@ func (s *Service) ListObjectsInFolderInternal(client client.Client, ctx context.
 		"count":      len(objectList),
 	}, nil
 }
+
+// ===== 복제 관련 =====
+
+// 복제 기본값
+const (
+	defaultReplicationConcurrency = 4
+	maxReplicationConcurrency     = 32
+	maxReplicationFailures        = 100 // 결과에 포함할 최대 실패 객체 수
+
+	// replicationSourceETagKey : 대상 객체에 기록하는 원본 ETag 메타데이터 키 (증분 복제 비교용)
+	replicationSourceETagKey = "Replication-Source-Etag"
+)
+
+// ReplicationProgress : 복제 진행 상황
+type ReplicationProgress struct {
+	TotalObjects      int   `json:"totalObjects"`
+	TotalBytes        int64 `json:"totalBytes"`
+	CopiedObjects     int   `json:"copiedObjects"`
+	CopiedBytes       int64 `json:"copiedBytes"`
+	SkippedObjects    int   `json:"skippedObjects"`
+	SkippedBytes      int64 `json:"skippedBytes"`
+	FailedObjects     int   `json:"failedObjects"`
+	FailedBytes       int64 `json:"failedBytes"`
+	TransferringBytes int64 `json:"transferringBytes"` // 복사 중인 객체의 전송된 바이트
+}
+
+// Percent : 처리된 바이트 기준 진행률 (바이트 합계가 0이면 객체 수 기준)
+func (p ReplicationProgress) Percent() int {
+	if p.TotalObjects == 0 {
+		return 100
+	}
+
+	var percent int
+	if p.TotalBytes == 0 {
+		percent = (p.CopiedObjects + p.SkippedObjects + p.FailedObjects) * 100 / p.TotalObjects
+	} else {
+		processed := p.CopiedBytes + p.SkippedBytes + p.FailedBytes + p.TransferringBytes
+		percent = int(processed * 100 / p.TotalBytes)
+	}
+	if percent > 100 {
+		percent = 100
+	}
+	return percent
+}
+
+// ReplicationFailure : 복제에 실패한 객체
+type ReplicationFailure struct {
+	Key   string `json:"key"`
+	Error string `json:"error"`
+}
+
+// ReplicationResult : 복제 결과
+type ReplicationResult struct {
+	ReplicationProgress
+	SourceBucket      string               `json:"sourceBucket"`
+	DestinationBucket string               `json:"destinationBucket"`
+	Prefixes          []string             `json:"prefixes"`
+	Failures          []ReplicationFailure `json:"failures,omitempty"` // 최대 maxReplicationFailures개
+	Duration          string               `json:"duration"`
+}
+
+// ValidateReplicationRequest : 복제 요청 검증 (접두사 정규화, 기본값 채움)
+func (s *Service) ValidateReplicationRequest(req *types.ReplicationRequest) error {
+	if req.Source.Bucket == "" || req.Destination.Bucket == "" {
+		return fmt.Errorf("source.bucket and destination.bucket are required")
+	}
+
+	if req.Concurrency == 0 {
+		req.Concurrency = defaultReplicationConcurrency
+	}
+	if req.Concurrency < 1 || req.Concurrency > maxReplicationConcurrency {
+		return fmt.Errorf("concurrency must be between 1 and %d", maxReplicationConcurrency)
+	}
+
+	if req.VerifyChecksum == nil {
+		enabled := true
+		req.VerifyChecksum = &enabled
+	}
+	if req.SkipUnchanged == nil {
+		enabled := true
+		req.SkipUnchanged = &enabled
+	}
+
+	// 접두사 정규화 (빈 접두사가 있으면 버킷 전체)
+	prefixes := make([]string, 0, len(req.Prefixes))
+	seen := make(map[string]bool, len(req.Prefixes))
+	wholeBucket := len(req.Prefixes) == 0
+	for _, prefix := range req.Prefixes {
+		prefix = strings.TrimLeft(strings.TrimSpace(prefix), "/")
+		if prefix == "" {
+			wholeBucket = true
+			break
+		}
+		if !seen[prefix] {
+			seen[prefix] = true
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	if wholeBucket {
+		prefixes = []string{""}
+	}
+	req.Prefixes = prefixes
+
+	// 같은 버킷으로 복제하면 자기 자신을 덮어씀
+	if req.Source.MinioConfig.Endpoint == req.Destination.MinioConfig.Endpoint &&
+		req.Source.Bucket == req.Destination.Bucket {
+		return fmt.Errorf("source and destination must not be the same bucket")
+	}
+	return nil
+}
+
+// ReplicateInternal : 원본 버킷의 접두사 아래 객체를 대상 버킷으로 복제 (내부 로직)
+// req는 ValidateReplicationRequest를 통과한 상태여야 하며, 실패한 객체가 있으면 결과와 함께 에러를 반환
+func (s *Service) ReplicateInternal(
+	ctx context.Context,
+	source, destination client.Client,
+	req types.ReplicationRequest,
+	progress func(ReplicationProgress),
+) (*ReplicationResult, error) {
+	startTime := time.Now()
+
+	exists, err := source.Minio().BucketExists(ctx, req.Source.Bucket)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check source bucket: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("source bucket '%s' does not exist", req.Source.Bucket)
+	}
+	if err := destination.Minio().CreateBucketIfNotExists(ctx, req.Destination.Bucket); err != nil {
+		return nil, fmt.Errorf("failed to prepare destination bucket: %w", err)
+	}
+
+	// 복제 대상 객체 수집 (겹치는 접두사는 한 번만 복사)
+	var objects []minio.ObjectInfo
+	seen := make(map[string]bool)
+	for _, prefix := range req.Prefixes {
+		listed, err := source.Minio().ListObjectsWithPrefix(ctx, req.Source.Bucket, prefix)
+		if err != nil {
+			return nil, fmt.Errorf("failed to list source objects: %w", err)
+		}
+		for _, object := range listed {
+			if !seen[object.Key] {
+				seen[object.Key] = true
+				objects = append(objects, object)
+			}
+		}
+	}
+
+	tracker := &replicationTracker{report: progress}
+	tracker.progress.TotalObjects = len(objects)
+	for _, object := range objects {
+		tracker.progress.TotalBytes += object.Size
+	}
+	tracker.notify()
+
+	// 동시 복사
+	queue := make(chan minio.ObjectInfo)
+	var wg sync.WaitGroup
+	for i := 0; i < req.Concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for object := range queue {
+				skipped, err := s.replicateObject(ctx, source, destination, req, object, tracker)
+				tracker.finish(object, skipped, err)
+			}
+		}()
+	}
+
+enqueue:
+	for _, object := range objects {
+		select {
+		case queue <- object:
+		case <-ctx.Done():
+			break enqueue
+		}
+	}
+	close(queue)
+	wg.Wait()
+
+	result := &ReplicationResult{
+		ReplicationProgress: tracker.progress,
+		SourceBucket:        req.Source.Bucket,
+		DestinationBucket:   req.Destination.Bucket,
+		Prefixes:            req.Prefixes,
+		Failures:            tracker.failures,
+		Duration:            time.Since(startTime).Round(time.Second).String(),
+	}
+
+	if ctx.Err() != nil {
+		return result, fmt.Errorf("replication interrupted: %w", ctx.Err())
+	}
+	if result.FailedObjects > 0 {
+		return result, fmt.Errorf("%d of %d objects failed to replicate", result.FailedObjects, result.TotalObjects)
+	}
+	return result, nil
+}
+
+// replicateObject : 객체 하나를 복제 (대상이 최신이면 건너뛰고 true 반환)
+func (s *Service) replicateObject(
+	ctx context.Context,
+	source, destination client.Client,
+	req types.ReplicationRequest,
+	object minio.ObjectInfo,
+	tracker *replicationTracker,
+) (bool, error) {
+	if *req.SkipUnchanged {
+		existing, err := destination.Minio().HeadObject(ctx, req.Destination.Bucket, object.Key)
+		switch {
+		case err == nil:
+			if existing.Size == object.Size &&
+				(existing.ETag == object.ETag || existing.UserMetadata[replicationSourceETagKey] == object.ETag) {
+				return true, nil
+			}
+		case !minio.IsObjectNotFound(err):
+			return false, fmt.Errorf("failed to stat destination object: %w", err)
+		}
+	}
+
+	// 목록 조회 이후 원본이 바뀌었으면 ETag 조건으로 실패시킴
+	reader, err := source.Minio().GetObjectStream(ctx, req.Source.Bucket, object.Key, minio.GetObjectOptions{MatchETag: object.ETag})
+	if err != nil {
+		return false, fmt.Errorf("failed to read source object: %w", err)
+	}
+	defer reader.Close()
+
+	hasher := md5.New()
+	var transferred atomic.Int64
+	uploaded, err := destination.Minio().PutObjectStream(ctx, req.Destination.Bucket, object.Key,
+		io.TeeReader(reader, hasher), object.Size, minio.PutObjectOptions{
+			ContentType:  object.ContentType,
+			UserMetadata: map[string]string{replicationSourceETagKey: object.ETag},
+			Progress: func(total int64) {
+				tracker.transfer(total - transferred.Swap(total))
+			},
+		})
+	tracker.transfer(-transferred.Load())
+	if err != nil {
+		return false, fmt.Errorf("failed to write destination object: %w", err)
+	}
+
+	// 검증 실패 시 다음 실행에서 다시 복사되도록 대상 객체 삭제
+	if verifyErr := verifyReplicatedObject(object, uploaded, hex.EncodeToString(hasher.Sum(nil)), *req.VerifyChecksum); verifyErr != nil {
+		if err := destination.Minio().DeleteObject(ctx, req.Destination.Bucket, object.Key); err != nil {
+			return false, fmt.Errorf("%v (failed to remove destination object: %v)", verifyErr, err)
+		}
+		return false, verifyErr
+	}
+	return false, nil
+}
+
+// verifyReplicatedObject : 복제된 객체의 크기와 체크섬 검증
+// 단일 파트 객체의 ETag는 내용의 MD5이므로 전송한 데이터의 MD5와 비교하고, multipart 객체는 크기만 비교
+func verifyReplicatedObject(source minio.ObjectInfo, uploaded *minio.ObjectInfo, md5sum string, verifyChecksum bool) error {
+	if uploaded.Size != source.Size {
+		return fmt.Errorf("size mismatch: source %d bytes, destination %d bytes", source.Size, uploaded.Size)
+	}
+	if verifyChecksum && isMD5ETag(source.ETag) && !strings.EqualFold(source.ETag, md5sum) {
+		return fmt.Errorf("checksum mismatch: source etag %s, transferred md5 %s", source.ETag, md5sum)
+	}
+	return nil
+}
+
+// isMD5ETag : ETag가 내용의 MD5(32자리 16진수)인지 확인 (multipart ETag는 '-<파트 수>' 접미사를 가짐)
+func isMD5ETag(etag string) bool {
+	if len(etag) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(etag)
+	return err == nil
+}
+
+// replicationTracker : 동시 복사 진행 상황 집계
+type replicationTracker struct {
+	mu       sync.Mutex
+	progress ReplicationProgress
+	failures []ReplicationFailure
+	report   func(ReplicationProgress)
+}
+
+// transfer : 복사 중인 객체의 전송 바이트 변화량 반영
+func (t *replicationTracker) transfer(delta int64) {
+	if delta == 0 {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.progress.TransferringBytes += delta
+	t.notifyLocked()
+}
+
+// finish : 객체 하나의 처리 결과 반영
+func (t *replicationTracker) finish(object minio.ObjectInfo, skipped bool, err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	switch {
+	case err != nil:
+		t.progress.FailedObjects++
+		t.progress.FailedBytes += object.Size
+		if len(t.failures) < maxReplicationFailures {
+			t.failures = append(t.failures, ReplicationFailure{Key: object.Key, Error: err.Error()})
+		}
+	case skipped:
+		t.progress.SkippedObjects++
+		t.progress.SkippedBytes += object.Size
+	default:
+		t.progress.CopiedObjects++
+		t.progress.CopiedBytes += object.Size
+	}
+	t.notifyLocked()
+}
+
+// notify : 현재 진행 상황 전달
+func (t *replicationTracker) notify() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.notifyLocked()
+}
+
+// notifyLocked : 현재 진행 상황 전달 (mu를 잡은 상태에서 호출, 전달 순서 보장)
+func (t *replicationTracker) notifyLocked() {
+	if t.report != nil {
+		t.report(t.progress)
+	}
+}
@@ -0,0 +1,336 @@
+package registry
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/handler"
+	reg "github.com/taking/kubemigrate/internal/registry"
+	"github.com/taking/kubemigrate/internal/response"
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// Handler : 클러스터/스토리지 레지스트리 관련 HTTP 핸들러
+type Handler struct {
+	*handler.BaseHandler
+}
+
+// NewHandler : 새로운 레지스트리 핸들러 생성
+func NewHandler(base *handler.BaseHandler) *Handler {
+	return &Handler{
+		BaseHandler: base,
+	}
+}
+
+// ===== 클러스터 관련 =====
+
+// ListClusters : 등록된 클러스터 목록 조회
+// @Summary List Registered Clusters
+// @Description List registered clusters. Kubeconfigs are never returned.
+// @Tags registry
+// @Produce json
+// @Success 200 {object} response.SuccessResponse
+// @Failure 503 {object} response.ErrorResponse
+// @Router /v1/clusters [get]
+func (h *Handler) ListClusters(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to list clusters", err)
+	}
+
+	return response.RespondWithData(c, http.StatusOK, registry.ListClusters())
+}
+
+// GetCluster : 등록된 클러스터 조회
+// @Summary Get Registered Cluster
+// @Description Get a registered cluster by ID. The kubeconfig is never returned.
+// @Tags registry
+// @Produce json
+// @Param clusterId path string true "Cluster ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /v1/clusters/{clusterId} [get]
+func (h *Handler) GetCluster(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to get cluster", err)
+	}
+
+	cluster, err := registry.GetCluster(c.Param("clusterId"))
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to get cluster", err)
+	}
+
+	return response.RespondWithData(c, http.StatusOK, cluster.Redacted())
+}
+
+// CreateCluster : 클러스터 등록
+// @Summary Register Cluster
+// @Description Register a kubeconfig under an ID (generated when omitted). The kubeconfig is stored encrypted and can be referenced with clusterId in kubernetes, helm and velero requests.
+// @Tags registry
+// @Accept json
+// @Produce json
+// @Param request body registry.Cluster true "Cluster (id, name, description, kubeconfig)"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /v1/clusters [post]
+func (h *Handler) CreateCluster(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to register cluster", err)
+	}
+
+	var req reg.Cluster
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if _, err := h.KubernetesValidator.ValidateKubernetesConfig(&config.KubeConfig{KubeConfig: req.KubeConfig}); err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_KUBERNETES_CONFIG", "Invalid Kubernetes configuration", err.Error())
+	}
+
+	cluster, err := registry.CreateCluster(c.Request().Context(), req)
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to register cluster", err)
+	}
+
+	return response.RespondWithData(c, http.StatusCreated, cluster.Redacted())
+}
+
+// UpdateCluster : 등록된 클러스터 수정
+// @Summary Update Registered Cluster
+// @Description Update the name, description or kubeconfig of a registered cluster. Omitted fields keep their value; cached clients for the cluster are dropped.
+// @Tags registry
+// @Accept json
+// @Produce json
+// @Param clusterId path string true "Cluster ID"
+// @Param request body registry.Cluster true "Fields to update"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /v1/clusters/{clusterId} [put]
+func (h *Handler) UpdateCluster(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to update cluster", err)
+	}
+
+	var req reg.Cluster
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if req.KubeConfig != "" {
+		if _, err := h.KubernetesValidator.ValidateKubernetesConfig(&config.KubeConfig{KubeConfig: req.KubeConfig}); err != nil {
+			return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_KUBERNETES_CONFIG", "Invalid Kubernetes configuration", err.Error())
+		}
+	}
+
+	id := c.Param("clusterId")
+	cluster, err := registry.UpdateCluster(c.Request().Context(), id, req)
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to update cluster", err)
+	}
+	h.InvalidateRegistryClients(handler.RegistryKindCluster, id)
+
+	return response.RespondWithData(c, http.StatusOK, cluster.Redacted())
+}
+
+// DeleteCluster : 등록된 클러스터 삭제
+// @Summary Delete Registered Cluster
+// @Description Delete a registered cluster and drop its cached clients
+// @Tags registry
+// @Produce json
+// @Param clusterId path string true "Cluster ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /v1/clusters/{clusterId} [delete]
+func (h *Handler) DeleteCluster(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to delete cluster", err)
+	}
+
+	id := c.Param("clusterId")
+	if err := registry.DeleteCluster(c.Request().Context(), id); err != nil {
+		return h.respondRegistryError(c, "Failed to delete cluster", err)
+	}
+	h.InvalidateRegistryClients(handler.RegistryKindCluster, id)
+
+	return response.RespondWithData(c, http.StatusOK, map[string]interface{}{
+		"id":      id,
+		"message": "Cluster deleted",
+	})
+}
+
+// ===== 스토리지 관련 =====
+
+// ListStorages : 등록된 스토리지 목록 조회
+// @Summary List Registered Storages
+// @Description List registered MinIO storages. Secret keys are masked.
+// @Tags registry
+// @Produce json
+// @Success 200 {object} response.SuccessResponse
+// @Failure 503 {object} response.ErrorResponse
+// @Router /v1/storages [get]
+func (h *Handler) ListStorages(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to list storages", err)
+	}
+
+	return response.RespondWithData(c, http.StatusOK, registry.ListStorages())
+}
+
+// GetStorage : 등록된 스토리지 조회
+// @Summary Get Registered Storage
+// @Description Get a registered MinIO storage by ID. The secret key is masked.
+// @Tags registry
+// @Produce json
+// @Param storageId path string true "Storage ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /v1/storages/{storageId} [get]
+func (h *Handler) GetStorage(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to get storage", err)
+	}
+
+	storage, err := registry.GetStorage(c.Param("storageId"))
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to get storage", err)
+	}
+
+	return response.RespondWithData(c, http.StatusOK, storage.Redacted())
+}
+
+// CreateStorage : 스토리지 등록
+// @Summary Register Storage
+// @Description Register a MinIO configuration under an ID (generated when omitted). Credentials are stored encrypted and can be referenced with storageId in minio and velero requests.
+// @Tags registry
+// @Accept json
+// @Produce json
+// @Param request body registry.Storage true "Storage (id, name, description, minio)"
+// @Success 201 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 409 {object} response.ErrorResponse
+// @Router /v1/storages [post]
+func (h *Handler) CreateStorage(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to register storage", err)
+	}
+
+	var req reg.Storage
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.MinioValidator.ValidateMinioConfig(&req.Minio); err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_MINIO_CONFIG", "Invalid MinIO configuration", err.Error())
+	}
+
+	storage, err := registry.CreateStorage(c.Request().Context(), req)
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to register storage", err)
+	}
+
+	return response.RespondWithData(c, http.StatusCreated, storage.Redacted())
+}
+
+// UpdateStorage : 등록된 스토리지 수정
+// @Summary Update Registered Storage
+// @Description Update a registered MinIO storage. Omitted fields and a masked secretKey keep their value; cached clients for the storage are dropped.
+// @Tags registry
+// @Accept json
+// @Produce json
+// @Param storageId path string true "Storage ID"
+// @Param request body registry.Storage true "Fields to update"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /v1/storages/{storageId} [put]
+func (h *Handler) UpdateStorage(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to update storage", err)
+	}
+
+	var req reg.Storage
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+
+	id := c.Param("storageId")
+	current, err := registry.GetStorage(id)
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to update storage", err)
+	}
+
+	// 수정 결과가 유효한 MinIO 설정인지 먼저 확인
+	candidate := reg.ApplyStorageUpdate(current, req)
+	if err := h.MinioValidator.ValidateMinioConfig(&candidate.Minio); err != nil {
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_MINIO_CONFIG", "Invalid MinIO configuration", err.Error())
+	}
+
+	storage, err := registry.UpdateStorage(c.Request().Context(), id, req)
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to update storage", err)
+	}
+	h.InvalidateRegistryClients(handler.RegistryKindStorage, id)
+
+	return response.RespondWithData(c, http.StatusOK, storage.Redacted())
+}
+
+// DeleteStorage : 등록된 스토리지 삭제
+// @Summary Delete Registered Storage
+// @Description Delete a registered MinIO storage and drop its cached clients
+// @Tags registry
+// @Produce json
+// @Param storageId path string true "Storage ID"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Router /v1/storages/{storageId} [delete]
+func (h *Handler) DeleteStorage(c echo.Context) error {
+	registry, err := h.requireRegistry()
+	if err != nil {
+		return h.respondRegistryError(c, "Failed to delete storage", err)
+	}
+
+	id := c.Param("storageId")
+	if err := registry.DeleteStorage(c.Request().Context(), id); err != nil {
+		return h.respondRegistryError(c, "Failed to delete storage", err)
+	}
+	h.InvalidateRegistryClients(handler.RegistryKindStorage, id)
+
+	return response.RespondWithData(c, http.StatusOK, map[string]interface{}{
+		"id":      id,
+		"message": "Storage deleted",
+	})
+}
+
+// ===== 공통 함수 =====
+
+// requireRegistry : 초기화된 레지스트리 반환
+func (h *Handler) requireRegistry() (*reg.Registry, error) {
+	if h.Registry == nil {
+		return nil, handler.ErrRegistryUnavailable
+	}
+	return h.Registry, nil
+}
+
+// respondRegistryError : 레지스트리 에러를 HTTP 응답으로 변환
+func (h *Handler) respondRegistryError(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, reg.ErrNotFound):
+		return response.RespondWithErrorModel(c, http.StatusNotFound, "REGISTRY_ENTRY_NOT_FOUND", message, err.Error())
+	case errors.Is(err, reg.ErrAlreadyExists):
+		return response.RespondWithErrorModel(c, http.StatusConflict, "REGISTRY_ENTRY_EXISTS", message, err.Error())
+	case errors.Is(err, reg.ErrInvalidID):
+		return response.RespondWithErrorModel(c, http.StatusBadRequest, "INVALID_REGISTRY_ID", message, err.Error())
+	case errors.Is(err, handler.ErrRegistryUnavailable):
+		return response.RespondWithErrorModel(c, http.StatusServiceUnavailable, "REGISTRY_UNAVAILABLE", message, err.Error())
+	default:
+		return response.RespondWithErrorModel(c, http.StatusInternalServerError, "REGISTRY_ERROR", message, err.Error())
+	}
+}
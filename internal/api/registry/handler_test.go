@@ -0,0 +1,103 @@
+package registry
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/handler"
+	"github.com/taking/kubemigrate/internal/job"
+)
+
+// TestRegistryHandler_Clusters 클러스터 등록/조회/수정/삭제 API 테스트
+func TestRegistryHandler_Clusters(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	registryHandler := NewHandler(handler.NewBaseHandlerWithMock(workerPool))
+
+	e := echo.New()
+	e.POST("/clusters", registryHandler.CreateCluster)
+	e.GET("/clusters", registryHandler.ListClusters)
+	e.GET("/clusters/:clusterId", registryHandler.GetCluster)
+	e.PUT("/clusters/:clusterId", registryHandler.UpdateCluster)
+	e.DELETE("/clusters/:clusterId", registryHandler.DeleteCluster)
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		body         string
+		expectedCode int
+	}{
+		{"등록", http.MethodPost, "/clusters", `{"id":"prod-a","kubeconfig":"apiVersion: v1\nkind: Config"}`, http.StatusCreated},
+		{"중복 등록", http.MethodPost, "/clusters", `{"id":"prod-a","kubeconfig":"apiVersion: v1\nkind: Config"}`, http.StatusConflict},
+		{"잘못된 ID", http.MethodPost, "/clusters", `{"id":"Prod A","kubeconfig":"apiVersion: v1\nkind: Config"}`, http.StatusBadRequest},
+		{"잘못된 kubeconfig", http.MethodPost, "/clusters", `{"id":"prod-b","kubeconfig":"invalid"}`, http.StatusBadRequest},
+		{"목록 조회", http.MethodGet, "/clusters", "", http.StatusOK},
+		{"조회", http.MethodGet, "/clusters/prod-a", "", http.StatusOK},
+		{"수정", http.MethodPut, "/clusters/prod-a", `{"name":"Production A"}`, http.StatusOK},
+		{"삭제", http.MethodDelete, "/clusters/prod-a", "", http.StatusOK},
+		{"삭제 후 조회", http.MethodGet, "/clusters/prod-a", "", http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		req := httptest.NewRequest(tt.method, tt.path, bytes.NewReader([]byte(tt.body)))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		if rec.Code != tt.expectedCode {
+			t.Errorf("%s: expected status %d, got %d (%s)", tt.name, tt.expectedCode, rec.Code, rec.Body.String())
+		}
+		if strings.Contains(rec.Body.String(), "kind: Config") {
+			t.Errorf("%s: kubeconfig must not be returned", tt.name)
+		}
+	}
+}
+
+// TestRegistryHandler_Storages 스토리지 등록 및 secretKey 마스킹 테스트
+func TestRegistryHandler_Storages(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	registryHandler := NewHandler(handler.NewBaseHandlerWithMock(workerPool))
+
+	e := echo.New()
+	e.POST("/storages", registryHandler.CreateStorage)
+	e.PUT("/storages/:storageId", registryHandler.UpdateStorage)
+
+	body := `{"id":"backup","minio":{"endpoint":"localhost:9000","accessKey":"minioadmin","secretKey":"minioadmin123"}}`
+	req := httptest.NewRequest(http.MethodPost, "/storages", bytes.NewReader([]byte(body)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusCreated {
+		t.Fatalf("Expected status 201, got %d (%s)", rec.Code, rec.Body.String())
+	}
+	var resp struct {
+		Data struct {
+			Minio struct {
+				SecretKey string `json:"secretKey"`
+			} `json:"minio"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+		t.Fatalf("Failed to decode response: %v", err)
+	}
+	if resp.Data.Minio.SecretKey != "********" {
+		t.Errorf("Expected masked secret key, got %q", resp.Data.Minio.SecretKey)
+	}
+
+	// 수정 결과가 유효하지 않은 MinIO 설정이면 거부
+	req = httptest.NewRequest(http.MethodPut, "/storages/backup", bytes.NewReader([]byte(`{"minio":{"endpoint":"{{minio_url}}"}}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for invalid endpoint, got %d (%s)", rec.Code, rec.Body.String())
+	}
+}
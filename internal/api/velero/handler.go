@@ -8,7 +8,6 @@ import (
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/response"
 	"github.com/taking/kubemigrate/pkg/client"
-	"github.com/taking/kubemigrate/pkg/client/minio"
 	"github.com/taking/kubemigrate/pkg/config"
 	"github.com/taking/kubemigrate/pkg/types"
 )
@@ -50,22 +49,40 @@ func (h *Handler) HealthCheck(c echo.Context) error {
 
 // InstallVeleroWithMinIO : Velero 설치 및 MinIO 연동 설정
 // @Summary Install Velero with MinIO
-// @Description Install Velero and configure MinIO integration
+// @Description Install Velero and configure MinIO integration. The optional `install` profile overrides chart/image versions, bucket/prefix, region, CA bundle, node-agent, resources, tolerations and extra Helm values.
 // @Tags velero
 // @Accept json
 // @Produce json
-// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param request body types.InstallVeleroRequest true "Velero configuration (kubeconfig/minio or clusterId/storageId)"
 // @Param namespace query string false "Namespace name (default: 'velero')"
 // @Param force query boolean false "Force recreate BSL and MinIO Secret (default: false)"
+// @Param clusterId query string false "Registered cluster ID (instead of kubeconfig)"
+// @Param storageId query string false "Registered storage ID (instead of minio)"
 // @Success 200 {object} response.SuccessResponse
 // @Failure 400 {object} response.ErrorResponse
+// @Failure 404 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /v1/velero/install [post]
 func (h *Handler) InstallVeleroWithMinIO(c echo.Context) error {
-	// 공통 검증 및 바인딩 사용
-	config, err := h.ValidateVeleroConfig(c, "velero")
-	if err != nil {
-		return err
+	var req types.InstallVeleroRequest
+	if err := c.Bind(&req); err != nil {
+		return h.ValidationManager.HandleValidationError(c, "velero", "request binding", err)
+	}
+
+	// 등록된 클러스터/스토리지 참조
+	if err := h.ResolveRegistryRefs(c, req.RegistryRef, &req.KubeConfig, &req.MinioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
+	// MinIO, Kubernetes, 설치 프로필 검증
+	config := req.VeleroConfig
+	if err := h.ValidationManager.ValidateVeleroConfig(&config); err != nil {
+		return h.ValidationManager.HandleValidationError(c, "velero", "config validation", err)
+	}
+	if config.Install != nil {
+		if err := h.ValidationManager.ValidateVeleroInstallOptions(config.Install); err != nil {
+			return h.ValidationManager.HandleValidationError(c, "velero", "install options validation", err)
+		}
 	}
 
 	// Query 파라미터 처리
@@ -76,29 +93,17 @@ func (h *Handler) InstallVeleroWithMinIO(c echo.Context) error {
 	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Minute)
 	defer cancel()
 
-	// MinIO 클라이언트 직접 생성 및 테스트
-	minioClient, err := minio.NewClientWithConfig(config.MinioConfig)
+	// 통합 클라이언트 생성
+	unifiedClient, err := h.NewVeleroClient(config.KubeConfig, &config.MinioConfig)
 	if err != nil {
-		return h.HandleConnectionError(c, "velero", "minio client creation", err)
+		return h.HandleConnectionError(c, "velero", "unified client creation", err)
 	}
 
 	// MinIO 연결 테스트
-	_, err = minioClient.ListBuckets(ctx)
-	if err != nil {
+	if _, err := unifiedClient.Minio().ListBuckets(ctx); err != nil {
 		return h.HandleConnectionError(c, "velero", "minio connection", err)
 	}
 
-	// 통합 클라이언트 생성 (MinIO 클라이언트는 이미 검증됨)
-	unifiedClient, err := client.NewClientWithConfig(
-		&config.KubeConfig,
-		&config.KubeConfig,
-		&config,
-		&config.MinioConfig,
-	)
-	if err != nil {
-		return h.HandleConnectionError(c, "velero", "unified client creation", err)
-	}
-
 	// Velero 설치 및 MinIO 연동 실행
 	result, err := h.service.InstallVeleroWithMinIOInternal(unifiedClient, ctx, config, namespace, force)
 	if err != nil {
@@ -108,6 +113,67 @@ func (h *Handler) InstallVeleroWithMinIO(c echo.Context) error {
 	return response.RespondWithData(c, 200, result)
 }
 
+// UninstallVelero : Velero 제거
+// @Summary Uninstall Velero
+// @Description Uninstall the Velero Helm release as an async job and report the remaining Velero resources
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param force query boolean false "Also delete the namespace, Helm release secrets and Velero CRDs (default: false)"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/install [delete]
+func (h *Handler) UninstallVelero(c echo.Context) error {
+	return h.HandleResourceClient(c, "velero-uninstall", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		force := h.ResolveBool(c, "force", false)
+		return h.service.UninstallVeleroInternal(client, ctx, namespace, force)
+	})
+}
+
+// CleanupVelero : Velero 잔여 리소스 정리
+// @Summary Cleanup Velero
+// @Description Remove leftover Velero resources as an async job and report the remaining Velero resources
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param force query boolean false "Delete the namespace, Helm release secrets and Velero CRDs instead of only the Helm release (default: false)"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/cleanup [post]
+func (h *Handler) CleanupVelero(c echo.Context) error {
+	return h.HandleResourceClient(c, "velero-cleanup", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		force := h.ResolveBool(c, "force", false)
+		return h.service.CleanupVeleroInternal(client, ctx, namespace, force)
+	})
+}
+
+// GetInstallStatus : Velero 설치 상태 조회
+// @Summary Get Velero Installation Status
+// @Description Collect the Velero installation status and resource inventory as an async job
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/install/status [get]
+func (h *Handler) GetInstallStatus(c echo.Context) error {
+	return h.HandleResourceClient(c, "velero-install-status", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetInstallStatusInternal(client, ctx, namespace)
+	})
+}
+
 // GetBackups : Velero 백업 목록 조회
 // @Summary Get Velero Backups
 // @Description Get list of Velero backups
@@ -122,7 +188,12 @@ func (h *Handler) InstallVeleroWithMinIO(c echo.Context) error {
 func (h *Handler) GetBackups(c echo.Context) error {
 	return h.HandleResourceClient(c, "velero-backups", func(client client.Client, ctx context.Context) (interface{}, error) {
 		namespace := h.ResolveNamespace(c, "velero")
-		return h.service.GetBackupsInternal(client, ctx, namespace)
+		backups, err := h.service.GetBackupsInternal(client, ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		h.service.backupPhases.replace(h.ClusterLabel(c), namespace, backupPhases(backups))
+		return backups, nil
 	})
 }
 
@@ -147,7 +218,164 @@ func (h *Handler) GetBackup(c echo.Context) error {
 
 	return h.HandleResourceClient(c, "velero-backup", func(client client.Client, ctx context.Context) (interface{}, error) {
 		namespace := h.ResolveNamespace(c, "velero")
-		return h.service.GetBackupInternal(client, ctx, namespace, backupName)
+		backup, err := h.service.GetBackupInternal(client, ctx, namespace, backupName)
+		if err != nil {
+			return nil, err
+		}
+		h.service.backupPhases.set(h.ClusterLabel(c), namespace, backup.Name, string(backup.Status.Phase))
+		return backup, nil
+	})
+}
+
+// GetBackupContents : Velero 백업 내용 조회
+// @Summary Get Velero Backup Contents
+// @Description Download the backup tarball from object storage and list the namespaces, resource kinds and objects it contains. Results are cached per backup; set refresh=true to re-read the tarball.
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param backupName path string true "Backup name"
+// @Param request body types.DeleteBackupRequest true "Kubernetes and MinIO configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param itemNamespace query string false "Only list objects in this namespace"
+// @Param resource query string false "Only list objects of this resource (e.g. 'deployments.apps')"
+// @Param bucket query string false "Bucket override (default: bucket of the backup storage location)"
+// @Param prefix query string false "Prefix override, used together with bucket"
+// @Param refresh query boolean false "Ignore cached contents (default: false)"
+// @Success 200 {object} types.BackupContents
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/backups/{backupName}/contents [get]
+func (h *Handler) GetBackupContents(c echo.Context) error {
+	backupName := c.Param("backupName")
+	if backupName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "backupName is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-backup-contents", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		contents, err := h.service.GetBackupContentsInternal(client, ctx, namespace, backupName, h.backupContentsOptions(c))
+		if err != nil {
+			return nil, err
+		}
+		return filterBackupContents(contents, c.QueryParam("itemNamespace"), c.QueryParam("resource")), nil
+	})
+}
+
+// GetBackupContentManifest : Velero 백업에 포함된 객체 매니페스트 조회
+// @Summary Get Velero Backup Object Manifest
+// @Description Return the manifest of a single object stored in the backup tarball. Use output=yaml for YAML output. Secret data is removed for the viewer role.
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param backupName path string true "Backup name"
+// @Param resource path string true "Resource (e.g. 'deployments.apps', 'configmaps')"
+// @Param name path string true "Object name"
+// @Param request body types.DeleteBackupRequest true "Kubernetes and MinIO configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param itemNamespace query string false "Namespace of the object (empty for cluster-scoped objects)"
+// @Param bucket query string false "Bucket override (default: bucket of the backup storage location)"
+// @Param prefix query string false "Prefix override, used together with bucket"
+// @Param refresh query boolean false "Ignore cached contents (default: false)"
+// @Param output query string false "Output format ('yaml')"
+// @Success 200 {object} map[string]interface{}
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/backups/{backupName}/contents/{resource}/{name} [get]
+func (h *Handler) GetBackupContentManifest(c echo.Context) error {
+	backupName := c.Param("backupName")
+	resource := c.Param("resource")
+	name := c.Param("name")
+	if backupName == "" || resource == "" || name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "backupName, resource and name are required", "")
+	}
+
+	return h.HandleManifestClient(c, "velero-backup-manifest", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		manifest, err := h.service.GetBackupContentManifestInternal(client, ctx, namespace, backupName, resource,
+			c.QueryParam("itemNamespace"), name, h.backupContentsOptions(c))
+		if err != nil || h.CanReadSecretData(c) {
+			return manifest, err
+		}
+		// viewer에게는 Secret 데이터를 제외하고 응답
+		return handler.RedactSecretData(manifest), nil
+	})
+}
+
+// DiffBackups : 두 Velero 백업 비교
+// @Summary Diff Velero Backups
+// @Description Compare the objects stored in two backup tarballs and list the objects added, removed and changed in targetBackup relative to backupName. Changed objects include a JSON Patch style diff; status, managedFields and resourceVersion are ignored. Values of changed Secrets are omitted for the viewer role.
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param backupName path string true "Base backup name"
+// @Param targetBackup path string true "Backup name to compare against the base backup"
+// @Param request body types.DeleteBackupRequest true "Kubernetes and MinIO configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param itemNamespace query string false "Only compare objects in this namespace"
+// @Param resource query string false "Only compare objects of this resource (e.g. 'deployments.apps')"
+// @Param bucket query string false "Bucket override (default: bucket of each backup's storage location)"
+// @Param prefix query string false "Prefix override, used together with bucket"
+// @Param refresh query boolean false "Ignore cached contents (default: false)"
+// @Success 200 {object} types.BackupDiff
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/backups/{backupName}/diff/{targetBackup} [get]
+func (h *Handler) DiffBackups(c echo.Context) error {
+	backupName := c.Param("backupName")
+	targetBackup := c.Param("targetBackup")
+	if backupName == "" || targetBackup == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "backupName and targetBackup are required", "")
+	}
+	if backupName == targetBackup {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST", "Cannot diff a backup with itself", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-backup-diff", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		diff, err := h.service.DiffBackupsInternal(client, ctx, namespace, backupName, targetBackup,
+			c.QueryParam("itemNamespace"), c.QueryParam("resource"), h.backupContentsOptions(c))
+		if err != nil || h.CanReadSecretData(c) {
+			return diff, err
+		}
+		// viewer에게는 Secret 변경 값을 제외하고 응답
+		return redactSecretDiff(diff), nil
+	})
+}
+
+// backupContentsOptions : 백업 내용 조회 Query 파라미터 처리
+func (h *Handler) backupContentsOptions(c echo.Context) BackupContentsOptions {
+	return BackupContentsOptions{
+		Bucket:  c.QueryParam("bucket"),
+		Prefix:  c.QueryParam("prefix"),
+		Refresh: h.ResolveBool(c, "refresh", false),
+	}
+}
+
+// GetBackupLogs : Velero 백업 로그 조회
+// @Summary Get Velero Backup Logs
+// @Description Create a Velero DownloadRequest for the backup log, download it from the signed URL and return the decompressed log lines. The signed URL must be reachable from this server (set publicUrl on the backup storage location if needed).
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param backupName path string true "Backup name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param level query string false "Only return lines of this level or higher (debug, info, warning, error)"
+// @Param insecureSkipTLSVerify query boolean false "Skip TLS verification when downloading from the signed URL (default: false)"
+// @Success 200 {object} types.VeleroLogs
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/backups/{backupName}/logs [get]
+func (h *Handler) GetBackupLogs(c echo.Context) error {
+	backupName := c.Param("backupName")
+	if backupName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "backupName is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-backup-logs", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetBackupLogsInternal(client, ctx, namespace, backupName,
+			c.QueryParam("level"), h.ResolveBool(c, "insecureSkipTLSVerify", false))
 	})
 }
 
@@ -165,7 +393,12 @@ func (h *Handler) GetBackup(c echo.Context) error {
 func (h *Handler) GetRestores(c echo.Context) error {
 	return h.HandleResourceClient(c, "velero-restores", func(client client.Client, ctx context.Context) (interface{}, error) {
 		namespace := h.ResolveNamespace(c, "velero")
-		return h.service.GetRestoresInternal(client, ctx, namespace)
+		restores, err := h.service.GetRestoresInternal(client, ctx, namespace)
+		if err != nil {
+			return nil, err
+		}
+		h.service.restorePhases.replace(h.ClusterLabel(c), namespace, restorePhases(restores))
+		return restores, nil
 	})
 }
 
@@ -190,18 +423,79 @@ func (h *Handler) GetRestore(c echo.Context) error {
 
 	return h.HandleResourceClient(c, "velero-restore", func(client client.Client, ctx context.Context) (interface{}, error) {
 		namespace := h.ResolveNamespace(c, "velero")
-		return h.service.GetRestoreInternal(client, ctx, namespace, restoreName)
+		restore, err := h.service.GetRestoreInternal(client, ctx, namespace, restoreName)
+		if err != nil {
+			return nil, err
+		}
+		h.service.restorePhases.set(h.ClusterLabel(c), namespace, restore.Name, string(restore.Status.Phase))
+		return restore, nil
+	})
+}
+
+// GetRestoreLogs : Velero 복원 로그 조회
+// @Summary Get Velero Restore Logs
+// @Description Create a Velero DownloadRequest for the restore log, download it from the signed URL and return the decompressed log lines
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param restoreName path string true "Restore name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param level query string false "Only return lines of this level or higher (debug, info, warning, error)"
+// @Param insecureSkipTLSVerify query boolean false "Skip TLS verification when downloading from the signed URL (default: false)"
+// @Success 200 {object} types.VeleroLogs
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/restores/{restoreName}/logs [get]
+func (h *Handler) GetRestoreLogs(c echo.Context) error {
+	restoreName := c.Param("restoreName")
+	if restoreName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "restoreName is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-restore-logs", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetRestoreLogsInternal(client, ctx, namespace, restoreName,
+			c.QueryParam("level"), h.ResolveBool(c, "insecureSkipTLSVerify", false))
+	})
+}
+
+// GetRestoreResults : Velero 복원 결과 조회
+// @Summary Get Velero Restore Results
+// @Description Create a Velero DownloadRequest for the restore results and return the warnings and errors grouped by Velero, cluster and namespace
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param restoreName path string true "Restore name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Param insecureSkipTLSVerify query boolean false "Skip TLS verification when downloading from the signed URL (default: false)"
+// @Success 200 {object} types.RestoreResults
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/restores/{restoreName}/results [get]
+func (h *Handler) GetRestoreResults(c echo.Context) error {
+	restoreName := c.Param("restoreName")
+	if restoreName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "restoreName is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-restore-results", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetRestoreResultsInternal(client, ctx, namespace, restoreName, h.ResolveBool(c, "insecureSkipTLSVerify", false))
 	})
 }
 
 // CreateRestore : Velero 복원 생성
 // @Summary Create Velero Restore
-// @Description Create a new Velero restore from a backup
+// @Description Create a new Velero restore from a backup. With dryRun=true nothing is created: the objects that would be restored are computed from the backup tarball (the `minio` config is required) after include/exclude filters, label selector, namespace and storage class mappings, and conflicts with existing objects in the target cluster are flagged.
 // @Tags velero
 // @Accept json
 // @Produce json
 // @Param request body types.VeleroRestoreRequest true "Restore configuration"
+// @Param dryRun query boolean false "Preview the restore without creating it (default: false)"
 // @Success 200 {object} response.SuccessResponse
+// @Success 200 {object} types.RestorePreview "dryRun=true"
 // @Failure 400 {object} response.ErrorResponse
 // @Failure 500 {object} response.ErrorResponse
 // @Router /v1/velero/restores [post]
@@ -211,7 +505,18 @@ func (h *Handler) CreateRestore(c echo.Context) error {
 		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
 	}
 
+	// 등록된 클러스터/스토리지 참조 (스토리지는 dry-run에서만 사용)
+	if req.MinioConfig == nil && (req.StorageID != "" || c.QueryParam("storageId") != "") {
+		req.MinioConfig = &config.MinioConfig{}
+	}
+	if err := h.ResolveRegistryRefs(c, req.RegistryRef, &req.KubeConfig, req.MinioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
 	// 필수 필드 검증
+	if req.KubeConfig.KubeConfig == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "kubeconfig is required", "")
+	}
 	if req.Restore.Name == "" {
 		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST", "Restore name is required", "")
 	}
@@ -219,10 +524,19 @@ func (h *Handler) CreateRestore(c echo.Context) error {
 		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST", "Backup name is required", "")
 	}
 
+	// 네임스페이스 매핑, 훅, 메타데이터 검증 (복원 생성 전)
+	if _, err := BuildRestore(req.Restore); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_RESTORE_SPEC", "Invalid restore specification", err.Error())
+	}
+
+	if h.ResolveBool(c, "dryRun", false) {
+		return h.previewRestore(c, req)
+	}
+
 	// 클라이언트 생성
-	client, err := client.NewClientWithConfig(req.KubeConfig, "", "", "")
+	client, err := h.NewVeleroClient(req.KubeConfig, nil)
 	if err != nil {
-		return response.RespondWithErrorModel(c, 500, "CLIENT_CREATION_FAILED", "Failed to create client", err.Error())
+		return h.HandleConnectionError(c, "velero", "client creation", err)
 	}
 
 	// 복원 생성
@@ -234,6 +548,31 @@ func (h *Handler) CreateRestore(c echo.Context) error {
 	return response.RespondWithData(c, 200, result)
 }
 
+// previewRestore : 복원 dry-run (백업 tarball 조회를 위해 MinIO 설정 필요)
+func (h *Handler) previewRestore(c echo.Context, req types.VeleroRestoreRequest) error {
+	if req.MinioConfig == nil {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "minio configuration is required for dry-run", "")
+	}
+	if err := h.MinioValidator.ValidateMinioConfig(req.MinioConfig); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_MINIO_CONFIG", "Invalid minio configuration", err.Error())
+	}
+
+	unifiedClient, err := h.NewVeleroClient(req.KubeConfig, req.MinioConfig)
+	if err != nil {
+		return h.HandleConnectionError(c, "velero", "client creation", err)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), h.GetConfigDuration("VELERO_RESTORE_PREVIEW_TIMEOUT", 2*time.Minute))
+	defer cancel()
+
+	preview, err := h.service.PreviewRestoreInternal(unifiedClient, ctx, req)
+	if err != nil {
+		return h.HandleInternalError(c, "velero", "restore preview", err)
+	}
+
+	return response.RespondWithData(c, 200, preview)
+}
+
 // ValidateRestore : Velero 복원 검증
 // @Summary Validate Velero Restore
 // @Description Validate a specific Velero restore
@@ -287,6 +626,11 @@ func (h *Handler) DeleteRestore(c echo.Context) error {
 		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST", "Invalid request body format", "")
 	}
 
+	// 등록된 클러스터/스토리지 참조
+	if err := h.ResolveRegistryRefs(c, deleteReq.RegistryRef, &deleteReq.KubeConfig, &deleteReq.MinioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
 	// 필수 필드 검증
 	if deleteReq.KubeConfig.KubeConfig == "" {
 		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "kubeconfig is required", "")
@@ -306,12 +650,7 @@ func (h *Handler) DeleteRestore(c echo.Context) error {
 	defer cancel()
 
 	// 클라이언트 생성
-	unifiedClient, err := client.NewClientWithConfig(
-		&deleteReq.KubeConfig,
-		&deleteReq.KubeConfig,
-		nil,
-		&deleteReq.MinioConfig,
-	)
+	unifiedClient, err := h.NewVeleroClient(deleteReq.KubeConfig, &deleteReq.MinioConfig)
 	if err != nil {
 		return h.HandleConnectionError(c, "velero", "client creation", err)
 	}
@@ -423,6 +762,50 @@ func (h *Handler) GetJobStatus(c echo.Context) error {
 	return response.RespondWithData(c, 200, result)
 }
 
+// CancelJob : 진행 중인 작업 취소
+// @Summary Cancel Job
+// @Description Cancel a running job and clean up partially created resources
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param jobId path string true "Job ID"
+// @Success 200 {object} map[string]interface{} "Cancelled job"
+// @Failure 404 {object} map[string]interface{} "Job not found"
+// @Failure 409 {object} map[string]interface{} "Job already finished"
+// @Router /v1/velero/jobs/{jobId}/cancel [post]
+func (h *Handler) CancelJob(c echo.Context) error {
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "jobId is required", "")
+	}
+
+	result, err := h.service.CancelJobInternal(jobID)
+	if err != nil {
+		return h.HandleJobCancelError(c, err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// StreamJob : 작업 진행 상황 및 로그 실시간 스트리밍 (Server-Sent Events)
+// @Summary Stream Job Progress
+// @Description Stream job status and log lines as Server-Sent Events. Resume with the Last-Event-ID header.
+// @Tags velero
+// @Produce text/event-stream
+// @Param jobId path string true "Job ID"
+// @Param Last-Event-ID header string false "Number of log lines already received"
+// @Success 200 {string} string "Event stream"
+// @Failure 404 {object} map[string]interface{} "Job not found"
+// @Router /v1/velero/jobs/{jobId}/stream [get]
+func (h *Handler) StreamJob(c echo.Context) error {
+	jobID := c.Param("jobId")
+	if jobID == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "jobId is required", "")
+	}
+
+	return h.BaseHandler.StreamJob(c, h.service.jobManager, jobID)
+}
+
 // GetJobLogs : 작업 로그 조회
 // @Summary Get Job Logs
 // @Description Get the logs of a specific job
@@ -490,6 +873,11 @@ func (h *Handler) CreateBackup(c echo.Context) error {
 		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", errorMsg, "")
 	}
 
+	// 등록된 클러스터/스토리지 참조
+	if err := h.ResolveRegistryRefs(c, createBackupReq.RegistryRef, &createBackupReq.KubeConfig, &createBackupReq.MinioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
 	// 필수 필드 검증
 	if createBackupReq.Backup.Name == "" {
 		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "backup name is required", "")
@@ -514,29 +902,18 @@ func (h *Handler) CreateBackup(c echo.Context) error {
 	// Query 파라미터 처리
 	namespace := h.ResolveNamespace(c, "velero")
 
-	// Velero 설정 구성
-	veleroConfig := config.VeleroConfig{
-		KubeConfig:  createBackupReq.KubeConfig,
-		MinioConfig: createBackupReq.MinioConfig,
-	}
-
 	// 컨텍스트 생성 (타임아웃 설정)
 	ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Minute)
 	defer cancel()
 
 	// 클라이언트 생성
-	unifiedClient, err := client.NewClientWithConfig(
-		&veleroConfig.KubeConfig,
-		&veleroConfig.KubeConfig,
-		&veleroConfig,
-		&veleroConfig.MinioConfig,
-	)
+	unifiedClient, err := h.NewVeleroClient(createBackupReq.KubeConfig, &createBackupReq.MinioConfig)
 	if err != nil {
 		return h.HandleConnectionError(c, "velero", "client creation", err)
 	}
 
 	// 백업 생성 실행
-	result, err := h.service.CreateBackupInternal(unifiedClient, ctx, createBackupReq.Backup, namespace)
+	result, err := h.service.CreateBackupInternal(unifiedClient, ctx, createBackupReq.KubeConfig, createBackupReq.Backup, namespace)
 	if err != nil {
 		return h.HandleInternalError(c, "velero", "backup creation", err)
 	}
@@ -598,6 +975,11 @@ func (h *Handler) DeleteBackup(c echo.Context) error {
 		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST", "Invalid request body format", "")
 	}
 
+	// 등록된 클러스터/스토리지 참조
+	if err := h.ResolveRegistryRefs(c, deleteReq.RegistryRef, &deleteReq.KubeConfig, &deleteReq.MinioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
 	// 필수 필드 검증
 	if deleteReq.KubeConfig.KubeConfig == "" {
 		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "kubeconfig is required", "")
@@ -617,12 +999,7 @@ func (h *Handler) DeleteBackup(c echo.Context) error {
 	defer cancel()
 
 	// 클라이언트 생성
-	unifiedClient, err := client.NewClientWithConfig(
-		&deleteReq.KubeConfig,
-		&deleteReq.KubeConfig,
-		nil,
-		&deleteReq.MinioConfig,
-	)
+	unifiedClient, err := h.NewVeleroClient(deleteReq.KubeConfig, &deleteReq.MinioConfig)
 	if err != nil {
 		return h.HandleConnectionError(c, "velero", "client creation", err)
 	}
@@ -636,3 +1013,529 @@ func (h *Handler) DeleteBackup(c echo.Context) error {
 
 	return response.RespondWithData(c, 200, result)
 }
+
+// GetSchedules : Velero 스케줄 목록 조회
+// @Summary Get Velero Schedules
+// @Description Get list of Velero backup schedules
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/schedules [get]
+func (h *Handler) GetSchedules(c echo.Context) error {
+	return h.HandleResourceClient(c, "velero-schedules", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetSchedulesInternal(client, ctx, namespace)
+	})
+}
+
+// GetSchedule : Velero 스케줄 상세 조회
+// @Summary Get Velero Schedule Details
+// @Description Get detailed information about a specific Velero backup schedule
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param scheduleName path string true "Schedule name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/schedules/{scheduleName} [get]
+func (h *Handler) GetSchedule(c echo.Context) error {
+	scheduleName := c.Param("scheduleName")
+	if scheduleName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "scheduleName is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-schedule", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetScheduleInternal(client, ctx, namespace, scheduleName)
+	})
+}
+
+// CreateSchedule : Velero 스케줄 생성
+// @Summary Create Velero Schedule
+// @Description Create a Velero schedule that runs the backup template on a cron expression
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param request body types.CreateScheduleRequest true "Schedule configuration with kubeconfig and minio settings"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/schedules [post]
+func (h *Handler) CreateSchedule(c echo.Context) error {
+	var req types.CreateScheduleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+
+	return h.applySchedule(c, req, "schedule creation", func(unifiedClient client.Client, ctx context.Context, namespace string) (interface{}, error) {
+		return h.service.CreateScheduleInternal(unifiedClient, ctx, req.Schedule, namespace)
+	})
+}
+
+// UpdateSchedule : Velero 스케줄 수정
+// @Summary Update Velero Schedule
+// @Description Replace the cron expression and backup template of a Velero schedule. The paused flag is kept when omitted.
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param scheduleName path string true "Schedule name"
+// @Param request body types.CreateScheduleRequest true "Schedule configuration with kubeconfig and minio settings"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/schedules/{scheduleName} [put]
+func (h *Handler) UpdateSchedule(c echo.Context) error {
+	scheduleName := c.Param("scheduleName")
+	if scheduleName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "scheduleName is required", "")
+	}
+
+	var req types.CreateScheduleRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if req.Schedule.Name == "" {
+		req.Schedule.Name = scheduleName
+	}
+	if req.Schedule.Name != scheduleName {
+		return response.RespondWithErrorModel(c, 400, "INVALID_SCHEDULE", "Schedule name does not match path", "")
+	}
+
+	return h.applySchedule(c, req, "schedule update", func(unifiedClient client.Client, ctx context.Context, namespace string) (interface{}, error) {
+		return h.service.UpdateScheduleInternal(unifiedClient, ctx, scheduleName, req.Schedule, namespace)
+	})
+}
+
+// PauseSchedule : Velero 스케줄 일시 중지
+// @Summary Pause Velero Schedule
+// @Description Pause a Velero schedule so that no new backups are created
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param scheduleName path string true "Schedule name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/schedules/{scheduleName}/pause [post]
+func (h *Handler) PauseSchedule(c echo.Context) error {
+	return h.setSchedulePaused(c, true)
+}
+
+// UnpauseSchedule : Velero 스케줄 재개
+// @Summary Unpause Velero Schedule
+// @Description Resume a paused Velero schedule
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param scheduleName path string true "Schedule name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/schedules/{scheduleName}/unpause [post]
+func (h *Handler) UnpauseSchedule(c echo.Context) error {
+	return h.setSchedulePaused(c, false)
+}
+
+// DeleteSchedule : Velero 스케줄 삭제
+// @Summary Delete Velero Schedule
+// @Description Delete a Velero schedule. Backups already created by the schedule are kept.
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param scheduleName path string true "Schedule name"
+// @Param request body config.KubeConfig true "Kubernetes configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/schedules/{scheduleName} [delete]
+func (h *Handler) DeleteSchedule(c echo.Context) error {
+	scheduleName := c.Param("scheduleName")
+	if scheduleName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "scheduleName is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-schedule-delete", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.DeleteScheduleInternal(client, ctx, namespace, scheduleName)
+	})
+}
+
+// setSchedulePaused : 스케줄 일시 중지 여부 변경 공통 처리
+func (h *Handler) setSchedulePaused(c echo.Context, paused bool) error {
+	scheduleName := c.Param("scheduleName")
+	if scheduleName == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "scheduleName is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-schedule-pause", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.PauseScheduleInternal(client, ctx, namespace, scheduleName, paused)
+	})
+}
+
+// applySchedule : 스케줄 생성/수정 공통 처리 (요청 검증 후 클라이언트 생성)
+func (h *Handler) applySchedule(
+	c echo.Context,
+	req types.CreateScheduleRequest,
+	operation string,
+	apply func(client.Client, context.Context, string) (interface{}, error),
+) error {
+	// 등록된 클러스터/스토리지 참조
+	if err := h.ResolveRegistryRefs(c, req.RegistryRef, &req.KubeConfig, &req.MinioConfig); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+
+	// 필수 필드 검증
+	if req.KubeConfig.KubeConfig == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "kubeconfig is required", "")
+	}
+	if err := h.service.ValidateScheduleRequest(req.Schedule); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_SCHEDULE", "Invalid schedule specification", err.Error())
+	}
+
+	namespace := h.ResolveNamespace(c, "velero")
+
+	// 컨텍스트 생성 (타임아웃 설정)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Minute)
+	defer cancel()
+
+	// 클라이언트 생성
+	unifiedClient, err := h.NewVeleroClient(req.KubeConfig, &req.MinioConfig)
+	if err != nil {
+		return h.HandleConnectionError(c, "velero", "client creation", err)
+	}
+
+	result, err := apply(unifiedClient, ctx, namespace)
+	if err != nil {
+		return h.HandleInternalError(c, "velero", operation, err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
+
+// GetBackupStorageLocation : 백업 스토리지 위치 상세 조회
+// @Summary Get Backup Storage Location Details
+// @Description Get detailed information about a specific backup storage location
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Backup storage location name"
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/storage-locations/{name} [get]
+func (h *Handler) GetBackupStorageLocation(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-storage-location", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetBackupStorageLocationInternal(client, ctx, namespace, name)
+	})
+}
+
+// CreateBackupStorageLocation : 백업 스토리지 위치 생성
+// @Summary Create Backup Storage Location
+// @Description Create a backup storage location for S3 compatible storage, GCS or Azure Blob with an optional credential secret
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param request body types.CreateBackupStorageLocationRequest true "Backup storage location with kubeconfig"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/storage-locations [post]
+func (h *Handler) CreateBackupStorageLocation(c echo.Context) error {
+	var req types.CreateBackupStorageLocationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.service.ValidateBackupStorageLocationRequest(req.Location); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_STORAGE_LOCATION", "Invalid backup storage location specification", err.Error())
+	}
+
+	return h.applyStorageLocation(c, req.KubeConfig, req.ClusterID, "storage location creation", func(unifiedClient client.Client, ctx context.Context, namespace string) (interface{}, error) {
+		return h.service.CreateBackupStorageLocationInternal(unifiedClient, ctx, req.Location, namespace)
+	})
+}
+
+// UpdateBackupStorageLocation : 백업 스토리지 위치 수정
+// @Summary Update Backup Storage Location
+// @Description Replace the provider, bucket, config and credential of a backup storage location
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Backup storage location name"
+// @Param request body types.CreateBackupStorageLocationRequest true "Backup storage location with kubeconfig"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/storage-locations/{name} [put]
+func (h *Handler) UpdateBackupStorageLocation(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	var req types.CreateBackupStorageLocationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if req.Location.Name == "" {
+		req.Location.Name = name
+	}
+	if req.Location.Name != name {
+		return response.RespondWithErrorModel(c, 400, "INVALID_STORAGE_LOCATION", "Storage location name does not match path", "")
+	}
+	if err := h.service.ValidateBackupStorageLocationRequest(req.Location); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_STORAGE_LOCATION", "Invalid backup storage location specification", err.Error())
+	}
+
+	return h.applyStorageLocation(c, req.KubeConfig, req.ClusterID, "storage location update", func(unifiedClient client.Client, ctx context.Context, namespace string) (interface{}, error) {
+		return h.service.UpdateBackupStorageLocationInternal(unifiedClient, ctx, name, req.Location, namespace)
+	})
+}
+
+// DeleteBackupStorageLocation : 백업 스토리지 위치 삭제
+// @Summary Delete Backup Storage Location
+// @Description Delete a backup storage location. Backup data in the bucket and the credential secret are kept.
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Backup storage location name"
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/storage-locations/{name} [delete]
+func (h *Handler) DeleteBackupStorageLocation(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-storage-location-delete", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.DeleteBackupStorageLocationInternal(client, ctx, namespace, name)
+	})
+}
+
+// SetDefaultBackupStorageLocation : 기본 백업 스토리지 위치 전환
+// @Summary Set Default Backup Storage Location
+// @Description Mark a backup storage location as default and unset the default flag on all others
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Backup storage location name"
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/storage-locations/{name}/default [post]
+func (h *Handler) SetDefaultBackupStorageLocation(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-storage-location-default", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.SetDefaultBackupStorageLocationInternal(client, ctx, namespace, name)
+	})
+}
+
+// SetBackupStorageLocationAccessMode : 백업 스토리지 위치 접근 모드 변경
+// @Summary Set Backup Storage Location Access Mode
+// @Description Switch a backup storage location between ReadWrite and ReadOnly (use ReadOnly on migration target clusters)
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Backup storage location name"
+// @Param mode query string true "Access mode (ReadWrite, ReadOnly)"
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/storage-locations/{name}/access-mode [post]
+func (h *Handler) SetBackupStorageLocationAccessMode(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	mode := c.QueryParam("mode")
+	if mode != "ReadWrite" && mode != "ReadOnly" {
+		return response.RespondWithErrorModel(c, 400, "INVALID_PARAMETER", "mode must be ReadWrite or ReadOnly", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-storage-location-access-mode", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.SetBackupStorageLocationAccessModeInternal(client, ctx, namespace, name, mode)
+	})
+}
+
+// GetVolumeSnapshotLocation : 볼륨 스냅샷 위치 상세 조회
+// @Summary Get Volume Snapshot Location Details
+// @Description Get detailed information about a specific volume snapshot location
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Volume snapshot location name"
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 404 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/volume-snapshot-locations/{name} [get]
+func (h *Handler) GetVolumeSnapshotLocation(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-volume-snapshot-location", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.GetVolumeSnapshotLocationInternal(client, ctx, namespace, name)
+	})
+}
+
+// CreateVolumeSnapshotLocation : 볼륨 스냅샷 위치 생성
+// @Summary Create Volume Snapshot Location
+// @Description Create a volume snapshot location for AWS EBS, GCP persistent disks or Azure managed disks
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param request body types.CreateVolumeSnapshotLocationRequest true "Volume snapshot location with kubeconfig"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/volume-snapshot-locations [post]
+func (h *Handler) CreateVolumeSnapshotLocation(c echo.Context) error {
+	var req types.CreateVolumeSnapshotLocationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if err := h.service.ValidateVolumeSnapshotLocationRequest(req.Location); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_SNAPSHOT_LOCATION", "Invalid volume snapshot location specification", err.Error())
+	}
+
+	return h.applyStorageLocation(c, req.KubeConfig, req.ClusterID, "snapshot location creation", func(unifiedClient client.Client, ctx context.Context, namespace string) (interface{}, error) {
+		return h.service.CreateVolumeSnapshotLocationInternal(unifiedClient, ctx, req.Location, namespace)
+	})
+}
+
+// UpdateVolumeSnapshotLocation : 볼륨 스냅샷 위치 수정
+// @Summary Update Volume Snapshot Location
+// @Description Replace the provider, config and credential of a volume snapshot location
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Volume snapshot location name"
+// @Param request body types.CreateVolumeSnapshotLocationRequest true "Volume snapshot location with kubeconfig"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 400 {object} response.ErrorResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/volume-snapshot-locations/{name} [put]
+func (h *Handler) UpdateVolumeSnapshotLocation(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	var req types.CreateVolumeSnapshotLocationRequest
+	if err := c.Bind(&req); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_REQUEST_BODY", "Invalid request body format", err.Error())
+	}
+	if req.Location.Name == "" {
+		req.Location.Name = name
+	}
+	if req.Location.Name != name {
+		return response.RespondWithErrorModel(c, 400, "INVALID_SNAPSHOT_LOCATION", "Snapshot location name does not match path", "")
+	}
+	if err := h.service.ValidateVolumeSnapshotLocationRequest(req.Location); err != nil {
+		return response.RespondWithErrorModel(c, 400, "INVALID_SNAPSHOT_LOCATION", "Invalid volume snapshot location specification", err.Error())
+	}
+
+	return h.applyStorageLocation(c, req.KubeConfig, req.ClusterID, "snapshot location update", func(unifiedClient client.Client, ctx context.Context, namespace string) (interface{}, error) {
+		return h.service.UpdateVolumeSnapshotLocationInternal(unifiedClient, ctx, name, req.Location, namespace)
+	})
+}
+
+// DeleteVolumeSnapshotLocation : 볼륨 스냅샷 위치 삭제
+// @Summary Delete Volume Snapshot Location
+// @Description Delete a volume snapshot location. The credential secret is kept.
+// @Tags velero
+// @Accept json
+// @Produce json
+// @Param name path string true "Volume snapshot location name"
+// @Param request body config.VeleroConfig true "Velero configuration"
+// @Param namespace query string false "Namespace name (default: 'velero')"
+// @Success 200 {object} response.SuccessResponse
+// @Failure 500 {object} response.ErrorResponse
+// @Router /v1/velero/volume-snapshot-locations/{name} [delete]
+func (h *Handler) DeleteVolumeSnapshotLocation(c echo.Context) error {
+	name := c.Param("name")
+	if name == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "name is required", "")
+	}
+
+	return h.HandleResourceClient(c, "velero-volume-snapshot-location-delete", func(client client.Client, ctx context.Context) (interface{}, error) {
+		namespace := h.ResolveNamespace(c, "velero")
+		return h.service.DeleteVolumeSnapshotLocationInternal(client, ctx, namespace, name)
+	})
+}
+
+// applyStorageLocation : BSL/VSL 생성/수정 공통 처리 (MinIO 설정 없이 kubeconfig 또는 등록된 클러스터로 클라이언트 생성)
+func (h *Handler) applyStorageLocation(
+	c echo.Context,
+	kubeConfig config.KubeConfig,
+	clusterID string,
+	operation string,
+	apply func(client.Client, context.Context, string) (interface{}, error),
+) error {
+	if err := h.ResolveRegistryRefs(c, types.RegistryRef{ClusterID: clusterID}, &kubeConfig, nil); err != nil {
+		return h.HandleConfigError(c, err)
+	}
+	if kubeConfig.KubeConfig == "" {
+		return response.RespondWithErrorModel(c, 400, "MISSING_PARAMETER", "kubeconfig is required", "")
+	}
+
+	namespace := h.ResolveNamespace(c, "velero")
+
+	// 컨텍스트 생성 (타임아웃 설정)
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 2*time.Minute)
+	defer cancel()
+
+	// 클라이언트 생성
+	unifiedClient, err := h.NewVeleroClient(kubeConfig, nil)
+	if err != nil {
+		return h.HandleConnectionError(c, "velero", "client creation", err)
+	}
+
+	result, err := apply(unifiedClient, ctx, namespace)
+	if err != nil {
+		return h.HandleInternalError(c, "velero", operation, err)
+	}
+
+	return response.RespondWithData(c, 200, result)
+}
@@ -1,15 +1,28 @@
 package velero
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
+	"context"
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/mocks"
+	"github.com/taking/kubemigrate/internal/registry"
+	veleroclient "github.com/taking/kubemigrate/pkg/client/velero"
+	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // TestVeleroHandler_HealthCheck 헬스체크 API 테스트
@@ -160,3 +173,953 @@ func TestVeleroHandler_InvalidRequest(t *testing.T) {
 		t.Log("No error occurred, but this is acceptable for this test")
 	}
 }
+
+// TestVeleroHandler_CreateRestoreInvalidSpec 잘못된 복원 스펙 검증 테스트
+func TestVeleroHandler_CreateRestoreInvalidSpec(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	veleroHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	tests := []struct {
+		name    string
+		restore map[string]interface{}
+	}{
+		{
+			name: "includeNamespaces에 없는 매핑 원본",
+			restore: map[string]interface{}{
+				"namespaceMappings": map[string]string{"other": "other-restored"},
+			},
+		},
+		{
+			name: "잘못된 매핑 대상 네임스페이스",
+			restore: map[string]interface{}{
+				"namespaceMappings": map[string]string{"app": "Invalid_NS"},
+			},
+		},
+		{
+			name: "잘못된 existingResourcePolicy",
+			restore: map[string]interface{}{
+				"existingResourcePolicy": "replace",
+			},
+		},
+		{
+			name: "지원하지 않는 preHooks",
+			restore: map[string]interface{}{
+				"hooks": map[string]interface{}{
+					"resources": []map[string]interface{}{
+						{
+							"name":     "pre",
+							"preHooks": []map[string]interface{}{{"exec": map[string]interface{}{"command": []string{"true"}}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "잘못된 exec 타임아웃",
+			restore: map[string]interface{}{
+				"hooks": map[string]interface{}{
+					"resources": []map[string]interface{}{
+						{
+							"name":      "post",
+							"postHooks": []map[string]interface{}{{"exec": map[string]interface{}{"command": []string{"true"}, "timeout": "soon"}}},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "잘못된 메타데이터 레이블",
+			restore: map[string]interface{}{
+				"metadata": map[string]string{"bad key": "value"},
+			},
+		},
+		{
+			name: "대문자 접두사 어노테이션 키",
+			restore: map[string]interface{}{
+				"annotations": map[string]string{"KubeMigrate.IO/source": "cluster-a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			restore := map[string]interface{}{
+				"name":                    "my-restore",
+				"backupName":              "my-backup",
+				"includeNamespaces":       []string{"app"},
+				"includeClusterResources": false,
+				"restorePVs":              true,
+			}
+			for k, v := range tt.restore {
+				restore[k] = v
+			}
+
+			reqBody, _ := json.Marshal(map[string]interface{}{
+				"kubeconfig": map[string]interface{}{
+					"kubeconfig": "apiVersion: v1\nkind: Config",
+				},
+				"restore": restore,
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/velero/restores", bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if err := veleroHandler.CreateRestore(c); err != nil {
+				t.Fatalf("CreateRestore() error = %v", err)
+			}
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("CreateRestore() status = %d, want %d (body: %s)", rec.Code, http.StatusBadRequest, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestVeleroHandler_UninstallAndStatus Velero 제거/정리/상태 조회 비동기 작업 테스트
+func TestVeleroHandler_UninstallAndStatus(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	veleroHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		handle  func(echo.Context) error
+		jobType string
+	}{
+		{name: "제거", method: http.MethodDelete, target: "/api/v1/velero/install", handle: veleroHandler.UninstallVelero, jobType: "velero-uninstall-"},
+		{name: "정리", method: http.MethodPost, target: "/api/v1/velero/cleanup", handle: veleroHandler.CleanupVelero, jobType: "velero-cleanup-"},
+		{name: "상태 조회", method: http.MethodGet, target: "/api/v1/velero/install/status", handle: veleroHandler.GetInstallStatus, jobType: "velero-status-"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqBody, _ := json.Marshal(map[string]interface{}{
+				"kubeconfig": map[string]interface{}{"kubeconfig": "apiVersion: v1\nkind: Config"},
+				"minio": map[string]interface{}{
+					"endpoint":  "localhost:9000",
+					"accessKey": "minioadmin",
+					"secretKey": "minioadmin123",
+				},
+			})
+
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if err := tt.handle(c); err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if rec.Code != http.StatusOK {
+				t.Fatalf("Expected status %d, got %d (body: %s)", http.StatusOK, rec.Code, rec.Body.String())
+			}
+
+			var resp struct {
+				Data struct {
+					JobID string `json:"jobId"`
+				} `json:"data"`
+			}
+			if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("Failed to decode response: %v", err)
+			}
+			if !strings.HasPrefix(resp.Data.JobID, tt.jobType) {
+				t.Fatalf("Expected job ID prefix %q, got %q", tt.jobType, resp.Data.JobID)
+			}
+
+			// 작업 완료 대기 후 인벤토리 확인
+			var info *job.JobInfo
+			deadline := time.Now().Add(5 * time.Second)
+			for time.Now().Before(deadline) {
+				snapshot, exists := veleroHandler.service.jobManager.Snapshot(resp.Data.JobID)
+				if !exists {
+					t.Fatalf("Job %s not found", resp.Data.JobID)
+				}
+				info = snapshot
+				if info.Status == job.JobStatusCompleted || info.Status == job.JobStatusFailed {
+					break
+				}
+				time.Sleep(20 * time.Millisecond)
+			}
+			if info.Status != job.JobStatusCompleted {
+				t.Fatalf("Expected job to complete, got %s (%s)", info.Status, info.Message)
+			}
+
+			result := info.Result.(map[string]interface{})
+			resources, ok := result["resources"].(*types.VeleroResources)
+			if !ok {
+				t.Fatalf("Expected resources inventory in result, got %T", result["resources"])
+			}
+			if len(resources.Pods) != 1 || resources.Pods[0] != "test-pod" {
+				t.Errorf("Expected pods [test-pod], got %v", resources.Pods)
+			}
+			if len(resources.ClusterRoles) != 0 {
+				t.Errorf("Expected non-velero cluster roles to be filtered, got %v", resources.ClusterRoles)
+			}
+		})
+	}
+}
+
+// TestVeleroHandler_CreateScheduleInvalid 잘못된 스케줄 요청 검증 테스트
+func TestVeleroHandler_CreateScheduleInvalid(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	veleroHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	tests := []struct {
+		name     string
+		schedule map[string]interface{}
+	}{
+		{name: "잘못된 cron 표현식", schedule: map[string]interface{}{"name": "daily", "schedule": "0 25 * * *"}},
+		{name: "cron 표현식 누락", schedule: map[string]interface{}{"name": "daily"}},
+		{name: "잘못된 스케줄 이름", schedule: map[string]interface{}{"name": "Daily_Backup", "schedule": "@daily"}},
+		{
+			name: "잘못된 템플릿 TTL",
+			schedule: map[string]interface{}{
+				"name": "daily", "schedule": "@daily",
+				"template": map[string]interface{}{"ttl": "30days"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqBody, _ := json.Marshal(map[string]interface{}{
+				"kubeconfig": map[string]interface{}{"kubeconfig": "apiVersion: v1\nkind: Config"},
+				"schedule":   tt.schedule,
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/velero/schedules", bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if err := veleroHandler.CreateSchedule(c); err != nil {
+				t.Fatalf("CreateSchedule() error = %v", err)
+			}
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+			}
+		})
+	}
+}
+
+// TestVeleroHandler_CreateBackupStorageLocationInvalid 잘못된 BSL 요청 검증 테스트
+func TestVeleroHandler_CreateBackupStorageLocationInvalid(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	veleroHandler := NewHandler(baseHandler)
+
+	e := echo.New()
+
+	tests := []struct {
+		name     string
+		location map[string]interface{}
+	}{
+		{name: "지원하지 않는 provider", location: map[string]interface{}{"name": "primary", "provider": "oracle", "bucket": "backups"}},
+		{name: "버킷 누락", location: map[string]interface{}{"name": "primary", "provider": "gcp"}},
+		{name: "Azure storageAccount 누락", location: map[string]interface{}{"name": "primary", "provider": "azure", "bucket": "backups"}},
+		{
+			name: "S3 호환 스토리지 region 누락",
+			location: map[string]interface{}{
+				"name": "primary", "provider": "aws", "bucket": "backups",
+				"config": map[string]interface{}{"s3Url": "https://s3.example.com"},
+			},
+		},
+		{name: "잘못된 접근 모드", location: map[string]interface{}{"name": "primary", "provider": "gcp", "bucket": "backups", "accessMode": "WriteOnly"}},
+		{name: "슬래시로 시작하는 접두사", location: map[string]interface{}{"name": "primary", "provider": "gcp", "bucket": "backups", "prefix": "/cluster-a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reqBody, _ := json.Marshal(map[string]interface{}{
+				"kubeconfig": map[string]interface{}{"kubeconfig": "apiVersion: v1\nkind: Config"},
+				"location":   tt.location,
+			})
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/velero/storage-locations", bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := e.NewContext(req, rec)
+
+			if err := veleroHandler.CreateBackupStorageLocation(c); err != nil {
+				t.Fatalf("CreateBackupStorageLocation() error = %v", err)
+			}
+			if rec.Code != http.StatusBadRequest {
+				t.Errorf("Expected status %d, got %d", http.StatusBadRequest, rec.Code)
+			}
+		})
+	}
+}
+
+// TestVeleroHandler_RegistryRefs : 등록 ID(clusterId/storageId)만으로 변경 API 호출 테스트
+func TestVeleroHandler_RegistryRefs(t *testing.T) {
+	workerPool := job.NewWorkerPool(2)
+	defer workerPool.Close()
+	baseHandler := handler.NewBaseHandlerWithMock(workerPool)
+	veleroHandler := NewHandler(baseHandler)
+
+	ctx := context.Background()
+	if _, err := baseHandler.Registry.CreateCluster(ctx, registry.Cluster{ID: "prod-a", KubeConfig: "apiVersion: v1\nkind: Config"}); err != nil {
+		t.Fatalf("CreateCluster() error = %v", err)
+	}
+	if _, err := baseHandler.Registry.CreateStorage(ctx, registry.Storage{ID: "backup-minio", Minio: config.MinioConfig{
+		Endpoint: "localhost:9000", AccessKey: "minioadmin", SecretKey: "minioadmin123",
+	}}); err != nil {
+		t.Fatalf("CreateStorage() error = %v", err)
+	}
+
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		body         map[string]interface{}
+		params       map[string]string
+		handle       func(echo.Context) error
+		expectedCode int
+	}{
+		{
+			name:   "install",
+			method: http.MethodPost,
+			target: "/velero/install",
+			body:   map[string]interface{}{"clusterId": "prod-a", "storageId": "backup-minio"},
+			handle: veleroHandler.InstallVeleroWithMinIO, expectedCode: http.StatusOK,
+		},
+		{
+			name:   "create backup",
+			method: http.MethodPost,
+			target: "/velero/backups",
+			body: map[string]interface{}{
+				"clusterId": "prod-a", "storageId": "backup-minio",
+				"backup": map[string]interface{}{"name": "nightly"},
+			},
+			handle: veleroHandler.CreateBackup, expectedCode: http.StatusOK,
+		},
+		{
+			name:   "delete backup with query ids",
+			method: http.MethodDelete,
+			target: "/velero/backups/nightly?clusterId=prod-a&storageId=backup-minio",
+			params: map[string]string{"backupName": "nightly"},
+			handle: veleroHandler.DeleteBackup, expectedCode: http.StatusOK,
+		},
+		{
+			name:   "create restore",
+			method: http.MethodPost,
+			target: "/velero/restores",
+			body: map[string]interface{}{
+				"clusterId": "prod-a",
+				"restore":   map[string]interface{}{"name": "nightly-restore", "backupName": "nightly"},
+			},
+			handle: veleroHandler.CreateRestore, expectedCode: http.StatusOK,
+		},
+		{
+			name:   "delete restore",
+			method: http.MethodDelete,
+			target: "/velero/restores/nightly-restore",
+			body:   map[string]interface{}{"clusterId": "prod-a", "storageId": "backup-minio"},
+			params: map[string]string{"restoreName": "nightly-restore"},
+			handle: veleroHandler.DeleteRestore, expectedCode: http.StatusOK,
+		},
+		{
+			name:   "create schedule",
+			method: http.MethodPost,
+			target: "/velero/schedules",
+			body: map[string]interface{}{
+				"clusterId": "prod-a", "storageId": "backup-minio",
+				"schedule": map[string]interface{}{"name": "daily", "schedule": "0 2 * * *"},
+			},
+			handle: veleroHandler.CreateSchedule, expectedCode: http.StatusOK,
+		},
+		{
+			name:   "unknown cluster",
+			method: http.MethodPost,
+			target: "/velero/backups",
+			body: map[string]interface{}{
+				"clusterId": "missing", "storageId": "backup-minio",
+				"backup": map[string]interface{}{"name": "nightly"},
+			},
+			handle: veleroHandler.CreateBackup, expectedCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var reqBody []byte
+			if tt.body != nil {
+				reqBody, _ = json.Marshal(tt.body)
+			}
+			req := httptest.NewRequest(tt.method, tt.target, bytes.NewReader(reqBody))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+			for name, value := range tt.params {
+				c.SetParamNames(name)
+				c.SetParamValues(value)
+			}
+
+			if err := tt.handle(c); err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+			if rec.Code != tt.expectedCode {
+				t.Errorf("Expected status %d, got %d: %s", tt.expectedCode, rec.Code, rec.Body.String())
+			}
+		})
+	}
+}
+
+// TestBuildBackupStorageLocation BSL 스펙 변환 테스트
+func TestBuildBackupStorageLocation(t *testing.T) {
+	credential := &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: "aws-primary-credentials"},
+		Key:                  "cloud",
+	}
+	bsl, err := buildBackupStorageLocation(types.BackupStorageLocationRequest{
+		Name:             "aws-primary",
+		Provider:         "aws",
+		Bucket:           "backups",
+		Prefix:           "cluster-a",
+		CACert:           "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+		Config:           map[string]string{"region": "us-east-1"},
+		Default:          true,
+		BackupSyncPeriod: "5m",
+	}, "velero", credential)
+	if err != nil {
+		t.Fatalf("buildBackupStorageLocation() error = %v", err)
+	}
+
+	if bsl.Namespace != "velero" || bsl.Spec.Provider != "aws" || !bsl.Spec.Default {
+		t.Errorf("Unexpected BSL metadata/spec: %+v", bsl)
+	}
+	if bsl.Spec.ObjectStorage == nil || bsl.Spec.ObjectStorage.Bucket != "backups" || bsl.Spec.ObjectStorage.Prefix != "cluster-a" {
+		t.Fatalf("Unexpected object storage: %+v", bsl.Spec.ObjectStorage)
+	}
+	if len(bsl.Spec.ObjectStorage.CACert) == 0 {
+		t.Error("Expected CA bundle to be set")
+	}
+	if bsl.Spec.AccessMode != velerov1.BackupStorageLocationAccessModeReadWrite {
+		t.Errorf("Expected default access mode ReadWrite, got %q", bsl.Spec.AccessMode)
+	}
+	if bsl.Spec.BackupSyncPeriod == nil || bsl.Spec.BackupSyncPeriod.Duration != 5*time.Minute {
+		t.Errorf("Expected backup sync period 5m, got %v", bsl.Spec.BackupSyncPeriod)
+	}
+	if bsl.Spec.Credential == nil || bsl.Spec.Credential.Name != "aws-primary-credentials" {
+		t.Errorf("Expected credential selector, got %+v", bsl.Spec.Credential)
+	}
+}
+
+// TestBuildScheduleSpec 스케줄 스펙 변환 테스트
+func TestBuildScheduleSpec(t *testing.T) {
+	paused := true
+	spec := buildScheduleSpec(types.ScheduleRequest{
+		Name:     "nightly",
+		Schedule: " 0 2 * * * ",
+		Paused:   &paused,
+		Template: types.BackupRequest{
+			IncludeNamespaces: []string{"app"},
+			LabelSelector:     map[string]string{"tier": "db"},
+			TTL:               "24h",
+		},
+	})
+
+	if spec.Schedule != "0 2 * * *" {
+		t.Errorf("Expected trimmed cron expression, got %q", spec.Schedule)
+	}
+	if !spec.Paused {
+		t.Error("Expected schedule to be paused")
+	}
+	if len(spec.Template.IncludedNamespaces) != 1 || spec.Template.IncludedNamespaces[0] != "app" {
+		t.Errorf("Unexpected template namespaces: %v", spec.Template.IncludedNamespaces)
+	}
+	if spec.Template.LabelSelector == nil || spec.Template.LabelSelector.MatchLabels["tier"] != "db" {
+		t.Errorf("Expected template label selector, got %+v", spec.Template.LabelSelector)
+	}
+	if spec.Template.TTL.Duration != 24*time.Hour {
+		t.Errorf("Expected template ttl 24h, got %v", spec.Template.TTL.Duration)
+	}
+	if spec.Template.DefaultVolumesToFsBackup == nil || *spec.Template.DefaultVolumesToFsBackup {
+		t.Errorf("Expected template defaultVolumesToFsBackup to default to false, got %v", spec.Template.DefaultVolumesToFsBackup)
+	}
+}
+
+// TestUpdateScheduleSpec 스케줄 수정 시 일시 중지 상태 유지 테스트
+func TestUpdateScheduleSpec(t *testing.T) {
+	current := velerov1.ScheduleSpec{Schedule: "0 2 * * *", Paused: true}
+	resume := false
+
+	tests := []struct {
+		name       string
+		paused     *bool
+		wantPaused bool
+	}{
+		{"paused omitted", nil, true},
+		{"paused explicitly false", &resume, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := updateScheduleSpec(current, types.ScheduleRequest{Name: "nightly", Schedule: "0 3 * * *", Paused: tt.paused})
+			if spec.Paused != tt.wantPaused {
+				t.Errorf("Expected paused %t, got %t", tt.wantPaused, spec.Paused)
+			}
+			if spec.Schedule != "0 3 * * *" {
+				t.Errorf("Expected schedule to be replaced, got %q", spec.Schedule)
+			}
+		})
+	}
+}
+
+// TestBuildRestore 복원 스펙 변환 테스트
+func TestBuildRestore(t *testing.T) {
+	waitForReady := true
+	restore, err := BuildRestore(types.RestoreRequest{
+		Name:                   "my-restore",
+		BackupName:             "my-backup",
+		IncludeNamespaces:      []string{"app"},
+		NamespaceMappings:      map[string]string{"app": "app-restored"},
+		ExistingResourcePolicy: "update",
+		Metadata:               map[string]string{"migration": "cluster-a"},
+		Annotations:            map[string]string{"kubemigrate.io/source": "cluster-a"},
+		Hooks: &types.RestoreHooks{
+			Resources: []types.RestoreResourceHookSpec{
+				{
+					Name:       "db",
+					Namespaces: []string{"app"},
+					PostHooks: []types.RestoreHookSpec{
+						{Exec: &types.ExecHook{Command: []string{"/bin/true"}, OnError: "Fail", Timeout: "30s", WaitForReady: &waitForReady}},
+						{Init: &types.InitHook{InitContainers: []v1.Container{{Name: "init", Image: "busybox"}}, Timeout: "1m"}},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("BuildRestore() error = %v", err)
+	}
+
+	if got := restore.Spec.NamespaceMapping["app"]; got != "app-restored" {
+		t.Errorf("NamespaceMapping[app] = %q, want %q", got, "app-restored")
+	}
+	if restore.Spec.ExistingResourcePolicy != velerov1.PolicyTypeUpdate {
+		t.Errorf("ExistingResourcePolicy = %q, want %q", restore.Spec.ExistingResourcePolicy, velerov1.PolicyTypeUpdate)
+	}
+	if restore.Labels["migration"] != "cluster-a" || restore.Annotations["kubemigrate.io/source"] != "cluster-a" {
+		t.Errorf("metadata not applied: labels=%v annotations=%v", restore.Labels, restore.Annotations)
+	}
+	if len(restore.Spec.Hooks.Resources) != 1 || len(restore.Spec.Hooks.Resources[0].PostHooks) != 2 {
+		t.Fatalf("hooks not converted: %+v", restore.Spec.Hooks)
+	}
+	hooks := restore.Spec.Hooks.Resources[0].PostHooks
+	if hooks[0].Exec == nil || hooks[0].Exec.ExecTimeout.Duration != 30*time.Second || hooks[0].Exec.OnError != velerov1.HookErrorModeFail {
+		t.Errorf("exec hook = %+v", hooks[0].Exec)
+	}
+	if hooks[1].Init == nil || len(hooks[1].Init.InitContainers) != 1 || hooks[1].Init.Timeout.Duration != time.Minute {
+		t.Errorf("init hook = %+v", hooks[1].Init)
+	}
+}
+
+// TestIndexBackupTarball 백업 tarball 색인 및 매니페스트 추출 테스트
+func TestIndexBackupTarball(t *testing.T) {
+	files := map[string]string{
+		"metadata/version": "1",
+		"resources/deployments.apps/namespaces/app/web.json":                                         `{"kind":"Deployment","metadata":{"name":"web"}}`,
+		"resources/deployments.apps/v1-preferredversion/namespaces/app/web.json":                     `{"kind":"Deployment","metadata":{"name":"web"}}`,
+		"resources/configmaps/v1-preferredversion/namespaces/app/settings.json":                      `{"kind":"ConfigMap","metadata":{"name":"settings"}}`,
+		"resources/namespaces/cluster/app.json":                                                      `{"kind":"Namespace","metadata":{"name":"app"}}`,
+		"resources/namespaces/v1-preferredversion/cluster/app.json":                                  `{"kind":"Namespace","metadata":{"name":"app"}}`,
+		"resources/horizontalpodautoscalers.autoscaling/v2/namespaces/app/web.json":                  `{"kind":"HorizontalPodAutoscaler"}`,
+		"resources/horizontalpodautoscalers.autoscaling/namespaces/app/web.json":                     `{"kind":"HorizontalPodAutoscaler"}`,
+		"resources/horizontalpodautoscalers.autoscaling/v1-preferredversion/namespaces/app/web.json": `{"kind":"HorizontalPodAutoscaler"}`,
+	}
+
+	tarball := buildBackupTarball(t, files)
+
+	items, err := indexBackupTarball(bytes.NewReader(tarball))
+	if err != nil {
+		t.Fatalf("indexBackupTarball() error = %v", err)
+	}
+	if len(items) != 4 {
+		t.Fatalf("Expected 4 items, got %d: %+v", len(items), items)
+	}
+
+	// 클러스터 범위 객체가 먼저 정렬됨
+	if items[0].Resource != "namespaces" || items[0].Namespace != "" || items[0].Path != "resources/namespaces/cluster/app.json" {
+		t.Errorf("Unexpected cluster-scoped item: %+v", items[0])
+	}
+
+	var hpa types.BackupContentItem
+	for _, item := range items {
+		if item.Resource == "horizontalpodautoscalers.autoscaling" {
+			hpa = item
+		}
+	}
+	if hpa.PreferredVersion != "v1" || len(hpa.Versions) != 2 {
+		t.Errorf("Expected preferred version v1 and 2 versions, got %+v", hpa)
+	}
+	if hpa.Path != "resources/horizontalpodautoscalers.autoscaling/namespaces/app/web.json" {
+		t.Errorf("Expected unversioned path to be preferred, got %s", hpa.Path)
+	}
+
+	filtered := filterBackupContents(&types.BackupContents{Items: items, TotalItems: len(items)}, "app", "configmaps")
+	if filtered.TotalItems != 1 || filtered.Items[0].Name != "settings" {
+		t.Errorf("Unexpected filtered contents: %+v", filtered.Items)
+	}
+
+	data, err := extractBackupTarballFile(bytes.NewReader(tarball), filtered.Items[0].Path)
+	if err != nil {
+		t.Fatalf("extractBackupTarballFile() error = %v", err)
+	}
+	if !strings.Contains(string(data), `"ConfigMap"`) {
+		t.Errorf("Unexpected manifest: %s", data)
+	}
+	if _, err := extractBackupTarballFile(bytes.NewReader(tarball), "resources/missing.json"); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+// TestDiffBackupContents 백업 비교 테스트
+func TestDiffBackupContents(t *testing.T) {
+	fromTarball := buildBackupTarball(t, map[string]string{
+		"resources/deployments.apps/namespaces/app/web.json": `{"metadata":{"name":"web","resourceVersion":"100","managedFields":[{"manager":"kubectl"}]},"spec":{"replicas":2,"template":{"metadata":{"labels":{"app/name":"web"}}}},"status":{"readyReplicas":2}}`,
+		"resources/configmaps/namespaces/app/old.json":       `{"metadata":{"name":"old"},"data":{"a":"1"}}`,
+		"resources/secrets/namespaces/app/token.json":        `{"metadata":{"name":"token","resourceVersion":"5"},"data":{"t":"x"}}`,
+	})
+	toTarball := buildBackupTarball(t, map[string]string{
+		"resources/deployments.apps/namespaces/app/web.json": `{"metadata":{"name":"web","resourceVersion":"200","labels":{"tier":"front"}},"spec":{"replicas":3,"template":{"metadata":{"labels":{"app/name":"web"}}}},"status":{"readyReplicas":1}}`,
+		"resources/configmaps/namespaces/app/new.json":       `{"metadata":{"name":"new"}}`,
+		"resources/secrets/namespaces/app/token.json":        `{"metadata":{"name":"token","resourceVersion":"9"},"data":{"t":"x"}}`,
+	})
+
+	load := func(name string, tarball []byte) (*types.BackupContents, map[string]map[string]interface{}) {
+		items, err := indexBackupTarball(bytes.NewReader(tarball))
+		if err != nil {
+			t.Fatalf("indexBackupTarball() error = %v", err)
+		}
+		paths := map[string]bool{}
+		for _, item := range items {
+			paths[item.Path] = true
+		}
+		manifests, err := readBackupTarballManifests(bytes.NewReader(tarball), paths)
+		if err != nil {
+			t.Fatalf("readBackupTarballManifests() error = %v", err)
+		}
+		return &types.BackupContents{BackupName: name, Items: items}, manifests
+	}
+	from, fromManifests := load("nightly-1", fromTarball)
+	to, toManifests := load("nightly-2", toTarball)
+
+	diff := diffBackupContents(from, to, fromManifests, toManifests)
+
+	if diff.Summary != (types.BackupDiffSummary{Added: 1, Removed: 1, Changed: 1, Unchanged: 1}) {
+		t.Fatalf("Unexpected summary: %+v", diff.Summary)
+	}
+	if diff.Added[0].Name != "new" || diff.Removed[0].Name != "old" {
+		t.Errorf("Unexpected added/removed: %+v / %+v", diff.Added, diff.Removed)
+	}
+
+	patch := diff.Changed[0].Patch
+	if diff.Changed[0].Name != "web" || len(patch) != 2 {
+		t.Fatalf("Expected 2 operations for web, got %+v", diff.Changed)
+	}
+	if patch[0].Op != "add" || patch[0].Path != "/metadata/labels" {
+		t.Errorf("Unexpected first operation: %+v", patch[0])
+	}
+	if patch[1].Op != "replace" || patch[1].Path != "/spec/replicas" || patch[1].Value != float64(3) || patch[1].OldValue != float64(2) {
+		t.Errorf("Unexpected second operation: %+v", patch[1])
+	}
+}
+
+// TestRedactSecretDiff viewer용 백업 비교 결과에서 Secret 변경 값 제거 테스트
+func TestRedactSecretDiff(t *testing.T) {
+	diff := &types.BackupDiff{
+		Changed: []types.BackupDiffItem{
+			{Resource: "secrets", Name: "token", Patch: []types.BackupDiffOperation{{Op: "replace", Path: "/data/t", Value: "bmV3", OldValue: "b2xk"}}},
+			{Resource: "configmaps", Name: "settings", Patch: []types.BackupDiffOperation{{Op: "replace", Path: "/data/a", Value: "2", OldValue: "1"}}},
+		},
+	}
+
+	redacted := redactSecretDiff(diff)
+	if op := redacted.Changed[0].Patch[0]; op.Path != "/data/t" || op.Value != nil || op.OldValue != nil {
+		t.Errorf("Expected secret values to be removed, got %+v", op)
+	}
+	if op := redacted.Changed[1].Patch[0]; op.Value != "2" || op.OldValue != "1" {
+		t.Errorf("Expected non-secret values to be kept, got %+v", op)
+	}
+	if diff.Changed[0].Patch[0].Value != "bmV3" {
+		t.Error("redactSecretDiff should not modify the cached diff")
+	}
+}
+
+// buildBackupTarball 테스트용 Velero 백업 tarball(gzip) 생성
+func buildBackupTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	tarWriter := tar.NewWriter(gzipWriter)
+	for name, content := range files {
+		if err := tarWriter.WriteHeader(&tar.Header{Name: name, Mode: 0644, Size: int64(len(content)), Typeflag: tar.TypeReg}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tarWriter.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tarWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// downloadVeleroClient : DownloadRequest의 서명된 URL을 테스트 서버로 지정하는 Velero 클라이언트
+type downloadVeleroClient struct {
+	*mocks.MockVeleroClient
+	baseURL string
+}
+
+// CreateDownloadRequest : API 서버처럼 GenerateName으로 이름을 채움 (테스트 서버 경로로 target kind 전달)
+func (c *downloadVeleroClient) CreateDownloadRequest(ctx context.Context, namespace string, request *velerov1.DownloadRequest) error {
+	request.Name = request.GenerateName + string(request.Spec.Target.Kind)
+	return nil
+}
+
+func (c *downloadVeleroClient) GetDownloadRequest(ctx context.Context, namespace, name string) (*velerov1.DownloadRequest, error) {
+	return &velerov1.DownloadRequest{
+		Status: velerov1.DownloadRequestStatus{
+			Phase:       velerov1.DownloadRequestPhaseProcessed,
+			DownloadURL: c.baseURL + "/" + name,
+		},
+	}, nil
+}
+
+// downloadClient : downloadVeleroClient를 반환하는 통합 클라이언트
+type downloadClient struct {
+	*mocks.MockClient
+	velero *downloadVeleroClient
+}
+
+func (c *downloadClient) Velero() veleroclient.Client {
+	return c.velero
+}
+
+// TestService_DownloadLogsAndResults DownloadRequest 기반 로그/복원 결과 조회 테스트
+func TestService_DownloadLogsAndResults(t *testing.T) {
+	gzipped := func(content string) []byte {
+		var buf bytes.Buffer
+		writer := gzip.NewWriter(&buf)
+		writer.Write([]byte(content))
+		writer.Close()
+		return buf.Bytes()
+	}
+	files := map[string][]byte{
+		"BackupLog": gzipped(`time="2024-01-15T00:00:00Z" level=info msg="Backup starting" backup=velero/daily
+time="2024-01-15T00:00:01Z" level=warning msg="Skipping pod volume" backup=velero/daily
+time="2024-01-15T00:00:02Z" level=error msg="Error backing up item" backup=velero/daily
+`),
+		"RestoreResults": gzipped(`{"warnings":{"cluster":["crd exists"],"namespaces":{"app":["configmap exists","service exists"]}},"errors":{"namespaces":{"db":["pvc failed"]}}}`),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		data, ok := files[r.URL.Path[strings.LastIndex(r.URL.Path, "-")+1:]]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write(data)
+	}))
+	defer server.Close()
+
+	service := NewService(handler.NewBaseHandlerWithMock(nil))
+	testClient := &downloadClient{
+		MockClient: mocks.NewMockClient(),
+		velero:     &downloadVeleroClient{MockVeleroClient: &mocks.MockVeleroClient{}, baseURL: server.URL},
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	logs, err := service.GetBackupLogsInternal(testClient, ctx, "velero", "daily", "warning", false)
+	if err != nil {
+		t.Fatalf("GetBackupLogsInternal() error = %v", err)
+	}
+	if logs.Total != 3 || logs.Warnings != 1 || logs.Errors != 1 || len(logs.Lines) != 2 {
+		t.Errorf("Unexpected logs: %+v", logs)
+	}
+	if _, err := service.GetBackupLogsInternal(testClient, ctx, "velero", "daily", "verbose", false); err == nil {
+		t.Error("Expected error for unsupported log level")
+	}
+
+	restoreResults, err := service.GetRestoreResultsInternal(testClient, ctx, "velero", "daily-restore", false)
+	if err != nil {
+		t.Fatalf("GetRestoreResultsInternal() error = %v", err)
+	}
+	if restoreResults.WarningCount != 3 || restoreResults.ErrorCount != 1 {
+		t.Errorf("Unexpected counts: warnings=%d errors=%d", restoreResults.WarningCount, restoreResults.ErrorCount)
+	}
+	if len(restoreResults.Warnings.Namespaces["app"]) != 2 || restoreResults.Errors.Namespaces["db"][0] != "pvc failed" {
+		t.Errorf("Unexpected results: %+v", restoreResults)
+	}
+
+	if _, err := service.GetRestoreLogsInternal(testClient, ctx, "velero", "daily-restore", "", false); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected not found error, got %v", err)
+	}
+}
+
+// TestPreviewRestorePlan 복원 dry-run 필터/매핑/충돌 계산 테스트
+func TestPreviewRestorePlan(t *testing.T) {
+	req := types.RestoreRequest{
+		Name:                 "preview",
+		BackupName:           "nightly",
+		IncludeNamespaces:    []string{"app"},
+		ExcludeResources:     []string{"pods"},
+		LabelSelector:        map[string]string{"app": "web"},
+		NamespaceMappings:    map[string]string{"app": "app-restored"},
+		StorageClassMappings: map[string]string{"standard": "fast-ssd"},
+	}
+	restore, err := BuildRestore(req)
+	if err != nil {
+		t.Fatalf("BuildRestore() error = %v", err)
+	}
+
+	backupItems := []types.BackupContentItem{
+		{Resource: "namespaces", Name: "app", Path: "ns-app"},
+		{Resource: "namespaces", Name: "other", Path: "ns-other"},
+		{Resource: "clusterroles.rbac.authorization.k8s.io", Name: "admin", Path: "cr-admin"},
+		{Resource: "configmaps", Namespace: "app", Name: "settings", Path: "cm-settings"},
+		{Resource: "configmaps", Namespace: "app", Name: "test-pod", Path: "cm-test-pod"},
+		{Resource: "configmaps", Namespace: "app", Name: "unlabeled", Path: "cm-unlabeled"},
+		{Resource: "configmaps", Namespace: "other", Name: "x", Path: "cm-x"},
+		{Resource: "events", Namespace: "app", Name: "e1", Path: "ev-e1"},
+		{Resource: "pods", Namespace: "app", Name: "web-1", Path: "pod-web-1"},
+		{Resource: "persistentvolumeclaims", Namespace: "app", Name: "data", Path: "pvc-data"},
+	}
+	webLabels := map[string]interface{}{"labels": map[string]interface{}{"app": "web"}}
+	manifests := map[string]map[string]interface{}{
+		"ns-app":       {"metadata": map[string]interface{}{"name": "app"}},
+		"cm-settings":  {"metadata": webLabels},
+		"cm-test-pod":  {"metadata": webLabels},
+		"cm-unlabeled": {"metadata": map[string]interface{}{}},
+		"pvc-data":     {"metadata": webLabels, "spec": map[string]interface{}{"storageClassName": "standard"}},
+	}
+
+	candidates := restoreCandidates(req, backupItems)
+	if len(candidates) != 5 {
+		t.Fatalf("Expected 5 candidates, got %+v", candidates)
+	}
+
+	items, err := planRestoreItems(restore, req.StorageClassMappings, candidates, manifests)
+	if err != nil {
+		t.Fatalf("planRestoreItems() error = %v", err)
+	}
+	if len(items) != 4 {
+		t.Fatalf("Expected 4 items after label selector, got %+v", items)
+	}
+
+	warnings := checkRestoreTargets(mocks.NewMockClient(), context.Background(), items, restore.Spec.ExistingResourcePolicy)
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "fast-ssd") {
+		t.Errorf("Expected missing storage class warning, got %v", warnings)
+	}
+
+	byName := map[string]types.RestorePreviewItem{}
+	for _, item := range items {
+		byName[item.Name] = item
+	}
+	if ns := byName["app"]; ns.TargetName != "app-restored" || ns.Action != "create" {
+		t.Errorf("Unexpected namespace item: %+v", ns)
+	}
+	if cm := byName["settings"]; cm.TargetNamespace != "app-restored" || cm.Action != "create" || cm.Exists {
+		t.Errorf("Unexpected configmap item: %+v", cm)
+	}
+	// Mock 클라이언트의 기존 객체(test-pod)와 이름이 같으면 충돌
+	if conflict := byName["test-pod"]; !conflict.Exists || conflict.Action != "skip" {
+		t.Errorf("Expected conflict to be skipped, got %+v", conflict)
+	}
+	if pvc := byName["data"]; pvc.StorageClass != "standard" || pvc.TargetStorageClass != "fast-ssd" || !strings.Contains(pvc.Message, "does not exist") {
+		t.Errorf("Unexpected pvc item: %+v", pvc)
+	}
+}
+
+// TestPhaseTracker Backup/Restore 단계 메트릭 집계 테스트
+func TestPhaseTracker(t *testing.T) {
+	tracker := newPhaseTracker()
+
+	backups := []velerov1.Backup{
+		{ObjectMeta: metav1.ObjectMeta{Name: "daily-1"}, Status: velerov1.BackupStatus{Phase: velerov1.BackupPhaseCompleted}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "daily-2"}, Status: velerov1.BackupStatus{Phase: velerov1.BackupPhaseCompleted}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "daily-3"}},
+	}
+	tracker.replace("prod-a", "velero", backupPhases(backups))
+	tracker.set("prod-a", "velero", "daily-3", string(velerov1.BackupPhaseFailed))
+	tracker.set("dr", "velero", "weekly-1", string(velerov1.BackupPhaseInProgress))
+	tracker.set("", "velero", "ignored", string(velerov1.BackupPhaseCompleted))
+
+	counts := func() map[string]float64 {
+		result := make(map[string]float64)
+		for _, sample := range tracker.samples() {
+			result[strings.Join(sample.LabelValues, "/")] = sample.Value
+		}
+		return result
+	}
+
+	got := counts()
+	expected := map[string]float64{
+		"prod-a/velero/Completed": 2,
+		"prod-a/velero/Failed":    1,
+		"dr/velero/InProgress":    1,
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, got)
+	}
+	for key, value := range expected {
+		if got[key] != value {
+			t.Errorf("Expected %s = %v, got %v", key, value, got[key])
+		}
+	}
+
+	// 목록 조회 결과가 비어 있으면 해당 범위 제거
+	tracker.replace("dr", "velero", backupPhases(nil))
+	if _, exists := counts()["dr/velero/InProgress"]; exists {
+		t.Error("Expected deleted backups to be removed from the gauge")
+	}
+
+	restores := restorePhases([]velerov1.Restore{{ObjectMeta: metav1.ObjectMeta{Name: "r1"}}})
+	if restores["r1"] != phaseNew {
+		t.Errorf("Expected empty restore phase to be reported as %s, got %s", phaseNew, restores["r1"])
+	}
+}
+
+// TestInstallFootprintRollbackPlan 설치 취소 시 이 작업이 만든 리소스만 되돌리는지 테스트
+func TestInstallFootprintRollbackPlan(t *testing.T) {
+	tests := []struct {
+		name          string
+		footprint     installFootprint
+		wantUninstall bool
+		wantDeleteNS  bool
+	}{
+		{"fresh cluster", installFootprint{}, true, true},
+		{"existing namespace", installFootprint{NamespaceExisted: true}, true, false},
+		{"existing velero", installFootprint{NamespaceExisted: true, ReleaseExisted: true}, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uninstall, deleteNS := tt.footprint.rollbackPlan()
+			if uninstall != tt.wantUninstall || deleteNS != tt.wantDeleteNS {
+				t.Errorf("rollbackPlan() = (%t, %t), want (%t, %t)", uninstall, deleteNS, tt.wantUninstall, tt.wantDeleteNS)
+			}
+		})
+	}
+}
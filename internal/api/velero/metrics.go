@@ -0,0 +1,109 @@
+package velero
+
+import (
+	"sync"
+
+	"github.com/taking/kubemigrate/internal/metrics"
+	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// phaseNew : 아직 단계가 기록되지 않은 리소스의 단계 레이블
+const phaseNew = "New"
+
+// phaseScope : 단계 집계 범위
+type phaseScope struct {
+	cluster   string
+	namespace string
+}
+
+// phaseTracker : 클러스터/네임스페이스별로 마지막으로 관찰한 Backup/Restore 단계 (메트릭용)
+// 서버는 클러스터를 감시하지 않으므로 목록/상세 조회 결과로 갱신
+type phaseTracker struct {
+	mu     sync.Mutex
+	phases map[phaseScope]map[string]string // 리소스 이름 → 단계
+}
+
+// newPhaseTracker : 단계 집계기 생성
+func newPhaseTracker() *phaseTracker {
+	return &phaseTracker{phases: make(map[phaseScope]map[string]string)}
+}
+
+// replace : 목록 조회 결과로 범위 전체 교체 (삭제된 리소스 반영)
+func (t *phaseTracker) replace(cluster, namespace string, phases map[string]string) {
+	if cluster == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	scope := phaseScope{cluster, namespace}
+	if len(phases) == 0 {
+		delete(t.phases, scope)
+		return
+	}
+	t.phases[scope] = phases
+}
+
+// set : 상세 조회 결과로 단일 리소스 단계 갱신
+func (t *phaseTracker) set(cluster, namespace, name, phase string) {
+	if cluster == "" {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	scope := phaseScope{cluster, namespace}
+	if t.phases[scope] == nil {
+		t.phases[scope] = make(map[string]string)
+	}
+	t.phases[scope][name] = phaseLabel(phase)
+}
+
+// samples : 클러스터/네임스페이스/단계별 리소스 수
+func (t *phaseTracker) samples() []metrics.Sample {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	var samples []metrics.Sample
+	for scope, phases := range t.phases {
+		counts := make(map[string]int)
+		for _, phase := range phases {
+			counts[phase]++
+		}
+		for phase, count := range counts {
+			samples = append(samples, metrics.Sample{
+				LabelValues: []string{scope.cluster, scope.namespace, phase},
+				Value:       float64(count),
+			})
+		}
+	}
+	return samples
+}
+
+// phaseLabel : 빈 단계는 New로 표시
+func phaseLabel(phase string) string {
+	if phase == "" {
+		return phaseNew
+	}
+	return phase
+}
+
+// backupPhases : Backup 목록의 이름별 단계
+func backupPhases(backups []velerov1.Backup) map[string]string {
+	phases := make(map[string]string, len(backups))
+	for _, backup := range backups {
+		phases[backup.Name] = phaseLabel(string(backup.Status.Phase))
+	}
+	return phases
+}
+
+// restorePhases : Restore 목록의 이름별 단계
+func restorePhases(restores []velerov1.Restore) map[string]string {
+	phases := make(map[string]string, len(restores))
+	for _, restore := range restores {
+		phases[restore.Name] = phaseLabel(string(restore.Status.Phase))
+	}
+	return phases
+}
@@ -1,28 +1,54 @@
 package velero
 
 import (
+	"archive/tar"
+	"compress/gzip"
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/taking/kubemigrate/internal/api/minio"
+	"github.com/taking/kubemigrate/internal/cache"
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/installer"
 	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/metrics"
 	"github.com/taking/kubemigrate/pkg/client"
+	"github.com/taking/kubemigrate/pkg/client/kubernetes"
+	minioclient "github.com/taking/kubemigrate/pkg/client/minio"
 	"github.com/taking/kubemigrate/pkg/config"
 	"github.com/taking/kubemigrate/pkg/types"
+	"github.com/taking/kubemigrate/pkg/utils"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
 	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 // Service : Velero 관련 비즈니스 로직
 type Service struct {
 	*handler.BaseHandler
-	jobManager   job.JobManager
-	installer    installer.InstallerService
-	minioService *minio.Service
+	jobManager    job.JobManager
+	installer     installer.InstallerService
+	minioService  *minio.Service
+	contentCache  *cache.LRUCache // 백업 tarball 내용 색인/매니페스트 캐시
+	backupPhases  *phaseTracker   // 조회한 Backup 단계 (메트릭용)
+	restorePhases *phaseTracker   // 조회한 Restore 단계 (메트릭용)
 }
 
 // NewService : 새로운 Velero 서비스 생성
@@ -30,12 +56,117 @@ func NewService(base *handler.BaseHandler) *Service {
 	// ConfigManager를 활용하여 워커 수 설정
 	workerCount := base.GetConfigInt("VELERO_WORKER_COUNT", 3)
 
-	return &Service{
-		BaseHandler:  base,
-		jobManager:   job.NewMemoryJobManagerWithWorkers(workerCount),
-		installer:    installer.NewService(),
-		minioService: minio.NewService(base),
+	s := &Service{
+		BaseHandler:   base,
+		jobManager:    base.NewJobManager("velero", workerCount),
+		installer:     installer.NewService(),
+		minioService:  minio.NewService(base),
+		contentCache:  cache.NewLRUCache(base.GetConfigInt("VELERO_CONTENT_CACHE_SIZE", 50)),
+		backupPhases:  newPhaseTracker(),
+		restorePhases: newPhaseTracker(),
 	}
+
+	// 백업 내용 캐시와 Backup/Restore 단계 메트릭 노출
+	s.contentCache.RegisterMetrics("velero-content")
+	metrics.VeleroBackups.Register("velero", s.backupPhases.samples)
+	metrics.VeleroRestores.Register("velero", s.restorePhases.samples)
+
+	// 재시작으로 중단된 백업/복원 작업 재연결
+	go s.recoverInterruptedJobs()
+
+	return s
+}
+
+// 재연결 가능한 작업 종류
+const (
+	recoveryKindBackup  = "velero-backup"
+	recoveryKindRestore = "velero-restore"
+)
+
+// recoverInterruptedJobs : 서버 재시작 전 진행 중이던 작업을 Velero 리소스에 다시 연결
+func (s *Service) recoverInterruptedJobs() {
+	for _, interrupted := range s.jobManager.InterruptedJobs() {
+		jobID := interrupted.JobID
+		recovery := interrupted.Recovery
+
+		if recovery == nil || recovery.KubeConfig == "" {
+			s.jobManager.FailJob(jobID, fmt.Errorf("job interrupted by server restart"))
+			continue
+		}
+
+		kubeConfig := config.KubeConfig{KubeConfig: recovery.KubeConfig}
+		veleroConfig := config.VeleroConfig{KubeConfig: kubeConfig}
+		unifiedClient, err := client.NewClientWithConfig(&kubeConfig, &kubeConfig, &veleroConfig, nil)
+		if err != nil {
+			s.jobManager.FailJob(jobID, fmt.Errorf("job interrupted by server restart, failed to reconnect: %w", err))
+			continue
+		}
+
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Server restarted, re-attaching to %s %s/%s", recovery.Kind, recovery.Namespace, recovery.Name))
+
+		switch recovery.Kind {
+		case recoveryKindRestore:
+			go s.resumeRestoreJob(unifiedClient, jobID, *recovery)
+		case recoveryKindBackup:
+			go s.resumeBackupJob(unifiedClient, jobID, *recovery)
+		default:
+			s.jobManager.FailJob(jobID, fmt.Errorf("job interrupted by server restart"))
+		}
+	}
+}
+
+// resumeRestoreJob : 재시작 후 진행 중이던 Restore 완료 대기
+func (s *Service) resumeRestoreJob(client client.Client, jobID string, recovery job.RecoveryInfo) {
+	timeout := s.GetConfigDuration("VELERO_RESTORE_TIMEOUT", 30*time.Minute)
+	ctx, cancel := s.jobManager.JobContext(jobID, timeout)
+	defer cancel()
+
+	restore, err := client.Velero().GetRestore(ctx, recovery.Namespace, recovery.Name)
+	if err != nil {
+		s.jobManager.FailJob(jobID, fmt.Errorf("job interrupted by server restart before restore was created: %w", err))
+		return
+	}
+
+	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 50, "Waiting for restore to complete...")
+	s.waitForRestoreCompletion(ctx, client, jobID, recovery.Name)
+
+	if job.IsCancelled(ctx) {
+		s.compensate(jobID, fmt.Sprintf("Deleting restore %s after cancellation", recovery.Name), func(ctx context.Context) error {
+			return client.Velero().DeleteRestore(ctx, recovery.Namespace, recovery.Name)
+		})
+		return
+	}
+
+	s.jobManager.CompleteJob(jobID, map[string]interface{}{
+		"restoreName": recovery.Name,
+		"backupName":  restore.Spec.BackupName,
+		"namespace":   recovery.Namespace,
+		"status":      "created",
+		"message":     "Restore re-attached after server restart",
+		"createdAt":   restore.CreationTimestamp.Time,
+	})
+}
+
+// resumeBackupJob : 재시작 후 Backup 리소스 생성 여부 확인
+func (s *Service) resumeBackupJob(client client.Client, jobID string, recovery job.RecoveryInfo) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	backup, err := client.Velero().GetBackup(ctx, recovery.Namespace, recovery.Name)
+	if err != nil {
+		s.jobManager.FailJob(jobID, fmt.Errorf("job interrupted by server restart before backup was created: %w", err))
+		return
+	}
+
+	s.jobManager.CompleteJob(jobID, map[string]interface{}{
+		"backupName":      recovery.Name,
+		"namespace":       recovery.Namespace,
+		"status":          "created",
+		"phase":           string(backup.Status.Phase),
+		"message":         "Backup re-attached after server restart",
+		"createdAt":       backup.CreationTimestamp.Time,
+		"storageLocation": backup.Spec.StorageLocation,
+	})
 }
 
 // InstallVeleroWithMinIOInternal : Velero 설치 및 MinIO 연동 설정 (비동기)
@@ -85,7 +216,7 @@ func (s *Service) installVeleroWithMinIOInternal(
 	// 백그라운드 작업을 위한 새로운 context 생성 (30분 timeout)
 	// ConfigManager를 활용하여 타임아웃 설정
 	timeout := s.GetConfigDuration("VELERO_INSTALL_TIMEOUT", 30*time.Minute)
-	bgCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	bgCtx, cancel := s.jobManager.JobContext(jobID, timeout)
 	defer cancel()
 
 	// Installer 설정
@@ -94,12 +225,22 @@ func (s *Service) installVeleroWithMinIOInternal(
 		Namespace:   namespace,
 		Force:       force,
 	}
+	if cfg.Install != nil {
+		installConfig.Options = *cfg.Install
+	}
+
+	// 취소 시 이 작업이 만든 리소스만 되돌리도록 설치 전 상태 기록
+	footprint := s.recordInstallFootprint(bgCtx, client, namespace)
 
 	// Installer를 통한 설치 실행
 	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, "Starting Velero installation...")
 	s.jobManager.AddJobLog(jobID, "Starting Velero installation process")
 
 	result, err := s.installer.InstallVelero(bgCtx, client, installConfig)
+	if job.IsCancelled(bgCtx) {
+		s.rollbackInstall(jobID, client, namespace, footprint)
+		return
+	}
 	if err != nil {
 		s.jobManager.FailJob(jobID, err)
 		return
@@ -110,6 +251,226 @@ func (s *Service) installVeleroWithMinIOInternal(
 	s.jobManager.AddJobLog(jobID, "Velero installation completed successfully")
 }
 
+// installFootprint : 설치 작업 시작 전 Velero 상태
+type installFootprint struct {
+	NamespaceExisted bool // 네임스페이스가 이미 존재했는지 여부
+	ReleaseExisted   bool // Velero 릴리스 또는 파드가 이미 존재했는지 여부
+}
+
+// rollbackPlan : 취소 시 되돌릴 리소스 (이 작업이 생성한 것만 제거)
+func (f installFootprint) rollbackPlan() (uninstallRelease, deleteNamespace bool) {
+	if f.ReleaseExisted {
+		return false, false
+	}
+	return true, !f.NamespaceExisted
+}
+
+// recordInstallFootprint : 설치 전 네임스페이스/릴리스 존재 여부 확인
+// 확인할 수 없는 경우 기존 설치를 지우지 않도록 이미 존재했던 것으로 간주
+func (s *Service) recordInstallFootprint(ctx context.Context, client client.Client, namespace string) installFootprint {
+	footprint := installFootprint{NamespaceExisted: true, ReleaseExisted: true}
+
+	if _, err := client.Kubernetes().GetNamespaces(ctx, namespace); apierrors.IsNotFound(err) {
+		footprint.NamespaceExisted = false
+	}
+
+	if status, err := s.installer.GetVeleroStatus(ctx, client, namespace); err == nil {
+		footprint.ReleaseExisted = status.HelmRelease || status.PodsInstalled
+	}
+
+	return footprint
+}
+
+// rollbackInstall : 취소된 설치 작업이 생성한 Velero 릴리스와 네임스페이스 제거
+func (s *Service) rollbackInstall(jobID string, client client.Client, namespace string, footprint installFootprint) {
+	uninstallRelease, deleteNamespace := footprint.rollbackPlan()
+	if !uninstallRelease {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Velero was already installed in namespace %s before this job, leaving it in place", namespace))
+		return
+	}
+
+	s.compensate(jobID, "Removing partially installed Velero release", func(ctx context.Context) error {
+		err := s.installer.UninstallVelero(ctx, client, installer.VeleroUninstallConfig{Namespace: namespace})
+		if !deleteNamespace {
+			return err
+		}
+
+		// 네임스페이스를 삭제하면 남은 리소스도 함께 제거되므로 릴리스 제거 실패(설치 전 취소 등)는 기록만 함
+		if err != nil {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Velero release was not removed: %v", err))
+		}
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Deleting namespace %s created by this job", namespace))
+		if err := client.Kubernetes().DeleteNamespace(ctx, namespace); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete namespace %s: %w", namespace, err)
+		}
+		return nil
+	})
+}
+
+// UninstallVeleroInternal : Velero Helm 릴리스 제거 (비동기)
+// force가 true이면 네임스페이스, Helm Release Secret, Velero CRD까지 함께 제거합니다
+func (s *Service) UninstallVeleroInternal(client client.Client, ctx context.Context, namespace string, force bool) (interface{}, error) {
+	jobID := fmt.Sprintf("velero-uninstall-%d", time.Now().UnixNano())
+
+	jobInfo := s.jobManager.CreateJob(jobID, map[string]interface{}{
+		"namespace": namespace,
+		"force":     force,
+		"operation": "uninstall",
+	})
+
+	go s.removeVeleroInternal(client, jobID, namespace, force, "uninstall", func(ctx context.Context) error {
+		return s.installer.UninstallVelero(ctx, client, installer.VeleroUninstallConfig{Namespace: namespace, Force: force})
+	})
+
+	return map[string]interface{}{
+		"status":    "processing",
+		"jobId":     jobID,
+		"message":   "Velero uninstallation started",
+		"statusUrl": fmt.Sprintf("/api/v1/velero/status/%s", jobID),
+		"logsUrl":   fmt.Sprintf("/api/v1/velero/logs/%s", jobID),
+		"job":       jobInfo,
+	}, nil
+}
+
+// CleanupVeleroInternal : Velero 잔여 리소스 정리 (비동기)
+// force가 false이면 Helm 릴리스만, true이면 네임스페이스와 CRD를 포함한 모든 잔여 리소스를 제거합니다
+func (s *Service) CleanupVeleroInternal(client client.Client, ctx context.Context, namespace string, force bool) (interface{}, error) {
+	jobID := fmt.Sprintf("velero-cleanup-%d", time.Now().UnixNano())
+
+	jobInfo := s.jobManager.CreateJob(jobID, map[string]interface{}{
+		"namespace": namespace,
+		"force":     force,
+		"operation": "cleanup",
+	})
+
+	go s.removeVeleroInternal(client, jobID, namespace, force, "cleanup", func(ctx context.Context) error {
+		return s.installer.CleanupVelero(ctx, client, namespace, force)
+	})
+
+	return map[string]interface{}{
+		"status":    "processing",
+		"jobId":     jobID,
+		"message":   "Velero cleanup started",
+		"statusUrl": fmt.Sprintf("/api/v1/velero/status/%s", jobID),
+		"logsUrl":   fmt.Sprintf("/api/v1/velero/logs/%s", jobID),
+		"job":       jobInfo,
+	}, nil
+}
+
+// removeVeleroInternal : 백그라운드에서 Velero 제거 후 남은 리소스 확인
+func (s *Service) removeVeleroInternal(
+	client client.Client,
+	jobID string,
+	namespace string,
+	force bool,
+	operation string,
+	remove func(ctx context.Context) error,
+) {
+	timeout := s.GetConfigDuration("VELERO_UNINSTALL_TIMEOUT", 10*time.Minute)
+	ctx, cancel := s.jobManager.JobContext(jobID, timeout)
+	defer cancel()
+
+	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, fmt.Sprintf("Starting Velero %s...", operation))
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Removing Velero from namespace %s (force: %t)", namespace, force))
+
+	if err := remove(ctx); err != nil {
+		s.jobManager.FailJob(jobID, fmt.Errorf("velero %s failed: %w", operation, err))
+		return
+	}
+
+	// 네임스페이스 삭제는 비동기로 진행되므로 완료될 때까지 대기
+	if force {
+		s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 60, "Waiting for namespace deletion...")
+		if err := s.waitForNamespaceDeletion(ctx, client, namespace); err != nil {
+			s.jobManager.FailJob(jobID, err)
+			return
+		}
+	}
+
+	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 90, "Collecting remaining Velero resources...")
+	result, err := s.collectVeleroInventory(ctx, client, namespace)
+	if err != nil {
+		s.jobManager.FailJob(jobID, err)
+		return
+	}
+	result["operation"] = operation
+	result["force"] = force
+
+	s.jobManager.CompleteJob(jobID, result)
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Velero %s completed successfully", operation))
+}
+
+// waitForNamespaceDeletion : 네임스페이스가 완전히 삭제될 때까지 대기
+func (s *Service) waitForNamespaceDeletion(ctx context.Context, client client.Client, namespace string) error {
+	ticker := time.NewTicker(5 * time.Second)
+	defer ticker.Stop()
+
+	for {
+		if _, err := client.Kubernetes().GetNamespaces(ctx, namespace); err != nil {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("timeout waiting for namespace '%s' deletion: %w", namespace, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+// GetInstallStatusInternal : Velero 설치 상태 및 리소스 인벤토리 조회 (비동기)
+func (s *Service) GetInstallStatusInternal(client client.Client, ctx context.Context, namespace string) (interface{}, error) {
+	jobID := fmt.Sprintf("velero-status-%d", time.Now().UnixNano())
+
+	jobInfo := s.jobManager.CreateJob(jobID, map[string]interface{}{
+		"namespace": namespace,
+		"operation": "status",
+	})
+
+	go func() {
+		timeout := s.GetConfigDuration("VELERO_STATUS_TIMEOUT", 2*time.Minute)
+		ctx, cancel := s.jobManager.JobContext(jobID, timeout)
+		defer cancel()
+
+		s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, "Collecting Velero installation status...")
+		result, err := s.collectVeleroInventory(ctx, client, namespace)
+		if err != nil {
+			s.jobManager.FailJob(jobID, err)
+			return
+		}
+
+		s.jobManager.CompleteJob(jobID, result)
+	}()
+
+	return map[string]interface{}{
+		"status":    "processing",
+		"jobId":     jobID,
+		"message":   "Velero status check started",
+		"statusUrl": fmt.Sprintf("/api/v1/velero/status/%s", jobID),
+		"logsUrl":   fmt.Sprintf("/api/v1/velero/logs/%s", jobID),
+		"job":       jobInfo,
+	}, nil
+}
+
+// collectVeleroInventory : Velero 상태와 리소스 인벤토리 수집
+func (s *Service) collectVeleroInventory(ctx context.Context, client client.Client, namespace string) (map[string]interface{}, error) {
+	status, err := s.installer.GetVeleroStatus(ctx, client, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check velero status: %w", err)
+	}
+
+	resources, err := s.installer.ListVeleroResources(ctx, client, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list velero resources: %w", err)
+	}
+
+	return map[string]interface{}{
+		"namespace": namespace,
+		"status":    status,
+		"resources": resources,
+	}, nil
+}
+
 // GetJobStatusInternal : 작업 상태 조회 (내부 로직)
 func (s *Service) GetJobStatusInternal(jobID string) (interface{}, error) {
 	job, exists := s.jobManager.GetJob(jobID)
@@ -135,6 +496,29 @@ func (s *Service) GetJobLogsInternal(jobID string) (interface{}, error) {
 	}, nil
 }
 
+// CancelJobInternal : 진행 중인 작업 취소 (내부 로직)
+func (s *Service) CancelJobInternal(jobID string) (interface{}, error) {
+	if err := s.jobManager.CancelJob(jobID); err != nil {
+		return nil, err
+	}
+
+	job, _ := s.jobManager.GetJob(jobID)
+	return job, nil
+}
+
+// compensate : 취소된 작업의 보상 정리 실행 (작업 컨텍스트와 별도의 컨텍스트 사용)
+func (s *Service) compensate(jobID, description string, cleanup func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Minute)
+	defer cancel()
+
+	s.jobManager.AddJobLog(jobID, description)
+	if err := cleanup(ctx); err != nil {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Warning: cleanup after cancellation failed: %v", err))
+		return
+	}
+	s.jobManager.AddJobLog(jobID, "Cleanup after cancellation completed")
+}
+
 // GetAllJobsInternal : 모든 작업 조회 (내부 로직)
 func (s *Service) GetAllJobsInternal() (interface{}, error) {
 	jobs := s.jobManager.GetAllJobs()
@@ -212,12 +596,26 @@ func (s *Service) CreateRestoreInternal(client client.Client, ctx context.Contex
 		"includeClusterResources": req.Restore.IncludeClusterResources,
 		"restorePVs":              req.Restore.RestorePVs,
 		"storageClassMappings":    req.Restore.StorageClassMappings,
+		"namespaceMappings":       req.Restore.NamespaceMappings,
+		"existingResourcePolicy":  req.Restore.ExistingResourcePolicy,
+	}
+
+	// Restore 리소스 변환 및 검증 (잘못된 요청은 Job 생성 전에 반환)
+	restore, err := BuildRestore(req.Restore)
+	if err != nil {
+		return nil, err
 	}
 
 	_ = s.jobManager.CreateJob(jobID, metadata)
+	s.jobManager.SetJobRecovery(jobID, job.RecoveryInfo{
+		Kind:       recoveryKindRestore,
+		Namespace:  "velero",
+		Name:       req.Restore.Name,
+		KubeConfig: req.KubeConfig.KubeConfig,
+	})
 
 	// 백그라운드에서 복구 생성 시작
-	go s.createRestoreInternal(client, ctx, jobID, req)
+	go s.createRestoreInternal(client, ctx, jobID, req, restore)
 
 	// 즉시 응답 반환
 	return types.RestoreResult{
@@ -239,10 +637,11 @@ func (s *Service) createRestoreInternal(
 	ctx context.Context,
 	jobID string,
 	req types.VeleroRestoreRequest,
+	restore *velerov1.Restore,
 ) {
 	// 백그라운드 작업을 위한 새로운 context 생성 (30분 timeout)
 	timeout := s.GetConfigDuration("VELERO_RESTORE_TIMEOUT", 30*time.Minute)
-	bgCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	bgCtx, cancel := s.jobManager.JobContext(jobID, timeout)
 	defer cancel()
 
 	// StorageClass 매핑이 있는 경우 ConfigMap 생성
@@ -253,36 +652,19 @@ func (s *Service) createRestoreInternal(
 			s.jobManager.FailJob(jobID, fmt.Errorf("failed to create StorageClass ConfigMap: %w", err))
 			return
 		}
-		// 복구 완료 후 ConfigMap 정리를 위한 defer 추가
+		// 복구 완료 후 ConfigMap 정리를 위한 defer 추가 (취소된 경우에도 정리되도록 별도 컨텍스트 사용)
 		defer func() {
-			if cleanupErr := s.deleteStorageClassConfigMap(client, bgCtx, jobID); cleanupErr != nil {
+			cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 2*time.Minute)
+			defer cleanupCancel()
+			if cleanupErr := s.deleteStorageClassConfigMap(client, cleanupCtx, jobID); cleanupErr != nil {
 				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Warning: Failed to cleanup StorageClass ConfigMap: %v", cleanupErr))
 			}
 		}()
 	}
 
-	// RestoreRequest를 velerov1.Restore로 변환
-	restore := &velerov1.Restore{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      req.Restore.Name,
-			Namespace: "velero",
-		},
-		Spec: velerov1.RestoreSpec{
-			BackupName:              req.Restore.BackupName,
-			IncludedNamespaces:      req.Restore.IncludeNamespaces,
-			ExcludedNamespaces:      req.Restore.ExcludeNamespaces,
-			IncludedResources:       req.Restore.IncludeResources,
-			ExcludedResources:       req.Restore.ExcludeResources,
-			IncludeClusterResources: &req.Restore.IncludeClusterResources,
-			RestorePVs:              &req.Restore.RestorePVs,
-		},
-	}
-
-	// LabelSelector 변환
-	if req.Restore.LabelSelector != nil && len(req.Restore.LabelSelector) > 0 {
-		restore.Spec.LabelSelector = &metav1.LabelSelector{
-			MatchLabels: req.Restore.LabelSelector,
-		}
+	// 네임스페이스 매핑 로그
+	for source, target := range req.Restore.NamespaceMappings {
+		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Namespace mapping: %s -> %s", source, target))
 	}
 
 	// StorageClass 매핑 처리 - Velero Restore Spec에 포함
@@ -307,6 +689,14 @@ func (s *Service) createRestoreInternal(
 	s.jobManager.AddJobLog(jobID, "Waiting for restore to complete...")
 	s.waitForRestoreCompletion(bgCtx, client, jobID, req.Restore.Name)
 
+	// 취소 시 생성된 Restore 리소스 삭제 (이미 복원된 리소스는 되돌리지 않음)
+	if job.IsCancelled(bgCtx) {
+		s.compensate(jobID, fmt.Sprintf("Deleting restore %s after cancellation", req.Restore.Name), func(ctx context.Context) error {
+			return client.Velero().DeleteRestore(ctx, "velero", req.Restore.Name)
+		})
+		return
+	}
+
 	// 복구 생성 완료
 	result := map[string]interface{}{
 		"restoreName":       req.Restore.Name,
@@ -322,73 +712,318 @@ func (s *Service) createRestoreInternal(
 	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore %s created successfully", req.Restore.Name))
 }
 
-// applyStorageClassMappings : StorageClass 매핑을 적용합니다
-func (s *Service) applyStorageClassMappings(
-	ctx context.Context,
-	client client.Client,
-	jobID string,
-	storageClassMappings map[string]string,
-	includeNamespaces []string,
-) {
-	for originalSC, newSC := range storageClassMappings {
-		for _, namespace := range includeNamespaces {
-			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Processing namespace %s: %s -> %s", namespace, originalSC, newSC))
-
-			// 해당 네임스페이스의 PVC 목록 조회
-			pvcList, err := client.Kubernetes().GetPVCs(ctx, namespace, "")
-			if err != nil {
-				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to get PVCs in namespace %s: %v", namespace, err))
-				continue
-			}
+// BuildRestore : RestoreRequest를 검증하고 velerov1.Restore로 변환
+func BuildRestore(req types.RestoreRequest) (*velerov1.Restore, error) {
+	if err := validateNamespaceMappings(req.NamespaceMappings, req.IncludeNamespaces); err != nil {
+		return nil, err
+	}
 
-			// PVC 목록을 타입 캐스팅
-			pvcs, ok := pvcList.(*v1.PersistentVolumeClaimList)
-			if !ok {
-				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to cast PVC list in namespace %s", namespace))
-				continue
-			}
+	if err := validateRestoreMetadata(req.Metadata, req.Annotations); err != nil {
+		return nil, err
+	}
 
-			// 해당 StorageClass를 사용하는 PVC 찾기
-			var targetPVCs []string
-			for _, pvc := range pvcs.Items {
-				if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == originalSC {
-					targetPVCs = append(targetPVCs, pvc.Name)
-				}
-			}
+	policy, err := parseExistingResourcePolicy(req.ExistingResourcePolicy)
+	if err != nil {
+		return nil, err
+	}
 
-			if len(targetPVCs) == 0 {
-				s.jobManager.AddJobLog(jobID, fmt.Sprintf("No PVCs found with StorageClass %s in namespace %s", originalSC, namespace))
-				continue
-			}
+	hooks, err := convertRestoreHooks(req.Hooks)
+	if err != nil {
+		return nil, err
+	}
 
-			// 각 PVC의 StorageClass 변경 (삭제 후 재생성 방식)
-			for _, pvcName := range targetPVCs {
-				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Recreating PVC %s/%s: %s -> %s", namespace, pvcName, originalSC, newSC))
+	includeClusterResources := req.IncludeClusterResources
+	restorePVs := req.RestorePVs
 
-				// PVC 삭제 후 재생성
-				err := s.recreatePVCWithNewStorageClass(ctx, client, jobID, namespace, pvcName, newSC)
-				if err != nil {
-					s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to recreate PVC %s/%s: %v", namespace, pvcName, err))
-					continue
-				}
+	restore := &velerov1.Restore{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        req.Name,
+			Namespace:   "velero",
+			Labels:      req.Metadata,
+			Annotations: req.Annotations,
+		},
+		Spec: velerov1.RestoreSpec{
+			BackupName:              req.BackupName,
+			IncludedNamespaces:      req.IncludeNamespaces,
+			ExcludedNamespaces:      req.ExcludeNamespaces,
+			IncludedResources:       req.IncludeResources,
+			ExcludedResources:       req.ExcludeResources,
+			NamespaceMapping:        req.NamespaceMappings,
+			IncludeClusterResources: &includeClusterResources,
+			RestorePVs:              &restorePVs,
+			Hooks:                   hooks,
+			ExistingResourcePolicy:  policy,
+		},
+	}
 
-				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Successfully recreated PVC %s/%s", namespace, pvcName))
-			}
+	// LabelSelector 변환
+	if len(req.LabelSelector) > 0 {
+		restore.Spec.LabelSelector = &metav1.LabelSelector{
+			MatchLabels: req.LabelSelector,
 		}
 	}
+
+	return restore, nil
 }
 
-// waitForPVCBinding : PVC가 새로운 PV와 바인딩될 때까지 대기
-func (s *Service) waitForPVCBinding(
-	ctx context.Context,
-	client client.Client,
-	jobID string,
-	namespace, pvcName string,
-	originalPVC *v1.PersistentVolumeClaim,
-) {
-	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Waiting for PVC %s/%s to bind to new PV...", namespace, pvcName))
+// validateNamespaceMappings : 네임스페이스 매핑 검증
+func validateNamespaceMappings(mappings map[string]string, includeNamespaces []string) error {
+	if len(mappings) == 0 {
+		return nil
+	}
 
-	// 최대 3분 대기 (단축)
+	included := make(map[string]bool, len(includeNamespaces))
+	for _, ns := range includeNamespaces {
+		included[ns] = true
+	}
+	restrictToIncluded := len(includeNamespaces) > 0 && !included["*"]
+
+	targets := make(map[string]string, len(mappings))
+	for source, target := range mappings {
+		if errs := validation.IsDNS1123Label(source); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace mapping source '%s': %s", source, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsDNS1123Label(target); len(errs) > 0 {
+			return fmt.Errorf("invalid namespace mapping target '%s': %s", target, strings.Join(errs, ", "))
+		}
+		if source == target {
+			return fmt.Errorf("namespace mapping '%s' maps to itself", source)
+		}
+		if restrictToIncluded && !included[source] {
+			return fmt.Errorf("namespace mapping source '%s' is not in includeNamespaces", source)
+		}
+		if other, exists := targets[target]; exists {
+			return fmt.Errorf("namespaces '%s' and '%s' are both mapped to '%s'", other, source, target)
+		}
+		targets[target] = source
+	}
+
+	return nil
+}
+
+// validateRestoreMetadata : Restore 리소스 레이블/어노테이션 검증
+func validateRestoreMetadata(labels, annotations map[string]string) error {
+	for key, value := range labels {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid metadata label key '%s': %s", key, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("invalid metadata label value '%s': %s", value, strings.Join(errs, ", "))
+		}
+	}
+
+	for key := range annotations {
+		// 레이블 키와 같이 입력한 키를 그대로 검증 (대문자 접두사 등은 소문자로 바꾸지 않고 거부)
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("invalid annotation key '%s': %s", key, strings.Join(errs, ", "))
+		}
+	}
+
+	return nil
+}
+
+// parseExistingResourcePolicy : ExistingResourcePolicy 문자열 변환
+func parseExistingResourcePolicy(policy string) (velerov1.PolicyType, error) {
+	switch velerov1.PolicyType(policy) {
+	case "":
+		return "", nil
+	case velerov1.PolicyTypeNone, velerov1.PolicyTypeUpdate:
+		return velerov1.PolicyType(policy), nil
+	default:
+		return "", fmt.Errorf("invalid existingResourcePolicy '%s': must be 'none' or 'update'", policy)
+	}
+}
+
+// convertRestoreHooks : 요청의 복원 훅을 Velero RestoreHooks로 변환
+func convertRestoreHooks(hooks *types.RestoreHooks) (velerov1.RestoreHooks, error) {
+	var result velerov1.RestoreHooks
+	if hooks == nil {
+		return result, nil
+	}
+
+	for i, resource := range hooks.Resources {
+		if resource.Name == "" {
+			return result, fmt.Errorf("hooks.resources[%d]: name is required", i)
+		}
+
+		// Velero 복원 훅은 postHooks(exec/init)만 지원
+		if len(resource.PreHooks) > 0 {
+			return result, fmt.Errorf("hook '%s': preHooks are not supported for restores, use init hooks in postHooks instead", resource.Name)
+		}
+		if len(resource.PostHooks) == 0 {
+			return result, fmt.Errorf("hook '%s': at least one postHook is required", resource.Name)
+		}
+
+		spec := velerov1.RestoreResourceHookSpec{
+			Name:               resource.Name,
+			IncludedNamespaces: resource.Namespaces,
+			IncludedResources:  resource.Resources,
+		}
+		if len(resource.LabelSelector) > 0 {
+			spec.LabelSelector = &metav1.LabelSelector{
+				MatchLabels: resource.LabelSelector,
+			}
+		}
+
+		for j, hook := range resource.PostHooks {
+			converted, err := convertRestoreHook(hook)
+			if err != nil {
+				return result, fmt.Errorf("hook '%s' postHooks[%d]: %w", resource.Name, j, err)
+			}
+			spec.PostHooks = append(spec.PostHooks, converted)
+		}
+
+		result.Resources = append(result.Resources, spec)
+	}
+
+	return result, nil
+}
+
+// convertRestoreHook : 개별 복원 훅(exec 또는 init) 변환
+func convertRestoreHook(hook types.RestoreHookSpec) (velerov1.RestoreResourceHook, error) {
+	var result velerov1.RestoreResourceHook
+
+	if (hook.Exec == nil) == (hook.Init == nil) {
+		return result, fmt.Errorf("exactly one of exec or init must be specified")
+	}
+
+	if hook.Exec != nil {
+		if len(hook.Exec.Command) == 0 {
+			return result, fmt.Errorf("exec command is required")
+		}
+
+		onError := velerov1.HookErrorMode(hook.Exec.OnError)
+		if onError != "" && onError != velerov1.HookErrorModeContinue && onError != velerov1.HookErrorModeFail {
+			return result, fmt.Errorf("invalid exec onError '%s': must be 'Continue' or 'Fail'", hook.Exec.OnError)
+		}
+
+		execTimeout, err := parseHookDuration("exec timeout", hook.Exec.Timeout)
+		if err != nil {
+			return result, err
+		}
+		waitTimeout, err := parseHookDuration("exec waitTimeout", hook.Exec.WaitTimeout)
+		if err != nil {
+			return result, err
+		}
+
+		result.Exec = &velerov1.ExecRestoreHook{
+			Container:    hook.Exec.Container,
+			Command:      hook.Exec.Command,
+			OnError:      onError,
+			ExecTimeout:  execTimeout,
+			WaitTimeout:  waitTimeout,
+			WaitForReady: hook.Exec.WaitForReady,
+		}
+		return result, nil
+	}
+
+	if len(hook.Init.InitContainers) == 0 {
+		return result, fmt.Errorf("init hook requires at least one initContainer")
+	}
+
+	timeout, err := parseHookDuration("init timeout", hook.Init.Timeout)
+	if err != nil {
+		return result, err
+	}
+
+	initHook := &velerov1.InitRestoreHook{Timeout: timeout}
+	for k, container := range hook.Init.InitContainers {
+		if container.Name == "" || container.Image == "" {
+			return result, fmt.Errorf("initContainers[%d]: name and image are required", k)
+		}
+
+		raw, err := json.Marshal(container)
+		if err != nil {
+			return result, fmt.Errorf("initContainers[%d]: failed to encode container: %w", k, err)
+		}
+		initHook.InitContainers = append(initHook.InitContainers, runtime.RawExtension{Raw: raw})
+	}
+
+	result.Init = initHook
+	return result, nil
+}
+
+// parseHookDuration : 훅 타임아웃 문자열을 metav1.Duration으로 변환
+func parseHookDuration(field, value string) (metav1.Duration, error) {
+	if value == "" {
+		return metav1.Duration{}, nil
+	}
+
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration < 0 {
+		return metav1.Duration{}, fmt.Errorf("invalid %s '%s'", field, value)
+	}
+
+	return metav1.Duration{Duration: duration}, nil
+}
+
+// applyStorageClassMappings : StorageClass 매핑을 적용합니다
+func (s *Service) applyStorageClassMappings(
+	ctx context.Context,
+	client client.Client,
+	jobID string,
+	storageClassMappings map[string]string,
+	includeNamespaces []string,
+) {
+	for originalSC, newSC := range storageClassMappings {
+		for _, namespace := range includeNamespaces {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Processing namespace %s: %s -> %s", namespace, originalSC, newSC))
+
+			// 해당 네임스페이스의 PVC 목록 조회
+			pvcList, err := client.Kubernetes().GetPVCs(ctx, namespace, "")
+			if err != nil {
+				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to get PVCs in namespace %s: %v", namespace, err))
+				continue
+			}
+
+			// PVC 목록을 타입 캐스팅
+			pvcs, ok := pvcList.(*v1.PersistentVolumeClaimList)
+			if !ok {
+				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to cast PVC list in namespace %s", namespace))
+				continue
+			}
+
+			// 해당 StorageClass를 사용하는 PVC 찾기
+			var targetPVCs []string
+			for _, pvc := range pvcs.Items {
+				if pvc.Spec.StorageClassName != nil && *pvc.Spec.StorageClassName == originalSC {
+					targetPVCs = append(targetPVCs, pvc.Name)
+				}
+			}
+
+			if len(targetPVCs) == 0 {
+				s.jobManager.AddJobLog(jobID, fmt.Sprintf("No PVCs found with StorageClass %s in namespace %s", originalSC, namespace))
+				continue
+			}
+
+			// 각 PVC의 StorageClass 변경 (삭제 후 재생성 방식)
+			for _, pvcName := range targetPVCs {
+				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Recreating PVC %s/%s: %s -> %s", namespace, pvcName, originalSC, newSC))
+
+				// PVC 삭제 후 재생성
+				err := s.recreatePVCWithNewStorageClass(ctx, client, jobID, namespace, pvcName, newSC)
+				if err != nil {
+					s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to recreate PVC %s/%s: %v", namespace, pvcName, err))
+					continue
+				}
+
+				s.jobManager.AddJobLog(jobID, fmt.Sprintf("Successfully recreated PVC %s/%s", namespace, pvcName))
+			}
+		}
+	}
+}
+
+// waitForPVCBinding : PVC가 새로운 PV와 바인딩될 때까지 대기
+func (s *Service) waitForPVCBinding(
+	ctx context.Context,
+	client client.Client,
+	jobID string,
+	namespace, pvcName string,
+	originalPVC *v1.PersistentVolumeClaim,
+) {
+	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Waiting for PVC %s/%s to bind to new PV...", namespace, pvcName))
+
+	// 최대 3분 대기 (단축)
 	waitTimeout := 3 * time.Minute
 	checkInterval := 10 * time.Second
 
@@ -429,21 +1064,28 @@ func (s *Service) waitForRestoreCompletion(
 	waitTimeout := 30 * time.Minute
 	checkInterval := 30 * time.Second
 
-	for start := time.Now(); time.Since(start) < waitTimeout; time.Sleep(checkInterval) {
+	for start := time.Now(); time.Since(start) < waitTimeout; {
 		// 복구 상태 확인
 		restoreStatus, err := client.Velero().GetRestore(ctx, "velero", restoreName)
-		if err != nil {
-			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to check restore status: %v", err))
-			continue
+		if ctx.Err() != nil {
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Stopped waiting for restore: %v", ctx.Err()))
+			return
 		}
-
-		// 복구 완료 확인
-		if restoreStatus.Status.Phase == "Completed" || restoreStatus.Status.Phase == "PartiallyFailed" {
+		switch {
+		case err != nil:
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Failed to check restore status: %v", err))
+		case restoreStatus.Status.Phase == "Completed" || restoreStatus.Status.Phase == "PartiallyFailed":
+			// 복구 완료 확인
 			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore completed with phase: %s", restoreStatus.Status.Phase))
 			return
+		default:
+			s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore status: %s, waiting...", restoreStatus.Status.Phase))
 		}
 
-		s.jobManager.AddJobLog(jobID, fmt.Sprintf("Restore status: %s, waiting...", restoreStatus.Status.Phase))
+		select {
+		case <-ctx.Done():
+		case <-time.After(checkInterval):
+		}
 	}
 
 	s.jobManager.AddJobLog(jobID, "Restore did not complete within timeout")
@@ -865,6 +1507,7 @@ func (s *Service) GetPodVolumeRestoresInternal(client client.Client, ctx context
 func (s *Service) CreateBackupInternal(
 	client client.Client,
 	ctx context.Context,
+	kubeConfig config.KubeConfig,
 	backupReq types.BackupRequest,
 	namespace string,
 ) (interface{}, error) {
@@ -884,6 +1527,12 @@ func (s *Service) CreateBackupInternal(
 	}
 
 	_ = s.jobManager.CreateJob(jobID, metadata)
+	s.jobManager.SetJobRecovery(jobID, job.RecoveryInfo{
+		Kind:       recoveryKindBackup,
+		Namespace:  namespace,
+		Name:       backupReq.Name,
+		KubeConfig: kubeConfig.KubeConfig,
+	})
 
 	// 백그라운드에서 백업 생성 시작
 	go s.createBackupInternal(client, ctx, jobID, backupReq, namespace)
@@ -911,7 +1560,7 @@ func (s *Service) createBackupInternal(
 ) {
 	// 백그라운드 작업을 위한 새로운 context 생성 (30분 timeout)
 	timeout := s.GetConfigDuration("VELERO_BACKUP_TIMEOUT", 30*time.Minute)
-	bgCtx, cancel := context.WithTimeout(context.Background(), timeout)
+	bgCtx, cancel := s.jobManager.JobContext(jobID, timeout)
 	defer cancel()
 
 	// Velero Backup 리소스 생성
@@ -920,25 +1569,9 @@ func (s *Service) createBackupInternal(
 			Name:      backupReq.Name,
 			Namespace: namespace,
 		},
-		Spec: velerov1.BackupSpec{
-			IncludedNamespaces:       backupReq.IncludeNamespaces,
-			ExcludedNamespaces:       backupReq.ExcludeNamespaces,
-			IncludedResources:        backupReq.IncludeResources,
-			ExcludedResources:        backupReq.ExcludeResources,
-			StorageLocation:          backupReq.StorageLocation,
-			VolumeSnapshotLocations:  backupReq.VolumeSnapshotLocations,
-			TTL:                      metav1.Duration{Duration: parseTTL(backupReq.TTL)},
-			IncludeClusterResources:  backupReq.IncludeClusterResources,
-			DefaultVolumesToFsBackup: s.getDefaultVolumesToFsBackup(backupReq),
-		},
-	}
-
-	// LabelSelector가 있는 경우에만 추가
-	if backupReq.LabelSelector != nil && len(backupReq.LabelSelector) > 0 {
-		backup.Spec.LabelSelector = &metav1.LabelSelector{
-			MatchLabels: backupReq.LabelSelector,
-		}
+		Spec: buildBackupSpec(backupReq),
 	}
+	backup.Spec.DefaultVolumesToFsBackup = s.getDefaultVolumesToFsBackup(backupReq)
 
 	// 백업 생성 시작
 	s.jobManager.UpdateJobStatus(jobID, job.JobStatusProcessing, 10, "Creating Velero backup...")
@@ -946,6 +1579,15 @@ func (s *Service) createBackupInternal(
 
 	// Velero 백업 리소스 생성
 	err := client.Velero().CreateBackup(bgCtx, namespace, backup)
+	if job.IsCancelled(bgCtx) {
+		// 취소 직전에 생성된 Backup 리소스 정리
+		if err == nil {
+			s.compensate(jobID, fmt.Sprintf("Deleting backup %s after cancellation", backupReq.Name), func(ctx context.Context) error {
+				return client.Velero().DeleteBackup(ctx, namespace, backupReq.Name)
+			})
+		}
+		return
+	}
 	if err != nil {
 		s.jobManager.FailJob(jobID, err)
 		return
@@ -966,17 +1608,48 @@ func (s *Service) createBackupInternal(
 	s.jobManager.AddJobLog(jobID, fmt.Sprintf("Backup %s created successfully", backupReq.Name))
 }
 
+// buildBackupSpec : 백업 요청을 Velero BackupSpec으로 변환 (Backup 생성, Schedule 템플릿 공용)
+func buildBackupSpec(backupReq types.BackupRequest) velerov1.BackupSpec {
+	spec := velerov1.BackupSpec{
+		IncludedNamespaces:       backupReq.IncludeNamespaces,
+		ExcludedNamespaces:       backupReq.ExcludeNamespaces,
+		IncludedResources:        backupReq.IncludeResources,
+		ExcludedResources:        backupReq.ExcludeResources,
+		StorageLocation:          backupReq.StorageLocation,
+		VolumeSnapshotLocations:  backupReq.VolumeSnapshotLocations,
+		TTL:                      metav1.Duration{Duration: parseTTL(backupReq.TTL)},
+		IncludeClusterResources:  backupReq.IncludeClusterResources,
+		DefaultVolumesToFsBackup: backupReq.DefaultVolumesToFsBackup,
+	}
+
+	// LabelSelector가 있는 경우에만 추가
+	if len(backupReq.LabelSelector) > 0 {
+		spec.LabelSelector = &metav1.LabelSelector{
+			MatchLabels: backupReq.LabelSelector,
+		}
+	}
+
+	return spec
+}
+
 // getDefaultVolumesToFsBackup : 요청에서 DefaultVolumesToFsBackup 값 결정
 func (s *Service) getDefaultVolumesToFsBackup(backupReq types.BackupRequest) *bool {
-	// 요청에서 명시적으로 설정된 경우 사용
+	value := defaultVolumesToFsBackup(backupReq)
+	if backupReq.DefaultVolumesToFsBackup != nil {
+		s.jobManager.AddJobLog("backup-create", fmt.Sprintf("Using explicit defaultVolumesToFsBackup: %v", *value))
+	} else {
+		s.jobManager.AddJobLog("backup-create", fmt.Sprintf("Using default defaultVolumesToFsBackup: %v", *value))
+	}
+	return value
+}
+
+// defaultVolumesToFsBackup : 요청에 명시된 값, 없으면 기본값 false (스냅샷 우선)
+func defaultVolumesToFsBackup(backupReq types.BackupRequest) *bool {
 	if backupReq.DefaultVolumesToFsBackup != nil {
-		s.jobManager.AddJobLog("backup-create", fmt.Sprintf("Using explicit defaultVolumesToFsBackup: %v", *backupReq.DefaultVolumesToFsBackup))
 		return backupReq.DefaultVolumesToFsBackup
 	}
 
-	// 기본값: false (스냅샷 우선)
 	defaultValue := false
-	s.jobManager.AddJobLog("backup-create", fmt.Sprintf("Using default defaultVolumesToFsBackup: %v", defaultValue))
 	return &defaultValue
 }
 
@@ -1197,6 +1870,154 @@ func (s *Service) DeleteBackupInternal(
 	return result, nil
 }
 
+// ===== Schedule 관련 =====
+
+// GetSchedulesInternal : Velero Schedule 목록 조회
+func (s *Service) GetSchedulesInternal(client client.Client, ctx context.Context, namespace string) ([]velerov1.Schedule, error) {
+	schedules, err := client.Velero().GetSchedules(ctx, namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schedules: %w", err)
+	}
+
+	// managedFields 제거
+	removeManagedFieldsFromSchedules(schedules)
+
+	return schedules, nil
+}
+
+// GetScheduleInternal : Velero Schedule 상세 조회
+func (s *Service) GetScheduleInternal(client client.Client, ctx context.Context, namespace, scheduleName string) (*velerov1.Schedule, error) {
+	schedule, err := client.Velero().GetSchedule(ctx, namespace, scheduleName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule '%s': %w", scheduleName, err)
+	}
+
+	// managedFields 제거
+	schedule.ObjectMeta.ManagedFields = nil
+
+	return schedule, nil
+}
+
+// ValidateScheduleRequest : 스케줄 요청 검증 (이름, cron 표현식, 백업 템플릿)
+func (s *Service) ValidateScheduleRequest(req types.ScheduleRequest) error {
+	if errs := validation.IsDNS1123Subdomain(req.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid schedule name '%s': %s", req.Name, strings.Join(errs, ", "))
+	}
+
+	if err := s.ValidationManager.ValidateCronExpression(req.Schedule); err != nil {
+		return err
+	}
+
+	if req.Template.TTL != "" {
+		if _, err := time.ParseDuration(req.Template.TTL); err != nil {
+			return fmt.Errorf("invalid template ttl '%s': %w", req.Template.TTL, err)
+		}
+	}
+
+	return nil
+}
+
+// CreateScheduleInternal : Velero Schedule 생성
+func (s *Service) CreateScheduleInternal(client client.Client, ctx context.Context, req types.ScheduleRequest, namespace string) (*velerov1.Schedule, error) {
+	if err := s.ValidateScheduleRequest(req); err != nil {
+		return nil, err
+	}
+
+	schedule := &velerov1.Schedule{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: namespace,
+		},
+		Spec: buildScheduleSpec(req),
+	}
+
+	if err := client.Velero().CreateSchedule(ctx, namespace, schedule); err != nil {
+		return nil, fmt.Errorf("failed to create schedule: %w", err)
+	}
+
+	schedule.ObjectMeta.ManagedFields = nil
+	return schedule, nil
+}
+
+// UpdateScheduleInternal : Velero Schedule 수정 (cron 표현식, 일시 중지 여부, 백업 템플릿 교체)
+func (s *Service) UpdateScheduleInternal(client client.Client, ctx context.Context, scheduleName string, req types.ScheduleRequest, namespace string) (*velerov1.Schedule, error) {
+	if req.Name == "" {
+		req.Name = scheduleName
+	}
+	if req.Name != scheduleName {
+		return nil, fmt.Errorf("schedule name '%s' does not match path '%s'", req.Name, scheduleName)
+	}
+	if err := s.ValidateScheduleRequest(req); err != nil {
+		return nil, err
+	}
+
+	schedule, err := client.Velero().GetSchedule(ctx, namespace, scheduleName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get schedule '%s': %w", scheduleName, err)
+	}
+
+	schedule.Spec = updateScheduleSpec(schedule.Spec, req)
+	if err := client.Velero().UpdateSchedule(ctx, namespace, schedule); err != nil {
+		return nil, fmt.Errorf("failed to update schedule: %w", err)
+	}
+
+	schedule.ObjectMeta.ManagedFields = nil
+	return schedule, nil
+}
+
+// PauseScheduleInternal : Velero Schedule 일시 중지/재개
+func (s *Service) PauseScheduleInternal(client client.Client, ctx context.Context, namespace, scheduleName string, paused bool) (interface{}, error) {
+	if err := client.Velero().PauseSchedule(ctx, namespace, scheduleName, paused); err != nil {
+		return nil, fmt.Errorf("failed to update schedule '%s': %w", scheduleName, err)
+	}
+
+	return map[string]interface{}{
+		"scheduleName": scheduleName,
+		"namespace":    namespace,
+		"paused":       paused,
+		"updatedAt":    time.Now(),
+	}, nil
+}
+
+// DeleteScheduleInternal : Velero Schedule 삭제 (스케줄로 생성된 Backup은 유지)
+func (s *Service) DeleteScheduleInternal(client client.Client, ctx context.Context, namespace, scheduleName string) (interface{}, error) {
+	if err := client.Velero().DeleteSchedule(ctx, namespace, scheduleName); err != nil {
+		return nil, fmt.Errorf("failed to delete schedule: %w", err)
+	}
+
+	return map[string]interface{}{
+		"scheduleName": scheduleName,
+		"namespace":    namespace,
+		"status":       "deleted",
+		"message":      "Schedule deleted successfully",
+		"deletedAt":    time.Now(),
+	}, nil
+}
+
+// buildScheduleSpec : 스케줄 요청을 Velero ScheduleSpec으로 변환
+// 백업 템플릿의 defaultVolumesToFsBackup은 백업 생성과 같은 기본값을 적용
+func buildScheduleSpec(req types.ScheduleRequest) velerov1.ScheduleSpec {
+	template := buildBackupSpec(req.Template)
+	template.DefaultVolumesToFsBackup = defaultVolumesToFsBackup(req.Template)
+
+	return velerov1.ScheduleSpec{
+		Template:                   template,
+		Schedule:                   strings.TrimSpace(req.Schedule),
+		Paused:                     req.Paused != nil && *req.Paused,
+		UseOwnerReferencesInBackup: req.UseOwnerReferencesInBackup,
+		SkipImmediately:            req.SkipImmediately,
+	}
+}
+
+// updateScheduleSpec : 수정 요청으로 ScheduleSpec 교체 (paused를 생략하면 기존 일시 중지 상태 유지)
+func updateScheduleSpec(current velerov1.ScheduleSpec, req types.ScheduleRequest) velerov1.ScheduleSpec {
+	spec := buildScheduleSpec(req)
+	if req.Paused == nil {
+		spec.Paused = current.Paused
+	}
+	return spec
+}
+
 // parseTTL : TTL 문자열을 time.Duration으로 변환
 func parseTTL(ttl string) time.Duration {
 	if ttl == "" {
@@ -1211,12 +2032,1615 @@ func parseTTL(ttl string) time.Duration {
 	return duration
 }
 
-// ===== managedFields 제거 헬퍼 함수들 =====
+// ===== 백업 내용 조회 관련 =====
 
-// removeManagedFieldsFromBackups : Backup 목록에서 managedFields 제거
-func removeManagedFieldsFromBackups(backups []velerov1.Backup) {
-	for i := range backups {
-		backups[i].ObjectMeta.ManagedFields = nil
+// 백업 내용 조회 설정
+const (
+	backupContentCacheTTL = 30 * time.Minute
+	maxManifestSize       = 16 * 1024 * 1024 // 단일 매니페스트 최대 크기
+)
+
+// BackupContentsOptions : 백업 내용 조회 옵션
+type BackupContentsOptions struct {
+	Bucket  string // 비어 있으면 백업의 BackupStorageLocation 버킷 사용
+	Prefix  string // Bucket을 지정한 경우에만 사용
+	Refresh bool   // 캐시 무시
+}
+
+// GetBackupContentsInternal : 오브젝트 스토리지의 백업 tarball을 읽어 포함된 객체 색인 (결과는 캐시)
+func (s *Service) GetBackupContentsInternal(client client.Client, ctx context.Context, namespace, backupName string, opts BackupContentsOptions) (*types.BackupContents, error) {
+	bucket, prefix, cacheKey, err := s.resolveBackupObjectStore(client, ctx, namespace, backupName, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.loadBackupContents(client, ctx, backupName, bucket, prefix, cacheKey, opts.Refresh)
+}
+
+// loadBackupContents : 백업 tarball 색인 (cacheKey로 캐시)
+func (s *Service) loadBackupContents(client client.Client, ctx context.Context, backupName, bucket, prefix, cacheKey string, refresh bool) (*types.BackupContents, error) {
+	if !refresh {
+		if cached, ok := s.contentCache.GetData(cacheKey); ok {
+			return cached.(*types.BackupContents), nil
+		}
+	}
+
+	backupDir := path.Join(prefix, "backups", backupName)
+
+	reader, err := client.Minio().GetObjectStream(ctx, bucket, path.Join(backupDir, backupName+".tar.gz"), minioclient.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download backup tarball: %w", err)
+	}
+	defer reader.Close()
+
+	items, err := indexBackupTarball(reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup tarball: %w", err)
+	}
+
+	contents := &types.BackupContents{
+		BackupName: backupName,
+		Bucket:     bucket,
+		Prefix:     prefix,
+		TotalItems: len(items),
+		Namespaces: map[string]int{},
+		Resources:  map[string]int{},
+		Items:      items,
+		IndexedAt:  time.Now(),
+	}
+	for _, item := range items {
+		contents.Resources[item.Resource]++
+		if item.Namespace != "" {
+			contents.Namespaces[item.Namespace]++
+		}
+	}
+
+	// resource-list는 Velero 1.9 이전 백업에는 없으므로 없으면 건너뜀
+	resourceList, err := s.readBackupResourceList(client, ctx, bucket, path.Join(backupDir, backupName+"-resource-list.json.gz"))
+	if err != nil {
+		return nil, err
+	}
+	if resourceList != nil {
+		contents.ResourceList = resourceList
+		contents.Kinds = make(map[string]int, len(resourceList))
+		for gvk, objects := range resourceList {
+			contents.Kinds[gvk] = len(objects)
+		}
+	}
+
+	s.contentCache.SetData(cacheKey, contents, backupContentCacheTTL)
+	return contents, nil
+}
+
+// filterBackupContents : 네임스페이스/리소스로 백업 내용 필터링 (캐시된 원본은 변경하지 않음)
+func filterBackupContents(contents *types.BackupContents, itemNamespace, resource string) *types.BackupContents {
+	if itemNamespace == "" && resource == "" {
+		return contents
+	}
+
+	filtered := *contents
+	filtered.Items = make([]types.BackupContentItem, 0)
+	for _, item := range contents.Items {
+		if itemNamespace != "" && item.Namespace != itemNamespace {
+			continue
+		}
+		if resource != "" && item.Resource != resource {
+			continue
+		}
+		filtered.Items = append(filtered.Items, item)
+	}
+	filtered.TotalItems = len(filtered.Items)
+	return &filtered
+}
+
+// GetBackupContentManifestInternal : 백업 tarball에서 객체 하나의 매니페스트 조회 (결과는 캐시)
+func (s *Service) GetBackupContentManifestInternal(client client.Client, ctx context.Context, namespace, backupName, resource, itemNamespace, name string, opts BackupContentsOptions) (map[string]interface{}, error) {
+	contents, err := s.GetBackupContentsInternal(client, ctx, namespace, backupName, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var item *types.BackupContentItem
+	for i := range contents.Items {
+		candidate := &contents.Items[i]
+		if candidate.Resource == resource && candidate.Namespace == itemNamespace && candidate.Name == name {
+			item = candidate
+			break
+		}
+	}
+	if item == nil {
+		if itemNamespace == "" {
+			return nil, fmt.Errorf("%s '%s' not found in backup '%s'", resource, name, backupName)
+		}
+		return nil, fmt.Errorf("%s '%s/%s' not found in backup '%s'", resource, itemNamespace, name, backupName)
+	}
+
+	cacheKey := fmt.Sprintf("manifest:%s/%s/%s:%s", contents.Bucket, contents.Prefix, backupName, item.Path)
+	if !opts.Refresh {
+		if cached, ok := s.contentCache.GetData(cacheKey); ok {
+			return cached.(map[string]interface{}), nil
+		}
+	}
+
+	tarballKey := path.Join(contents.Prefix, "backups", backupName, backupName+".tar.gz")
+	reader, err := client.Minio().GetObjectStream(ctx, contents.Bucket, tarballKey, minioclient.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download backup tarball: %w", err)
+	}
+	defer reader.Close()
+
+	data, err := extractBackupTarballFile(reader, item.Path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup tarball: %w", err)
+	}
+
+	var manifest map[string]interface{}
+	if err := json.Unmarshal(data, &manifest); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest %s: %w", item.Path, err)
+	}
+
+	s.contentCache.SetData(cacheKey, manifest, backupContentCacheTTL)
+	return manifest, nil
+}
+
+// resolveBackupObjectStore : 백업 tarball이 저장된 버킷/접두사와 캐시 키 결정
+func (s *Service) resolveBackupObjectStore(client client.Client, ctx context.Context, namespace, backupName string, opts BackupContentsOptions) (string, string, string, error) {
+	backup, err := client.Velero().GetBackup(ctx, namespace, backupName)
+	if err != nil {
+		return "", "", "", fmt.Errorf("failed to get backup '%s': %w", backupName, err)
+	}
+
+	// tarball은 백업 항목 수집이 끝난 뒤 업로드됨
+	switch backup.Status.Phase {
+	case velerov1.BackupPhaseNew, velerov1.BackupPhaseInProgress, velerov1.BackupPhaseFailedValidation:
+		return "", "", "", fmt.Errorf("backup '%s' has no contents in object storage (phase: %s)", backupName, backup.Status.Phase)
+	}
+
+	bucket, prefix := opts.Bucket, strings.Trim(opts.Prefix, "/")
+	if bucket == "" {
+		locationName := backup.Spec.StorageLocation
+		if locationName == "" {
+			locationName = "default"
+		}
+		bsl, err := client.Velero().GetBackupStorageLocation(ctx, namespace, locationName)
+		if err != nil {
+			return "", "", "", fmt.Errorf("failed to get backup storage location '%s': %w", locationName, err)
+		}
+		if bsl.Spec.ObjectStorage == nil || bsl.Spec.ObjectStorage.Bucket == "" {
+			return "", "", "", fmt.Errorf("backup storage location '%s' has no object storage bucket", locationName)
+		}
+		bucket = bsl.Spec.ObjectStorage.Bucket
+		prefix = strings.Trim(bsl.Spec.ObjectStorage.Prefix, "/")
+	}
+
+	// 같은 이름으로 다시 만든 백업과 구분하기 위해 UID 포함
+	cacheKey := fmt.Sprintf("contents:%s/%s/%s:%s", bucket, prefix, backupName, backup.UID)
+	return bucket, prefix, cacheKey, nil
+}
+
+// readBackupResourceList : <backup>-resource-list.json.gz 읽기 (없으면 nil)
+func (s *Service) readBackupResourceList(client client.Client, ctx context.Context, bucket, key string) (map[string][]string, error) {
+	reader, err := client.Minio().GetObjectStream(ctx, bucket, key, minioclient.GetObjectOptions{})
+	if err != nil {
+		if minioclient.IsObjectNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to download backup resource list: %w", err)
+	}
+	defer reader.Close()
+
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		if minioclient.IsObjectNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read backup resource list: %w", err)
+	}
+	defer gzipReader.Close()
+
+	var resourceList map[string][]string
+	if err := json.NewDecoder(gzipReader).Decode(&resourceList); err != nil {
+		return nil, fmt.Errorf("failed to parse backup resource list: %w", err)
+	}
+	return resourceList, nil
+}
+
+// indexBackupTarball : Velero 백업 tarball(gzip)의 resources/ 항목 색인
+// 경로 형식 : resources/<resource.group>/[<version>[-preferredversion]/](namespaces/<ns>|cluster)/<name>.json
+func indexBackupTarball(reader io.Reader) ([]types.BackupContentItem, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	items := make(map[string]*types.BackupContentItem)
+	var order []string
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		entry, ok := parseBackupTarballPath(header.Name)
+		if !ok {
+			continue
+		}
+
+		key := entry.resource + "/" + entry.namespace + "/" + entry.name
+		item, exists := items[key]
+		if !exists {
+			item = &types.BackupContentItem{
+				Resource:  entry.resource,
+				Namespace: entry.namespace,
+				Name:      entry.name,
+			}
+			items[key] = item
+			order = append(order, key)
+		}
+
+		// 버전이 없는 경로가 기본 매니페스트, 없으면 preferred 버전 경로 사용
+		switch {
+		case entry.version == "":
+			item.Path, item.Size = header.Name, header.Size
+		case entry.preferred:
+			item.PreferredVersion = entry.version
+			if item.Path == "" || !isUnversionedBackupPath(item.Path, entry.resource) {
+				item.Path, item.Size = header.Name, header.Size
+			}
+		}
+		if entry.version != "" {
+			item.Versions = append(item.Versions, entry.version)
+		}
+	}
+
+	result := make([]types.BackupContentItem, 0, len(order))
+	for _, key := range order {
+		item := items[key]
+		if item.Path == "" {
+			// preferred 표시 없이 다른 버전만 있는 경우
+			continue
+		}
+		result = append(result, *item)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Namespace != result[j].Namespace {
+			return result[i].Namespace < result[j].Namespace
+		}
+		if result[i].Resource != result[j].Resource {
+			return result[i].Resource < result[j].Resource
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// backupTarballEntry : 백업 tarball 경로 해석 결과
+type backupTarballEntry struct {
+	resource  string
+	version   string
+	preferred bool
+	namespace string
+	name      string
+}
+
+// parseBackupTarballPath : resources/ 아래 매니페스트 경로 해석 (다른 경로는 false)
+func parseBackupTarballPath(name string) (backupTarballEntry, bool) {
+	var entry backupTarballEntry
+
+	parts := strings.Split(strings.TrimPrefix(path.Clean(name), "./"), "/")
+	if len(parts) < 4 || parts[0] != "resources" || !strings.HasSuffix(name, ".json") {
+		return entry, false
+	}
+	entry.resource = parts[1]
+	rest := parts[2:]
+
+	if rest[0] != "namespaces" && rest[0] != "cluster" {
+		entry.version = strings.TrimSuffix(rest[0], "-preferredversion")
+		entry.preferred = entry.version != rest[0]
+		rest = rest[1:]
+	}
+
+	switch {
+	case len(rest) == 3 && rest[0] == "namespaces":
+		entry.namespace = rest[1]
+		entry.name = strings.TrimSuffix(rest[2], ".json")
+	case len(rest) == 2 && rest[0] == "cluster":
+		entry.name = strings.TrimSuffix(rest[1], ".json")
+	default:
+		return entry, false
+	}
+	return entry, true
+}
+
+// isUnversionedBackupPath : 버전 디렉터리가 없는 기본 매니페스트 경로인지 확인
+func isUnversionedBackupPath(name, resource string) bool {
+	rest := strings.TrimPrefix(strings.TrimPrefix(path.Clean(name), "./"), "resources/"+resource+"/")
+	return strings.HasPrefix(rest, "namespaces/") || strings.HasPrefix(rest, "cluster/")
+}
+
+// extractBackupTarballFile : 백업 tarball(gzip)에서 파일 하나 읽기
+func extractBackupTarballFile(reader io.Reader, name string) ([]byte, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil, fmt.Errorf("%s not found in backup tarball", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if header.Name != name {
+			continue
+		}
+		if header.Size > maxManifestSize {
+			return nil, fmt.Errorf("manifest %s is too large (%d bytes)", name, header.Size)
+		}
+		return io.ReadAll(tarReader)
+	}
+}
+
+// ===== 백업 비교 관련 =====
+
+// jsonPointerEscaper : JSON Pointer(RFC 6901) 경로 토큰 이스케이프
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// DiffBackupsInternal : 두 백업 tarball의 객체를 비교 (from 기준으로 to에서 추가/삭제/변경된 객체, 결과는 캐시)
+func (s *Service) DiffBackupsInternal(client client.Client, ctx context.Context, namespace, from, to, itemNamespace, resource string, opts BackupContentsOptions) (*types.BackupDiff, error) {
+	fromBucket, fromPrefix, fromKey, err := s.resolveBackupObjectStore(client, ctx, namespace, from, opts)
+	if err != nil {
+		return nil, err
+	}
+	toBucket, toPrefix, toKey, err := s.resolveBackupObjectStore(client, ctx, namespace, to, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheKey := fmt.Sprintf("diff:%s|%s|%s|%s", fromKey, toKey, itemNamespace, resource)
+	if !opts.Refresh {
+		if cached, ok := s.contentCache.GetData(cacheKey); ok {
+			return cached.(*types.BackupDiff), nil
+		}
+	}
+
+	fromContents, err := s.loadBackupContents(client, ctx, from, fromBucket, fromPrefix, fromKey, opts.Refresh)
+	if err != nil {
+		return nil, err
+	}
+	toContents, err := s.loadBackupContents(client, ctx, to, toBucket, toPrefix, toKey, opts.Refresh)
+	if err != nil {
+		return nil, err
+	}
+	fromContents = filterBackupContents(fromContents, itemNamespace, resource)
+	toContents = filterBackupContents(toContents, itemNamespace, resource)
+
+	fromManifests, err := s.readBackupManifests(client, ctx, fromContents)
+	if err != nil {
+		return nil, err
+	}
+	toManifests, err := s.readBackupManifests(client, ctx, toContents)
+	if err != nil {
+		return nil, err
+	}
+
+	diff := diffBackupContents(fromContents, toContents, fromManifests, toManifests)
+	s.contentCache.SetData(cacheKey, diff, backupContentCacheTTL)
+	return diff, nil
+}
+
+// readBackupManifests : 색인된 객체의 매니페스트를 tarball에서 한 번에 읽기 (키 : tarball 경로)
+func (s *Service) readBackupManifests(client client.Client, ctx context.Context, contents *types.BackupContents) (map[string]map[string]interface{}, error) {
+	paths := make(map[string]bool, len(contents.Items))
+	for _, item := range contents.Items {
+		paths[item.Path] = true
+	}
+
+	tarballKey := path.Join(contents.Prefix, "backups", contents.BackupName, contents.BackupName+".tar.gz")
+	reader, err := client.Minio().GetObjectStream(ctx, contents.Bucket, tarballKey, minioclient.GetObjectOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to download backup tarball: %w", err)
+	}
+	defer reader.Close()
+
+	manifests, err := readBackupTarballManifests(reader, paths)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read backup tarball '%s': %w", contents.BackupName, err)
+	}
+	return manifests, nil
+}
+
+// readBackupTarballManifests : 백업 tarball(gzip)에서 지정한 경로의 매니페스트 읽기
+func readBackupTarballManifests(reader io.Reader, paths map[string]bool) (map[string]map[string]interface{}, error) {
+	gzipReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return nil, err
+	}
+	defer gzipReader.Close()
+
+	manifests := make(map[string]map[string]interface{}, len(paths))
+	tarReader := tar.NewReader(gzipReader)
+	for len(manifests) < len(paths) {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !paths[header.Name] {
+			continue
+		}
+		if header.Size > maxManifestSize {
+			return nil, fmt.Errorf("manifest %s is too large (%d bytes)", header.Name, header.Size)
+		}
+
+		var manifest map[string]interface{}
+		if err := json.NewDecoder(tarReader).Decode(&manifest); err != nil {
+			return nil, fmt.Errorf("failed to parse manifest %s: %w", header.Name, err)
+		}
+		manifests[header.Name] = manifest
+	}
+	return manifests, nil
+}
+
+// diffBackupContents : 색인과 매니페스트로 두 백업 비교
+func diffBackupContents(from, to *types.BackupContents, fromManifests, toManifests map[string]map[string]interface{}) *types.BackupDiff {
+	diff := &types.BackupDiff{
+		From:    from.BackupName,
+		To:      to.BackupName,
+		Added:   make([]types.BackupDiffItem, 0),
+		Removed: make([]types.BackupDiffItem, 0),
+		Changed: make([]types.BackupDiffItem, 0),
+	}
+
+	itemKey := func(item types.BackupContentItem) string {
+		return item.Resource + "/" + item.Namespace + "/" + item.Name
+	}
+	toItems := make(map[string]types.BackupContentItem, len(to.Items))
+	for _, item := range to.Items {
+		toItems[itemKey(item)] = item
+	}
+
+	fromKeys := make(map[string]bool, len(from.Items))
+	for _, fromItem := range from.Items {
+		key := itemKey(fromItem)
+		fromKeys[key] = true
+
+		diffItem := types.BackupDiffItem{Resource: fromItem.Resource, Namespace: fromItem.Namespace, Name: fromItem.Name}
+		toItem, exists := toItems[key]
+		if !exists {
+			diff.Removed = append(diff.Removed, diffItem)
+			continue
+		}
+
+		diffItem.Patch = diffManifests(fromManifests[fromItem.Path], toManifests[toItem.Path])
+		if len(diffItem.Patch) == 0 {
+			diff.Summary.Unchanged++
+			continue
+		}
+		diff.Changed = append(diff.Changed, diffItem)
+	}
+
+	for _, toItem := range to.Items {
+		if !fromKeys[itemKey(toItem)] {
+			diff.Added = append(diff.Added, types.BackupDiffItem{Resource: toItem.Resource, Namespace: toItem.Namespace, Name: toItem.Name})
+		}
+	}
+
+	diff.Summary.Added = len(diff.Added)
+	diff.Summary.Removed = len(diff.Removed)
+	diff.Summary.Changed = len(diff.Changed)
+	return diff
+}
+
+// diffManifests : 두 매니페스트의 차이를 JSON Patch 형식으로 계산 (status, managedFields, resourceVersion 제외)
+func diffManifests(from, to map[string]interface{}) []types.BackupDiffOperation {
+	var ops []types.BackupDiffOperation
+	diffJSONValue("", normalizeBackupManifest(from), normalizeBackupManifest(to), &ops)
+	return ops
+}
+
+// redactSecretDiff : Secret 변경 항목의 값(value/oldValue)을 제거한 사본 반환 (캐시된 결과는 수정하지 않음)
+func redactSecretDiff(diff *types.BackupDiff) *types.BackupDiff {
+	redacted := *diff
+	redacted.Changed = make([]types.BackupDiffItem, len(diff.Changed))
+	for i, item := range diff.Changed {
+		if item.Resource == "secrets" {
+			patch := make([]types.BackupDiffOperation, len(item.Patch))
+			for j, op := range item.Patch {
+				patch[j] = types.BackupDiffOperation{Op: op.Op, Path: op.Path}
+			}
+			item.Patch = patch
+		}
+		redacted.Changed[i] = item
+	}
+	return &redacted
+}
+
+// normalizeBackupManifest : 비교에서 제외할 필드 제거
+func normalizeBackupManifest(manifest map[string]interface{}) map[string]interface{} {
+	if manifest == nil {
+		return map[string]interface{}{}
+	}
+
+	obj := &unstructured.Unstructured{Object: manifest}
+	utils.StripManagedFields(obj)
+	// 상태 갱신만으로도 바뀌는 필드
+	unstructured.RemoveNestedField(obj.Object, "status")
+	unstructured.RemoveNestedField(obj.Object, "metadata", "resourceVersion")
+	return obj.Object
+}
+
+// diffJSONValue : JSON 값을 재귀적으로 비교하여 변경 항목 추가 (길이가 다른 배열은 통째로 교체)
+func diffJSONValue(pointer string, from, to interface{}, ops *[]types.BackupDiffOperation) {
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		toValue, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+
+		keys := make([]string, 0, len(fromValue)+len(toValue))
+		for key := range fromValue {
+			keys = append(keys, key)
+		}
+		for key := range toValue {
+			if _, exists := fromValue[key]; !exists {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			childPointer := pointer + "/" + jsonPointerEscaper.Replace(key)
+			fromChild, inFrom := fromValue[key]
+			toChild, inTo := toValue[key]
+			switch {
+			case !inTo:
+				*ops = append(*ops, types.BackupDiffOperation{Op: "remove", Path: childPointer, OldValue: fromChild})
+			case !inFrom:
+				*ops = append(*ops, types.BackupDiffOperation{Op: "add", Path: childPointer, Value: toChild})
+			default:
+				diffJSONValue(childPointer, fromChild, toChild, ops)
+			}
+		}
+		return
+	case []interface{}:
+		toValue, ok := to.([]interface{})
+		if !ok || len(fromValue) != len(toValue) {
+			break
+		}
+		for i := range fromValue {
+			diffJSONValue(fmt.Sprintf("%s/%d", pointer, i), fromValue[i], toValue[i], ops)
+		}
+		return
+	}
+
+	if !reflect.DeepEqual(from, to) {
+		*ops = append(*ops, types.BackupDiffOperation{Op: "replace", Path: pointer, Value: to, OldValue: from})
+	}
+}
+
+// ===== 로그/결과 조회 관련 =====
+
+// DownloadRequest 처리 설정
+const (
+	downloadRequestPollInterval = 500 * time.Millisecond
+	maxVeleroDownloadSize       = 64 * 1024 * 1024 // 압축 해제 후 최대 크기
+)
+
+// veleroLogLevels : 로그 레벨 순위 (level 필터는 지정한 레벨 이상만 반환)
+var veleroLogLevels = map[string]int{"trace": 0, "debug": 1, "info": 2, "warning": 3, "error": 4, "fatal": 5, "panic": 6}
+
+// GetBackupLogsInternal : 백업 로그 조회
+func (s *Service) GetBackupLogsInternal(client client.Client, ctx context.Context, namespace, backupName, level string, insecureSkipTLSVerify bool) (*types.VeleroLogs, error) {
+	backup, err := client.Velero().GetBackup(ctx, namespace, backupName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backup '%s': %w", backupName, err)
+	}
+	switch backup.Status.Phase {
+	case velerov1.BackupPhaseNew, velerov1.BackupPhaseInProgress, velerov1.BackupPhaseFailedValidation:
+		return nil, fmt.Errorf("logs for backup '%s' are not available (phase: %s)", backupName, backup.Status.Phase)
+	}
+
+	data, err := s.downloadVeleroObject(client, ctx, namespace, velerov1.DownloadTargetKindBackupLog, backupName, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	return parseVeleroLogs(backupName, string(velerov1.DownloadTargetKindBackupLog), data, level)
+}
+
+// GetRestoreLogsInternal : 복원 로그 조회
+func (s *Service) GetRestoreLogsInternal(client client.Client, ctx context.Context, namespace, restoreName, level string, insecureSkipTLSVerify bool) (*types.VeleroLogs, error) {
+	if _, err := s.getFinishedRestore(client, ctx, namespace, restoreName); err != nil {
+		return nil, err
+	}
+
+	data, err := s.downloadVeleroObject(client, ctx, namespace, velerov1.DownloadTargetKindRestoreLog, restoreName, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+	return parseVeleroLogs(restoreName, string(velerov1.DownloadTargetKindRestoreLog), data, level)
+}
+
+// GetRestoreResultsInternal : 복원 결과(네임스페이스별 경고/오류) 조회
+func (s *Service) GetRestoreResultsInternal(client client.Client, ctx context.Context, namespace, restoreName string, insecureSkipTLSVerify bool) (*types.RestoreResults, error) {
+	restore, err := s.getFinishedRestore(client, ctx, namespace, restoreName)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := s.downloadVeleroObject(client, ctx, namespace, velerov1.DownloadTargetKindRestoreResults, restoreName, insecureSkipTLSVerify)
+	if err != nil {
+		return nil, err
+	}
+
+	// 저장 형식 : {"warnings": Result, "errors": Result}
+	var stored map[string]results.Result
+	if err := json.Unmarshal(data, &stored); err != nil {
+		return nil, fmt.Errorf("failed to parse restore results: %w", err)
+	}
+
+	restoreResults := &types.RestoreResults{
+		RestoreName: restoreName,
+		BackupName:  restore.Spec.BackupName,
+		Phase:       string(restore.Status.Phase),
+		Warnings:    stored["warnings"],
+		Errors:      stored["errors"],
+	}
+	restoreResults.WarningCount = countVeleroResult(restoreResults.Warnings)
+	restoreResults.ErrorCount = countVeleroResult(restoreResults.Errors)
+	return restoreResults, nil
+}
+
+// getFinishedRestore : 로그/결과가 업로드된 복원인지 확인
+func (s *Service) getFinishedRestore(client client.Client, ctx context.Context, namespace, restoreName string) (*velerov1.Restore, error) {
+	restore, err := client.Velero().GetRestore(ctx, namespace, restoreName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get restore '%s': %w", restoreName, err)
+	}
+	switch restore.Status.Phase {
+	case velerov1.RestorePhaseNew, velerov1.RestorePhaseInProgress, velerov1.RestorePhaseFailedValidation:
+		return nil, fmt.Errorf("logs and results for restore '%s' are not available (phase: %s)", restoreName, restore.Status.Phase)
+	}
+	return restore, nil
+}
+
+// downloadVeleroObject : DownloadRequest를 생성하여 서명된 URL로 파일을 내려받고 gzip 압축 해제
+func (s *Service) downloadVeleroObject(client client.Client, ctx context.Context, namespace string, kind velerov1.DownloadTargetKind, name string, insecureSkipTLSVerify bool) ([]byte, error) {
+	// 이름 충돌을 피하기 위해 GenerateName 사용 (접두사는 63자 제한을 고려해 자름)
+	prefix := name
+	if len(prefix) > 56 {
+		prefix = prefix[:56]
+	}
+	request := &velerov1.DownloadRequest{
+		ObjectMeta: metav1.ObjectMeta{GenerateName: prefix + "-"},
+		Spec: velerov1.DownloadRequestSpec{
+			Target: velerov1.DownloadTarget{Kind: kind, Name: name},
+		},
+	}
+	if err := client.Velero().CreateDownloadRequest(ctx, namespace, request); err != nil {
+		return nil, fmt.Errorf("failed to create download request: %w", err)
+	}
+	defer func() {
+		// 만료된 요청은 Velero가 정리하지만 바로 삭제
+		cleanupCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = client.Velero().DeleteDownloadRequest(cleanupCtx, namespace, request.Name)
+	}()
+
+	downloadURL, err := waitForDownloadURL(client, ctx, namespace, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	httpRequest, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("invalid download URL: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecureSkipTLSVerify {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec
+	}
+	resp, err := (&http.Client{Transport: transport}).Do(httpRequest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download %s: %w", kind, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		if resp.StatusCode == http.StatusNotFound {
+			return nil, fmt.Errorf("%s for '%s' not found in object storage", kind, name)
+		}
+		return nil, fmt.Errorf("failed to download %s: status %d: %s", kind, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	gzipReader, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", kind, err)
+	}
+	defer gzipReader.Close()
+
+	data, err := io.ReadAll(io.LimitReader(gzipReader, maxVeleroDownloadSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress %s: %w", kind, err)
+	}
+	if len(data) > maxVeleroDownloadSize {
+		return nil, fmt.Errorf("%s for '%s' exceeds %d bytes", kind, name, maxVeleroDownloadSize)
+	}
+	return data, nil
+}
+
+// waitForDownloadURL : DownloadRequest가 처리되어 서명된 URL이 설정될 때까지 대기
+func waitForDownloadURL(client client.Client, ctx context.Context, namespace, name string) (string, error) {
+	ticker := time.NewTicker(downloadRequestPollInterval)
+	defer ticker.Stop()
+
+	for {
+		request, err := client.Velero().GetDownloadRequest(ctx, namespace, name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get download request: %w", err)
+		}
+		if err == nil && request.Status.Phase == velerov1.DownloadRequestPhaseProcessed && request.Status.DownloadURL != "" {
+			return request.Status.DownloadURL, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return "", fmt.Errorf("timed out waiting for download request '%s' to be processed", name)
+		case <-ticker.C:
+		}
+	}
+}
+
+// parseVeleroLogs : Velero 로그(logrus text 형식)의 레벨별 줄 수 집계 및 레벨 필터링
+func parseVeleroLogs(name, kind string, data []byte, level string) (*types.VeleroLogs, error) {
+	minRank := -1
+	if level != "" {
+		rank, ok := veleroLogLevels[strings.ToLower(level)]
+		if !ok {
+			return nil, fmt.Errorf("unsupported log level '%s'", level)
+		}
+		minRank = rank
+	}
+
+	logs := &types.VeleroLogs{Name: name, Kind: kind, Lines: make([]string, 0)}
+	for _, line := range strings.Split(strings.TrimRight(string(data), "\n"), "\n") {
+		if line == "" {
+			continue
+		}
+		logs.Total++
+
+		lineLevel := veleroLogLineLevel(line)
+		switch lineLevel {
+		case "warning":
+			logs.Warnings++
+		case "error", "fatal", "panic":
+			logs.Errors++
+		}
+
+		if minRank >= 0 {
+			rank, ok := veleroLogLevels[lineLevel]
+			if !ok || rank < minRank {
+				continue
+			}
+		}
+		logs.Lines = append(logs.Lines, line)
+	}
+	return logs, nil
+}
+
+// veleroLogLineLevel : 로그 줄의 level 값 추출 (없으면 빈 문자열)
+func veleroLogLineLevel(line string) string {
+	index := strings.Index(line, "level=")
+	if index < 0 {
+		return ""
+	}
+	value := line[index+len("level="):]
+	if end := strings.IndexByte(value, ' '); end >= 0 {
+		value = value[:end]
+	}
+	return strings.Trim(value, `"`)
+}
+
+// countVeleroResult : Result에 포함된 메시지 수
+func countVeleroResult(result results.Result) int {
+	count := len(result.Velero) + len(result.Cluster)
+	for _, messages := range result.Namespaces {
+		count += len(messages)
+	}
+	return count
+}
+
+// ===== 복원 dry-run 관련 =====
+
+// nonRestorableResources : Velero가 복원하지 않는 리소스
+var nonRestorableResources = map[string]bool{
+	"nodes":                            true,
+	"events":                           true,
+	"events.events.k8s.io":             true,
+	"backups.velero.io":                true,
+	"restores.velero.io":               true,
+	"resticrepositories.velero.io":     true,
+	"backuprepositories.velero.io":     true,
+	"csinodes.storage.k8s.io":          true,
+	"volumeattachments.storage.k8s.io": true,
+}
+
+// PreviewRestoreInternal : 복원을 생성하지 않고 복원될 객체, 매핑 결과, 대상 클러스터와의 충돌 계산
+func (s *Service) PreviewRestoreInternal(client client.Client, ctx context.Context, req types.VeleroRestoreRequest) (*types.RestorePreview, error) {
+	restore, err := BuildRestore(req.Restore)
+	if err != nil {
+		return nil, err
+	}
+
+	contents, err := s.GetBackupContentsInternal(client, ctx, restore.Namespace, req.Restore.BackupName, BackupContentsOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// 네임스페이스/리소스 필터를 먼저 적용하고 후보 객체의 매니페스트만 읽음
+	candidates := *contents
+	candidates.Items = restoreCandidates(req.Restore, contents.Items)
+	manifests, err := s.readBackupManifests(client, ctx, &candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := planRestoreItems(restore, req.Restore.StorageClassMappings, candidates.Items, manifests)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &types.RestorePreview{
+		RestoreName: req.Restore.Name,
+		BackupName:  req.Restore.BackupName,
+		Items:       items,
+		Warnings:    checkRestoreTargets(client, ctx, items, restore.Spec.ExistingResourcePolicy),
+	}
+
+	preview.Summary.BackupItems = len(contents.Items)
+	preview.Summary.Restored = len(items)
+	preview.Summary.Excluded = len(contents.Items) - len(items)
+	for _, item := range items {
+		switch item.Action {
+		case "create":
+			preview.Summary.Create++
+		case "update":
+			preview.Summary.Update++
+		case "skip":
+			preview.Summary.Skip++
+		}
+		if item.Exists && item.Resource != "namespaces" {
+			preview.Summary.Conflicts++
+		}
+	}
+	return preview, nil
+}
+
+// restoreCandidates : 네임스페이스/리소스/클러스터 범위 필터 적용 (매니페스트 불필요)
+func restoreCandidates(req types.RestoreRequest, items []types.BackupContentItem) []types.BackupContentItem {
+	candidates := make([]types.BackupContentItem, 0, len(items))
+	for _, item := range items {
+		if nonRestorableResources[item.Resource] {
+			continue
+		}
+		if !matchesRestoreResource(req.IncludeResources, req.ExcludeResources, item.Resource) {
+			continue
+		}
+
+		switch {
+		case item.Resource == "namespaces":
+			// 네임스페이스는 포함된 네임스페이스이면 클러스터 리소스 설정과 관계없이 복원
+			if !matchesRestoreNamespace(req.IncludeNamespaces, req.ExcludeNamespaces, item.Name) {
+				continue
+			}
+		case item.Namespace == "":
+			if !req.IncludeClusterResources {
+				continue
+			}
+		default:
+			if !matchesRestoreNamespace(req.IncludeNamespaces, req.ExcludeNamespaces, item.Namespace) {
+				continue
+			}
+		}
+		candidates = append(candidates, item)
+	}
+	return candidates
+}
+
+// planRestoreItems : 레이블 선택자와 네임스페이스/StorageClass 매핑 적용
+func planRestoreItems(restore *velerov1.Restore, storageClassMappings map[string]string, candidates []types.BackupContentItem, manifests map[string]map[string]interface{}) ([]types.RestorePreviewItem, error) {
+	selector := labels.Everything()
+	if restore.Spec.LabelSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(restore.Spec.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+	}
+
+	items := make([]types.RestorePreviewItem, 0, len(candidates))
+	for _, candidate := range candidates {
+		obj := &unstructured.Unstructured{Object: manifests[candidate.Path]}
+		if obj.Object == nil {
+			obj.Object = map[string]interface{}{}
+		}
+
+		// 네임스페이스는 레이블 선택자와 관계없이 포함된 객체를 위해 복원됨
+		if candidate.Resource != "namespaces" && !selector.Matches(labels.Set(obj.GetLabels())) {
+			continue
+		}
+
+		item := types.RestorePreviewItem{
+			Resource:  candidate.Resource,
+			Namespace: candidate.Namespace,
+			Name:      candidate.Name,
+		}
+		if candidate.Namespace != "" {
+			item.TargetNamespace = mappedName(restore.Spec.NamespaceMapping, candidate.Namespace)
+		}
+		if candidate.Resource == "namespaces" {
+			item.TargetName = mappedName(restore.Spec.NamespaceMapping, candidate.Name)
+		}
+
+		if candidate.Resource == "persistentvolumeclaims" || candidate.Resource == "persistentvolumes" {
+			storageClass, _, _ := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+			if storageClass != "" {
+				item.StorageClass = storageClass
+				item.TargetStorageClass = mappedName(storageClassMappings, storageClass)
+			}
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+// checkRestoreTargets : 대상 클러스터의 기존 객체와 StorageClass를 확인하여 Action/Exists 설정 (확인 실패는 경고로 반환)
+func checkRestoreTargets(client client.Client, ctx context.Context, items []types.RestorePreviewItem, policy velerov1.PolicyType) []string {
+	var warnings []string
+
+	// 리소스/대상 네임스페이스별로 한 번씩 목록 조회
+	existing := make(map[string]map[string]bool)
+	unavailable := make(map[string]bool)
+	for i := range items {
+		item := &items[i]
+		name := item.Name
+		if item.TargetName != "" {
+			name = item.TargetName
+		}
+
+		groupKey := item.Resource + "/" + item.TargetNamespace
+		if _, listed := existing[groupKey]; !listed && !unavailable[groupKey] {
+			names, err := listTargetObjectNames(client, ctx, item.Resource, item.TargetNamespace)
+			if err != nil {
+				unavailable[groupKey] = true
+				if errors.Is(err, kubernetes.ErrUnsupportedResourceKind) {
+					warnings = append(warnings, fmt.Sprintf("resource %s is not served by the target cluster", item.Resource))
+				} else {
+					warnings = append(warnings, fmt.Sprintf("failed to check existing %s in '%s': %v", item.Resource, item.TargetNamespace, err))
+				}
+			} else {
+				existing[groupKey] = names
+			}
+		}
+
+		item.Action = "create"
+		if unavailable[groupKey] {
+			item.Message = "existing objects could not be checked"
+			continue
+		}
+		if !existing[groupKey][name] {
+			continue
+		}
+
+		item.Exists = true
+		switch {
+		case item.Resource == "namespaces":
+			item.Action = "skip"
+			item.Message = "namespace already exists; objects are restored into it"
+		case policy == velerov1.PolicyTypeUpdate:
+			item.Action = "update"
+			item.Message = "already exists; Velero will try to update it (existingResourcePolicy=update)"
+		default:
+			item.Action = "skip"
+			item.Message = "already exists; Velero keeps the existing object"
+		}
+	}
+
+	// StorageClass 매핑 대상 존재 여부 확인
+	storageClasses := make(map[string]bool)
+	for _, item := range items {
+		if item.TargetStorageClass != "" {
+			storageClasses[item.TargetStorageClass] = false
+		}
+	}
+	if len(storageClasses) == 0 {
+		return warnings
+	}
+
+	result, err := client.Kubernetes().GetStorageClasses(ctx, "")
+	if err != nil {
+		return append(warnings, fmt.Sprintf("failed to check storage classes: %v", err))
+	}
+	if list, ok := result.(*storagev1.StorageClassList); ok {
+		for _, storageClass := range list.Items {
+			if _, used := storageClasses[storageClass.Name]; used {
+				storageClasses[storageClass.Name] = true
+			}
+		}
+	}
+	for i := range items {
+		item := &items[i]
+		if item.TargetStorageClass != "" && !storageClasses[item.TargetStorageClass] {
+			item.Message = strings.TrimPrefix(item.Message+"; storage class '"+item.TargetStorageClass+"' does not exist in the target cluster", "; ")
+		}
+	}
+	var missing []string
+	for name, found := range storageClasses {
+		if !found {
+			missing = append(missing, name)
+		}
+	}
+	sort.Strings(missing)
+	for _, name := range missing {
+		warnings = append(warnings, fmt.Sprintf("storage class '%s' does not exist in the target cluster", name))
+	}
+	return warnings
+}
+
+// listTargetObjectNames : 대상 클러스터의 리소스 이름 목록 조회
+func listTargetObjectNames(client client.Client, ctx context.Context, resource, namespace string) (map[string]bool, error) {
+	names := make(map[string]bool)
+	query := kubernetes.ResourceQuery{Limit: 500}
+	for {
+		result, err := client.Kubernetes().GetResources(ctx, resource, namespace, "", query)
+		if err != nil {
+			return nil, err
+		}
+		list, ok := result.(*unstructured.UnstructuredList)
+		if !ok {
+			return nil, fmt.Errorf("unexpected list type %T", result)
+		}
+		for _, obj := range list.Items {
+			names[obj.GetName()] = true
+		}
+		if list.GetContinue() == "" {
+			return names, nil
+		}
+		query.Continue = list.GetContinue()
+	}
+}
+
+// matchesRestoreNamespace : 포함/제외 네임스페이스 조건 확인 (glob 패턴 지원, 포함 목록이 비어 있으면 전체)
+func matchesRestoreNamespace(includes, excludes []string, namespace string) bool {
+	for _, pattern := range excludes {
+		if matched, _ := path.Match(pattern, namespace); matched {
+			return false
+		}
+	}
+	if len(includes) == 0 {
+		return true
+	}
+	for _, pattern := range includes {
+		if matched, _ := path.Match(pattern, namespace); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesRestoreResource : 포함/제외 리소스 조건 확인 ("deployments" 또는 "deployments.apps" 형식)
+func matchesRestoreResource(includes, excludes []string, resource string) bool {
+	matches := func(pattern string) bool {
+		pattern = strings.ToLower(pattern)
+		return pattern == "*" || pattern == resource || pattern == strings.SplitN(resource, ".", 2)[0]
+	}
+	for _, pattern := range excludes {
+		if matches(pattern) {
+			return false
+		}
+	}
+	if len(includes) == 0 {
+		return true
+	}
+	for _, pattern := range includes {
+		if matches(pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// mappedName : 매핑이 있으면 매핑된 이름 반환
+func mappedName(mappings map[string]string, name string) string {
+	if mapped, ok := mappings[name]; ok && mapped != "" {
+		return mapped
+	}
+	return name
+}
+
+// ===== StorageLocation 관련 =====
+
+// 지원하는 오브젝트 스토리지/스냅샷 provider (aws는 S3 호환 스토리지 포함)
+var supportedStorageProviders = map[string]bool{
+	"aws":   true,
+	"gcp":   true,
+	"azure": true,
+}
+
+// 자격 증명 Secret 기본 key (Velero 플러그인 기본 값)
+const defaultStorageCredentialKey = "cloud"
+
+// normalizeStorageProvider : provider 이름 정규화 ("velero.io/aws" → "aws")
+func normalizeStorageProvider(provider string) string {
+	return strings.TrimPrefix(strings.ToLower(strings.TrimSpace(provider)), "velero.io/")
+}
+
+// ValidateBackupStorageLocationRequest : BSL 요청 검증 (이름, provider별 필수 설정, 접근 모드, 주기)
+func (s *Service) ValidateBackupStorageLocationRequest(req types.BackupStorageLocationRequest) error {
+	if errs := validation.IsDNS1123Subdomain(req.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid storage location name '%s': %s", req.Name, strings.Join(errs, ", "))
+	}
+
+	provider := normalizeStorageProvider(req.Provider)
+	if !supportedStorageProviders[provider] {
+		return fmt.Errorf("unsupported provider '%s': must be one of aws, gcp, azure", req.Provider)
+	}
+
+	if req.Bucket == "" {
+		return fmt.Errorf("bucket is required")
+	}
+	if strings.Contains(req.Bucket, "/") {
+		return fmt.Errorf("invalid bucket '%s': use prefix for sub-paths", req.Bucket)
+	}
+	if strings.HasPrefix(req.Prefix, "/") || strings.HasSuffix(req.Prefix, "/") {
+		return fmt.Errorf("invalid prefix '%s': must not start or end with '/'", req.Prefix)
+	}
+
+	switch provider {
+	case "aws":
+		// S3 호환 스토리지(MinIO, Ceph 등)는 region 없이 서명할 수 없음
+		if req.Config["s3Url"] != "" && req.Config["region"] == "" {
+			return fmt.Errorf("config.region is required when config.s3Url is set")
+		}
+	case "azure":
+		if req.Config["storageAccount"] == "" {
+			return fmt.Errorf("config.storageAccount is required for azure provider")
+		}
+	}
+
+	switch velerov1.BackupStorageLocationAccessMode(req.AccessMode) {
+	case "", velerov1.BackupStorageLocationAccessModeReadWrite, velerov1.BackupStorageLocationAccessModeReadOnly:
+	default:
+		return fmt.Errorf("invalid accessMode '%s': must be ReadWrite or ReadOnly", req.AccessMode)
+	}
+
+	if req.BackupSyncPeriod != "" {
+		if _, err := time.ParseDuration(req.BackupSyncPeriod); err != nil {
+			return fmt.Errorf("invalid backupSyncPeriod '%s': %w", req.BackupSyncPeriod, err)
+		}
+	}
+	if req.ValidationFrequency != "" {
+		if _, err := time.ParseDuration(req.ValidationFrequency); err != nil {
+			return fmt.Errorf("invalid validationFrequency '%s': %w", req.ValidationFrequency, err)
+		}
+	}
+
+	if req.CACert != "" && !strings.Contains(req.CACert, "-----BEGIN CERTIFICATE-----") {
+		return fmt.Errorf("caCert must be a PEM encoded certificate bundle")
+	}
+
+	return validateStorageCredential(req.Credential)
+}
+
+// ValidateVolumeSnapshotLocationRequest : VSL 요청 검증 (이름, provider별 필수 설정)
+func (s *Service) ValidateVolumeSnapshotLocationRequest(req types.VolumeSnapshotLocationRequest) error {
+	if errs := validation.IsDNS1123Subdomain(req.Name); len(errs) > 0 {
+		return fmt.Errorf("invalid snapshot location name '%s': %s", req.Name, strings.Join(errs, ", "))
+	}
+
+	provider := normalizeStorageProvider(req.Provider)
+	if !supportedStorageProviders[provider] {
+		return fmt.Errorf("unsupported provider '%s': must be one of aws, gcp, azure", req.Provider)
+	}
+	if provider == "aws" && req.Config["region"] == "" {
+		return fmt.Errorf("config.region is required for aws provider")
+	}
+
+	return validateStorageCredential(req.Credential)
+}
+
+// validateStorageCredential : 자격 증명 Secret 이름/key 검증
+func validateStorageCredential(credential *types.StorageCredential) error {
+	if credential == nil {
+		return nil
+	}
+	if credential.SecretName != "" {
+		if errs := validation.IsDNS1123Subdomain(credential.SecretName); len(errs) > 0 {
+			return fmt.Errorf("invalid credential secretName '%s': %s", credential.SecretName, strings.Join(errs, ", "))
+		}
+	}
+	if credential.Key != "" {
+		if errs := validation.IsConfigMapKey(credential.Key); len(errs) > 0 {
+			return fmt.Errorf("invalid credential key '%s': %s", credential.Key, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+// GetBackupStorageLocationInternal : BackupStorageLocation 상세 조회
+func (s *Service) GetBackupStorageLocationInternal(client client.Client, ctx context.Context, namespace, name string) (*velerov1.BackupStorageLocation, error) {
+	bsl, err := client.Velero().GetBackupStorageLocation(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backup storage location '%s': %w", name, err)
+	}
+
+	bsl.ObjectMeta.ManagedFields = nil
+	return bsl, nil
+}
+
+// CreateBackupStorageLocationInternal : BackupStorageLocation 생성 (자격 증명 Secret 포함)
+func (s *Service) CreateBackupStorageLocationInternal(client client.Client, ctx context.Context, req types.BackupStorageLocationRequest, namespace string) (*velerov1.BackupStorageLocation, error) {
+	if err := s.ValidateBackupStorageLocationRequest(req); err != nil {
+		return nil, err
+	}
+
+	credential, err := s.applyStorageCredential(ctx, client, namespace, req.Name, req.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	bsl, err := buildBackupStorageLocation(req, namespace, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	// 새 BSL이 default이면 기존 default 해제 (Velero는 default BSL을 하나만 허용)
+	if req.Default {
+		if err := s.unsetDefaultBackupStorageLocations(ctx, client, namespace, req.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := client.Velero().CreateBackupStorageLocation(ctx, namespace, bsl); err != nil {
+		return nil, fmt.Errorf("failed to create backup storage location: %w", err)
+	}
+
+	bsl.ObjectMeta.ManagedFields = nil
+	return bsl, nil
+}
+
+// UpdateBackupStorageLocationInternal : BackupStorageLocation 수정 (스펙 교체)
+func (s *Service) UpdateBackupStorageLocationInternal(client client.Client, ctx context.Context, name string, req types.BackupStorageLocationRequest, namespace string) (*velerov1.BackupStorageLocation, error) {
+	if req.Name == "" {
+		req.Name = name
+	}
+	if req.Name != name {
+		return nil, fmt.Errorf("storage location name '%s' does not match path '%s'", req.Name, name)
+	}
+	if err := s.ValidateBackupStorageLocationRequest(req); err != nil {
+		return nil, err
+	}
+
+	bsl, err := client.Velero().GetBackupStorageLocation(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backup storage location '%s': %w", name, err)
+	}
+
+	// 새 자격 증명이 없으면 기존 Secret 참조 유지
+	credential := bsl.Spec.Credential
+	if req.Credential != nil {
+		if credential, err = s.applyStorageCredential(ctx, client, namespace, name, req.Credential); err != nil {
+			return nil, err
+		}
+	}
+
+	desired, err := buildBackupStorageLocation(req, namespace, credential)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Default && !bsl.Spec.Default {
+		if err := s.unsetDefaultBackupStorageLocations(ctx, client, namespace, name); err != nil {
+			return nil, err
+		}
+	}
+
+	bsl.Spec = desired.Spec
+	if err := client.Velero().UpdateBackupStorageLocation(ctx, namespace, bsl); err != nil {
+		return nil, fmt.Errorf("failed to update backup storage location: %w", err)
+	}
+
+	bsl.ObjectMeta.ManagedFields = nil
+	return bsl, nil
+}
+
+// DeleteBackupStorageLocationInternal : BackupStorageLocation 삭제 (버킷의 백업 데이터와 자격 증명 Secret은 유지)
+func (s *Service) DeleteBackupStorageLocationInternal(client client.Client, ctx context.Context, namespace, name string) (interface{}, error) {
+	if err := client.Velero().DeleteBackupStorageLocation(ctx, namespace, name); err != nil {
+		return nil, fmt.Errorf("failed to delete backup storage location: %w", err)
+	}
+
+	return map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+		"status":    "deleted",
+		"message":   "Backup storage location deleted successfully",
+		"deletedAt": time.Now(),
+	}, nil
+}
+
+// SetDefaultBackupStorageLocationInternal : default BSL 전환 (다른 BSL의 default 해제)
+func (s *Service) SetDefaultBackupStorageLocationInternal(client client.Client, ctx context.Context, namespace, name string) (*velerov1.BackupStorageLocation, error) {
+	bsl, err := client.Velero().GetBackupStorageLocation(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backup storage location '%s': %w", name, err)
+	}
+
+	if err := s.unsetDefaultBackupStorageLocations(ctx, client, namespace, name); err != nil {
+		return nil, err
+	}
+
+	if !bsl.Spec.Default {
+		bsl.Spec.Default = true
+		if err := client.Velero().UpdateBackupStorageLocation(ctx, namespace, bsl); err != nil {
+			return nil, fmt.Errorf("failed to set default backup storage location: %w", err)
+		}
+	}
+
+	bsl.ObjectMeta.ManagedFields = nil
+	return bsl, nil
+}
+
+// SetBackupStorageLocationAccessModeInternal : BSL 접근 모드 변경 (대상 클러스터는 ReadOnly로 두어 백업 덮어쓰기 방지)
+func (s *Service) SetBackupStorageLocationAccessModeInternal(client client.Client, ctx context.Context, namespace, name, accessMode string) (*velerov1.BackupStorageLocation, error) {
+	mode := velerov1.BackupStorageLocationAccessMode(accessMode)
+	if mode != velerov1.BackupStorageLocationAccessModeReadWrite && mode != velerov1.BackupStorageLocationAccessModeReadOnly {
+		return nil, fmt.Errorf("invalid accessMode '%s': must be ReadWrite or ReadOnly", accessMode)
+	}
+
+	bsl, err := client.Velero().GetBackupStorageLocation(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get backup storage location '%s': %w", name, err)
+	}
+
+	if bsl.Spec.AccessMode != mode {
+		bsl.Spec.AccessMode = mode
+		if err := client.Velero().UpdateBackupStorageLocation(ctx, namespace, bsl); err != nil {
+			return nil, fmt.Errorf("failed to update access mode: %w", err)
+		}
+	}
+
+	bsl.ObjectMeta.ManagedFields = nil
+	return bsl, nil
+}
+
+// GetVolumeSnapshotLocationInternal : VolumeSnapshotLocation 상세 조회
+func (s *Service) GetVolumeSnapshotLocationInternal(client client.Client, ctx context.Context, namespace, name string) (*velerov1.VolumeSnapshotLocation, error) {
+	vsl, err := client.Velero().GetVolumeSnapshotLocation(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume snapshot location '%s': %w", name, err)
+	}
+
+	vsl.ObjectMeta.ManagedFields = nil
+	return vsl, nil
+}
+
+// CreateVolumeSnapshotLocationInternal : VolumeSnapshotLocation 생성 (자격 증명 Secret 포함)
+func (s *Service) CreateVolumeSnapshotLocationInternal(client client.Client, ctx context.Context, req types.VolumeSnapshotLocationRequest, namespace string) (*velerov1.VolumeSnapshotLocation, error) {
+	if err := s.ValidateVolumeSnapshotLocationRequest(req); err != nil {
+		return nil, err
+	}
+
+	credential, err := s.applyStorageCredential(ctx, client, namespace, req.Name, req.Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	vsl := buildVolumeSnapshotLocation(req, namespace, credential)
+	if err := client.Velero().CreateVolumeSnapshotLocation(ctx, namespace, vsl); err != nil {
+		return nil, fmt.Errorf("failed to create volume snapshot location: %w", err)
+	}
+
+	vsl.ObjectMeta.ManagedFields = nil
+	return vsl, nil
+}
+
+// UpdateVolumeSnapshotLocationInternal : VolumeSnapshotLocation 수정 (스펙 교체)
+func (s *Service) UpdateVolumeSnapshotLocationInternal(client client.Client, ctx context.Context, name string, req types.VolumeSnapshotLocationRequest, namespace string) (*velerov1.VolumeSnapshotLocation, error) {
+	if req.Name == "" {
+		req.Name = name
+	}
+	if req.Name != name {
+		return nil, fmt.Errorf("snapshot location name '%s' does not match path '%s'", req.Name, name)
+	}
+	if err := s.ValidateVolumeSnapshotLocationRequest(req); err != nil {
+		return nil, err
+	}
+
+	vsl, err := client.Velero().GetVolumeSnapshotLocation(ctx, namespace, name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get volume snapshot location '%s': %w", name, err)
+	}
+
+	credential := vsl.Spec.Credential
+	if req.Credential != nil {
+		if credential, err = s.applyStorageCredential(ctx, client, namespace, name, req.Credential); err != nil {
+			return nil, err
+		}
+	}
+
+	vsl.Spec = buildVolumeSnapshotLocation(req, namespace, credential).Spec
+	if err := client.Velero().UpdateVolumeSnapshotLocation(ctx, namespace, vsl); err != nil {
+		return nil, fmt.Errorf("failed to update volume snapshot location: %w", err)
+	}
+
+	vsl.ObjectMeta.ManagedFields = nil
+	return vsl, nil
+}
+
+// DeleteVolumeSnapshotLocationInternal : VolumeSnapshotLocation 삭제 (자격 증명 Secret은 유지)
+func (s *Service) DeleteVolumeSnapshotLocationInternal(client client.Client, ctx context.Context, namespace, name string) (interface{}, error) {
+	if err := client.Velero().DeleteVolumeSnapshotLocation(ctx, namespace, name); err != nil {
+		return nil, fmt.Errorf("failed to delete volume snapshot location: %w", err)
+	}
+
+	return map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+		"status":    "deleted",
+		"message":   "Volume snapshot location deleted successfully",
+		"deletedAt": time.Now(),
+	}, nil
+}
+
+// unsetDefaultBackupStorageLocations : 지정한 BSL을 제외한 모든 BSL의 default 해제
+func (s *Service) unsetDefaultBackupStorageLocations(ctx context.Context, client client.Client, namespace, keep string) error {
+	bsls, err := client.Velero().GetBackupStorageLocations(ctx, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to list backup storage locations: %w", err)
+	}
+
+	for i := range bsls {
+		bsl := &bsls[i]
+		if bsl.Name == keep || !bsl.Spec.Default {
+			continue
+		}
+		bsl.Spec.Default = false
+		if err := client.Velero().UpdateBackupStorageLocation(ctx, namespace, bsl); err != nil {
+			return fmt.Errorf("failed to unset default on backup storage location '%s': %w", bsl.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applyStorageCredential : 자격 증명 Secret 생성/교체 후 SecretKeySelector 반환 (자격 증명 미지정 시 nil → Velero 기본 자격 증명 사용)
+func (s *Service) applyStorageCredential(
+	ctx context.Context,
+	client client.Client,
+	namespace, locationName string,
+	credential *types.StorageCredential,
+) (*v1.SecretKeySelector, error) {
+	if credential == nil {
+		return nil, nil
+	}
+
+	secretName := credential.SecretName
+	if secretName == "" {
+		secretName = fmt.Sprintf("%s-credentials", locationName)
+	}
+	key := credential.Key
+	if key == "" {
+		key = defaultStorageCredentialKey
+	}
+
+	// 자격 증명 내용이 있으면 Secret 교체, 없으면 기존 Secret 참조만 설정
+	if credential.Data != "" {
+		if err := client.Kubernetes().DeleteSecret(ctx, namespace, secretName); err != nil && !apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("failed to replace credential secret '%s': %w", secretName, err)
+		}
+		if _, err := client.Kubernetes().CreateSecret(ctx, namespace, secretName, map[string]string{key: credential.Data}); err != nil {
+			return nil, fmt.Errorf("failed to create credential secret '%s': %w", secretName, err)
+		}
+	}
+
+	return &v1.SecretKeySelector{
+		LocalObjectReference: v1.LocalObjectReference{Name: secretName},
+		Key:                  key,
+	}, nil
+}
+
+// buildBackupStorageLocation : BSL 요청을 Velero BackupStorageLocation으로 변환
+func buildBackupStorageLocation(req types.BackupStorageLocationRequest, namespace string, credential *v1.SecretKeySelector) (*velerov1.BackupStorageLocation, error) {
+	objectStorage := &velerov1.ObjectStorageLocation{
+		Bucket: req.Bucket,
+		Prefix: req.Prefix,
+	}
+	if req.CACert != "" {
+		objectStorage.CACert = []byte(req.CACert)
+	}
+
+	spec := velerov1.BackupStorageLocationSpec{
+		Provider:    req.Provider,
+		Config:      req.Config,
+		Credential:  credential,
+		Default:     req.Default,
+		AccessMode:  velerov1.BackupStorageLocationAccessMode(req.AccessMode),
+		StorageType: velerov1.StorageType{ObjectStorage: objectStorage},
+	}
+	if spec.AccessMode == "" {
+		spec.AccessMode = velerov1.BackupStorageLocationAccessModeReadWrite
+	}
+
+	if req.BackupSyncPeriod != "" {
+		period, err := time.ParseDuration(req.BackupSyncPeriod)
+		if err != nil {
+			return nil, fmt.Errorf("invalid backupSyncPeriod '%s': %w", req.BackupSyncPeriod, err)
+		}
+		spec.BackupSyncPeriod = &metav1.Duration{Duration: period}
+	}
+	if req.ValidationFrequency != "" {
+		frequency, err := time.ParseDuration(req.ValidationFrequency)
+		if err != nil {
+			return nil, fmt.Errorf("invalid validationFrequency '%s': %w", req.ValidationFrequency, err)
+		}
+		spec.ValidationFrequency = &metav1.Duration{Duration: frequency}
+	}
+
+	return &velerov1.BackupStorageLocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: namespace,
+		},
+		Spec: spec,
+	}, nil
+}
+
+// buildVolumeSnapshotLocation : VSL 요청을 Velero VolumeSnapshotLocation으로 변환
+func buildVolumeSnapshotLocation(req types.VolumeSnapshotLocationRequest, namespace string, credential *v1.SecretKeySelector) *velerov1.VolumeSnapshotLocation {
+	return &velerov1.VolumeSnapshotLocation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      req.Name,
+			Namespace: namespace,
+		},
+		Spec: velerov1.VolumeSnapshotLocationSpec{
+			Provider:   req.Provider,
+			Config:     req.Config,
+			Credential: credential,
+		},
+	}
+}
+
+// ===== managedFields 제거 헬퍼 함수들 =====
+
+// removeManagedFieldsFromBackups : Backup 목록에서 managedFields 제거
+func removeManagedFieldsFromBackups(backups []velerov1.Backup) {
+	for i := range backups {
+		backups[i].ObjectMeta.ManagedFields = nil
+	}
+}
+
+// removeManagedFieldsFromSchedules : Schedule 목록에서 managedFields 제거
+func removeManagedFieldsFromSchedules(schedules []velerov1.Schedule) {
+	for i := range schedules {
+		schedules[i].ObjectMeta.ManagedFields = nil
 	}
 }
 
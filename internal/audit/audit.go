@@ -0,0 +1,148 @@
+// Package audit 클러스터/스토리지를 변경하는 API 요청의 감사 로그를 관리합니다.
+package audit
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// ErrDisabled : 감사 로그가 비활성화된 경우 조회 에러
+var ErrDisabled = errors.New("audit log is disabled")
+
+// 요청 처리 결과
+const (
+	OutcomeSuccess = "success"
+	OutcomeFailure = "failure"
+)
+
+// 조회 건수 제한
+const (
+	DefaultQueryLimit = 100
+	MaxQueryLimit     = 1000
+)
+
+// Target : 요청이 대상으로 한 클러스터
+type Target struct {
+	Field       string `json:"field"`                 // 요청 내 위치 (예: "kubeconfig", "source.kubeconfig", "clusterId")
+	ClusterID   string `json:"clusterId,omitempty"`   // 등록된 클러스터 ID
+	Fingerprint string `json:"fingerprint,omitempty"` // kubeconfig SHA-256 앞 16자리
+	Server      string `json:"server,omitempty"`      // kubeconfig 현재 컨텍스트의 API 서버 주소
+}
+
+// Entry : 감사 로그 항목 (JSON lines 한 줄)
+type Entry struct {
+	ID         string                 `json:"id"`
+	Timestamp  time.Time              `json:"timestamp"`
+	RequestID  string                 `json:"requestId,omitempty"`
+	Subject    string                 `json:"subject"`              // 인증된 주체 (인증 실패 시 빈 값)
+	Role       string                 `json:"role,omitempty"`       // 주체 역할
+	AuthMethod string                 `json:"authMethod,omitempty"` // token, jwt, anonymous
+	ClientIP   string                 `json:"clientIp"`
+	Method     string                 `json:"method"`
+	Route      string                 `json:"route"` // 라우트 패턴 (예: /api/v1/velero/backups/:backupName)
+	Path       string                 `json:"path"`
+	Service    string                 `json:"service"` // velero, helm, minio, migrations 등
+	Targets    []Target               `json:"targets,omitempty"`
+	Params     map[string]interface{} `json:"params,omitempty"` // path/query/body 파라미터 (비밀 값 마스킹)
+	StatusCode int                    `json:"statusCode"`
+	Outcome    string                 `json:"outcome"`
+	Error      string                 `json:"error,omitempty"`
+	JobID      string                 `json:"jobId,omitempty"`
+	DurationMs int64                  `json:"durationMs"`
+}
+
+// Filter : 감사 로그 조회 조건 (빈 값은 조건에서 제외)
+type Filter struct {
+	Since   time.Time
+	Until   time.Time
+	Subject string
+	Service string
+	Method  string
+	Outcome string
+	JobID   string
+	Cluster string // clusterId, fingerprint 또는 server 일부
+	Limit   int
+}
+
+// Matches : 항목이 조회 조건에 맞는지 여부
+func (f Filter) Matches(entry Entry) bool {
+	if !f.Since.IsZero() && entry.Timestamp.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && entry.Timestamp.After(f.Until) {
+		return false
+	}
+	if f.Subject != "" && entry.Subject != f.Subject {
+		return false
+	}
+	if f.Service != "" && entry.Service != f.Service {
+		return false
+	}
+	if f.Method != "" && !strings.EqualFold(entry.Method, f.Method) {
+		return false
+	}
+	if f.Outcome != "" && entry.Outcome != f.Outcome {
+		return false
+	}
+	if f.JobID != "" && entry.JobID != f.JobID {
+		return false
+	}
+	if f.Cluster != "" {
+		matched := false
+		for _, target := range entry.Targets {
+			if target.ClusterID == f.Cluster || target.Fingerprint == f.Cluster ||
+				(target.Server != "" && strings.Contains(target.Server, f.Cluster)) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// limit : 유효한 조회 건수
+func (f Filter) limit() int {
+	switch {
+	case f.Limit <= 0:
+		return DefaultQueryLimit
+	case f.Limit > MaxQueryLimit:
+		return MaxQueryLimit
+	default:
+		return f.Limit
+	}
+}
+
+// Recorder : 감사 로그 기록/조회 인터페이스
+type Recorder interface {
+	Record(entry Entry) error
+	Query(filter Filter) ([]Entry, error)
+}
+
+// NewFromConfig : 설정으로 감사 로그 생성 (비활성화 시 nil 반환)
+func NewFromConfig(cfg config.AuditConfig) (Recorder, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+	log, err := NewFileLog(cfg.Path, int64(cfg.MaxSizeMB)*1024*1024, cfg.MaxBackups)
+	if err != nil {
+		return nil, err
+	}
+	return log, nil
+}
+
+// NewID : 감사 로그 항목 ID 생성
+func NewID() string {
+	buf := make([]byte, 8)
+	if _, err := rand.Read(buf); err != nil {
+		return time.Now().UTC().Format("20060102150405.000000000")
+	}
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,258 @@
+package audit
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFileLog_RecordAndQuery(t *testing.T) {
+	log, err := NewFileLog(filepath.Join(t.TempDir(), "audit", "audit.log"), 1024*1024, 2)
+	if err != nil {
+		t.Fatalf("NewFileLog() error = %v", err)
+	}
+	defer log.Close() //nolint:errcheck
+
+	base := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 5; i++ {
+		outcome := OutcomeSuccess
+		if i%2 == 1 {
+			outcome = OutcomeFailure
+		}
+		if err := log.Record(Entry{
+			ID:        fmt.Sprintf("entry-%d", i),
+			Timestamp: base.Add(time.Duration(i) * time.Minute),
+			Subject:   "ci",
+			Service:   "velero",
+			Outcome:   outcome,
+			JobID:     fmt.Sprintf("job-%d", i),
+			Targets:   []Target{{Field: "kubeconfig", Fingerprint: "abc123"}},
+		}); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	entries, err := log.Query(Filter{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(entries) != 5 || entries[0].ID != "entry-4" {
+		t.Fatalf("Expected 5 entries newest first, got %+v", entries)
+	}
+
+	tests := []struct {
+		name    string
+		filter  Filter
+		wantIDs []string
+	}{
+		{"outcome", Filter{Outcome: OutcomeFailure}, []string{"entry-3", "entry-1"}},
+		{"job id", Filter{JobID: "job-2"}, []string{"entry-2"}},
+		{"time range", Filter{Since: base.Add(time.Minute), Until: base.Add(2 * time.Minute)}, []string{"entry-2", "entry-1"}},
+		{"limit", Filter{Limit: 2}, []string{"entry-4", "entry-3"}},
+		{"cluster fingerprint", Filter{Cluster: "abc123", Limit: 1}, []string{"entry-4"}},
+		{"no match", Filter{Subject: "someone-else"}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries, err := log.Query(tt.filter)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			var ids []string
+			for _, entry := range entries {
+				ids = append(ids, entry.ID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("Expected %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+}
+
+func TestFileLog_Rotation(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	log, err := NewFileLog(path, 512, 2)
+	if err != nil {
+		t.Fatalf("NewFileLog() error = %v", err)
+	}
+	defer log.Close() //nolint:errcheck
+
+	for i := 0; i < 20; i++ {
+		if err := log.Record(Entry{ID: fmt.Sprintf("entry-%02d", i), Timestamp: time.Now(), Route: strings.Repeat("x", 100)}); err != nil {
+			t.Fatalf("Record() error = %v", err)
+		}
+	}
+
+	for _, name := range []string{path, path + ".1", path + ".2"} {
+		info, err := os.Stat(name)
+		if err != nil {
+			t.Fatalf("Expected %s to exist: %v", name, err)
+		}
+		if info.Size() > 512 {
+			t.Errorf("Expected %s to be rotated before exceeding 512 bytes, got %d", name, info.Size())
+		}
+		if info.Mode().Perm() != 0600 {
+			t.Errorf("Expected %s to have mode 0600, got %v", name, info.Mode().Perm())
+		}
+	}
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Errorf("Expected only 2 backups to be kept")
+	}
+
+	entries, err := log.Query(Filter{Limit: MaxQueryLimit})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(entries) == 0 || entries[0].ID != "entry-19" {
+		t.Errorf("Expected newest entry first across rotated files, got %d entries", len(entries))
+	}
+}
+
+func TestRedact(t *testing.T) {
+	kubeConfig := "apiVersion: v1\nkind: Config\nusers:\n- name: admin\n  user:\n    token: super-secret-token\n"
+	body := map[string]interface{}{
+		"kubeconfig": kubeConfig,
+		"minio": map[string]interface{}{
+			"endpoint":  "minio.example.com:9000",
+			"accessKey": "minioadmin",
+			"secretKey": "minioadmin123",
+		},
+		"values": map[string]interface{}{
+			"auth": map[string]interface{}{"rootPassword": "hunter2hunter2"},
+		},
+		"includeNamespaces": []interface{}{"app"},
+	}
+
+	redacted := Redact(body).(map[string]interface{})
+	minio := redacted["minio"].(map[string]interface{})
+	password := redacted["values"].(map[string]interface{})["auth"].(map[string]interface{})["rootPassword"]
+
+	if strings.Contains(redacted["kubeconfig"].(string), "super-secret-token") {
+		t.Errorf("kubeconfig was not masked: %v", redacted["kubeconfig"])
+	}
+	// kubeconfig 외 비밀 값은 앞/뒤 일부도 남기지 않음
+	if minio["secretKey"] != redactedValue || minio["accessKey"] != redactedValue || password != redactedValue {
+		t.Errorf("secrets were not fully masked: %+v %v", minio, password)
+	}
+	if minio["endpoint"] != "minio.example.com:9000" {
+		t.Errorf("non-secret values should be kept: %v", minio["endpoint"])
+	}
+	if body["minio"].(map[string]interface{})["secretKey"] != "minioadmin123" {
+		t.Error("Redact should not modify the original value")
+	}
+}
+
+func TestClusterTargets(t *testing.T) {
+	kubeConfig := `apiVersion: v1
+kind: Config
+clusters:
+- name: prod
+  cluster:
+    server: https://prod.example.com:6443
+contexts:
+- name: prod
+  context:
+    cluster: prod
+    user: admin
+current-context: prod
+users:
+- name: admin
+  user:
+    token: secret
+`
+	body := map[string]interface{}{
+		"source": map[string]interface{}{"kubeconfig": base64.StdEncoding.EncodeToString([]byte(kubeConfig))},
+		"target": map[string]interface{}{"kubeconfig": kubeConfig},
+	}
+
+	targets := ClusterTargets(body, "dr-cluster")
+	if len(targets) != 3 {
+		t.Fatalf("Expected 3 targets, got %+v", targets)
+	}
+	if targets[0].Field != "clusterId" || targets[0].ClusterID != "dr-cluster" {
+		t.Errorf("Unexpected clusterId target: %+v", targets[0])
+	}
+	source, target := targets[1], targets[2]
+	if source.Field != "source.kubeconfig" || target.Field != "target.kubeconfig" {
+		t.Errorf("Unexpected target fields: %+v", targets)
+	}
+	if source.Server != "https://prod.example.com:6443" || len(source.Fingerprint) != 16 {
+		t.Errorf("Unexpected kubeconfig target: %+v", source)
+	}
+	if source.Fingerprint != target.Fingerprint {
+		t.Errorf("Base64 and plain kubeconfig should share a fingerprint: %s != %s", source.Fingerprint, target.Fingerprint)
+	}
+}
+
+// TestRedact_NestedSecrets : 비밀 키 아래 객체/배열의 하위 값 전체 마스킹 테스트
+func TestRedact_NestedSecrets(t *testing.T) {
+	body := map[string]interface{}{
+		"kubeconfig": map[string]interface{}{"kubeconfig": "apiVersion: v1\nkind: Config\nusers: []\n"},
+		"location": map[string]interface{}{
+			"provider": "aws",
+			"credential": map[string]interface{}{
+				"secretName": "cloud-credentials",
+				"data":       "[default]\naws_secret_access_key=plain-secret",
+			},
+		},
+		"values": map[string]interface{}{
+			"credentials": map[string]interface{}{
+				"useSecret": true,
+				"secretContents": map[string]interface{}{
+					"cloud": "[default]\naws_secret_access_key=plain-secret",
+				},
+				"extraEnvVars": []interface{}{map[string]interface{}{"name": "AWS_KEY", "value": "plain-secret"}},
+			},
+		},
+	}
+
+	redacted := Redact(body).(map[string]interface{})
+	encoded, _ := json.Marshal(redacted)
+	if strings.Contains(string(encoded), "plain-secret") {
+		t.Fatalf("nested secrets were not masked: %s", encoded)
+	}
+
+	location := redacted["location"].(map[string]interface{})
+	credential := location["credential"].(map[string]interface{})
+	if credential["data"] != redactedValue {
+		t.Errorf("credential.data was not masked: %+v", credential)
+	}
+	if location["provider"] != "aws" {
+		t.Errorf("non-secret sibling should be kept: %v", location["provider"])
+	}
+	credentials := redacted["values"].(map[string]interface{})["credentials"].(map[string]interface{})
+	if credentials["secretContents"].(map[string]interface{})["cloud"] != redactedValue || credentials["useSecret"] != redactedValue {
+		t.Errorf("credentials subtree was not masked: %+v", credentials)
+	}
+
+	// 객체 형태의 kubeconfig도 kubeconfig 규칙으로 마스킹
+	kubeConfig := redacted["kubeconfig"].(map[string]interface{})["kubeconfig"].(string)
+	if !strings.Contains(kubeConfig, "...") {
+		t.Errorf("nested kubeconfig should keep the kubeconfig mask: %q", kubeConfig)
+	}
+}
+
+// TestClusterTargets_MigrationIDs : 마이그레이션 요청의 sourceClusterId/targetClusterId 수집 테스트
+func TestClusterTargets_MigrationIDs(t *testing.T) {
+	body := map[string]interface{}{
+		"sourceClusterId": "prod-a",
+		"targetClusterId": "dr-b",
+		"storageId":       "backup-minio",
+	}
+
+	targets := ClusterTargets(body, "")
+	if len(targets) != 2 {
+		t.Fatalf("Expected 2 targets, got %+v", targets)
+	}
+	if targets[0].Field != "sourceClusterId" || targets[0].ClusterID != "prod-a" {
+		t.Errorf("Unexpected source target: %+v", targets[0])
+	}
+	if targets[1].Field != "targetClusterId" || targets[1].ClusterID != "dr-b" {
+		t.Errorf("Unexpected target target: %+v", targets[1])
+	}
+}
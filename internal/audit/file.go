@@ -0,0 +1,193 @@
+package audit
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// 파일 회전 기본값
+const (
+	defaultMaxSize    = 100 * 1024 * 1024
+	defaultMaxBackups = 5
+	maxLineSize       = 4 * 1024 * 1024
+)
+
+// FileLog : 크기 기준으로 회전하는 JSON lines 파일 감사 로그
+// 회전 시 audit.log → audit.log.1 → audit.log.2 ... 순으로 이동하며 maxBackups를 넘는 파일은 삭제
+type FileLog struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+// NewFileLog : 파일 감사 로그 생성 (디렉토리가 없으면 0700으로 생성)
+func NewFileLog(path string, maxSize int64, maxBackups int) (*FileLog, error) {
+	if path == "" {
+		return nil, fmt.Errorf("audit log path is required")
+	}
+	if maxSize <= 0 {
+		maxSize = defaultMaxSize
+	}
+	if maxBackups < 0 {
+		maxBackups = defaultMaxBackups
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return nil, fmt.Errorf("failed to create audit log directory: %w", err)
+	}
+
+	l := &FileLog{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// open : 현재 파일을 추가 모드로 열기
+func (l *FileLog) open() error {
+	file, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to open audit log %s: %w", l.path, err)
+	}
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to stat audit log %s: %w", l.path, err)
+	}
+
+	l.file = file
+	l.size = info.Size()
+	return nil
+}
+
+// Record : 항목을 JSON 한 줄로 추가 (크기 초과 시 회전)
+func (l *FileLog) Record(entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.size > 0 && l.size+int64(len(line)) > l.maxSize {
+		if err := l.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := l.file.Write(line)
+	l.size += int64(n)
+	if err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return nil
+}
+
+// rotate : 현재 파일을 백업으로 이동하고 새 파일 열기
+func (l *FileLog) rotate() error {
+	if err := l.file.Close(); err != nil {
+		return fmt.Errorf("failed to close audit log: %w", err)
+	}
+
+	if l.maxBackups == 0 {
+		if err := os.Remove(l.path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove audit log: %w", err)
+		}
+		return l.open()
+	}
+
+	_ = os.Remove(l.backupPath(l.maxBackups))
+	for i := l.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(l.backupPath(i), l.backupPath(i+1)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to rotate audit log: %w", err)
+		}
+	}
+	if err := os.Rename(l.path, l.backupPath(1)); err != nil {
+		return fmt.Errorf("failed to rotate audit log: %w", err)
+	}
+
+	return l.open()
+}
+
+// backupPath : n번째 회전 파일 경로
+func (l *FileLog) backupPath(n int) string {
+	return fmt.Sprintf("%s.%d", l.path, n)
+}
+
+// Query : 현재 파일과 회전 파일에서 조건에 맞는 항목을 최신순으로 조회
+func (l *FileLog) Query(filter Filter) ([]Entry, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	limit := filter.limit()
+	var matched []Entry
+
+	// 오래된 파일부터 읽고 최근 limit개만 유지
+	for i := l.maxBackups; i >= 0; i-- {
+		path := l.path
+		if i > 0 {
+			path = l.backupPath(i)
+		}
+
+		err := readEntries(path, func(entry Entry) {
+			if !filter.Matches(entry) {
+				return
+			}
+			matched = append(matched, entry)
+			if len(matched) > limit {
+				matched = matched[1:]
+			}
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// 최신순 정렬
+	for i, j := 0, len(matched)-1; i < j; i, j = i+1, j-1 {
+		matched[i], matched[j] = matched[j], matched[i]
+	}
+	return matched, nil
+}
+
+// Close : 파일 닫기
+func (l *FileLog) Close() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.file.Close()
+}
+
+// readEntries : JSON lines 파일의 각 항목 처리 (없는 파일은 무시, 손상된 줄은 건너뜀)
+func readEntries(path string, fn func(Entry)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to open audit log %s: %w", path, err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	scanner := bufio.NewScanner(file)
+	scanner.Buffer(make([]byte, 64*1024), maxLineSize)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		fn(entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read audit log %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package audit
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/taking/kubemigrate/internal/cache"
+	"github.com/taking/kubemigrate/internal/validator"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// redactedValue : 비밀 값을 대신 기록하는 문자열 (원문 일부도 남기지 않음)
+const redactedValue = "********"
+
+// 값 전체를 마스킹하는 키 (소문자, 정확히 일치)
+var sensitiveKeys = map[string]bool{
+	"kubeconfig":    true,
+	"accesskey":     true,
+	"authorization": true,
+	"cacert":        true,
+	"cert":          true,
+	"key":           true,
+}
+
+// 이름에 포함되면 마스킹하는 키 조각 (소문자)
+var sensitiveKeyParts = []string{"password", "passwd", "secret", "token", "credential", "privatekey", "apikey"}
+
+// isSensitiveKey : 비밀 값을 담는 키인지 여부
+func isSensitiveKey(key string) bool {
+	lower := strings.ToLower(key)
+	if sensitiveKeys[lower] {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(lower, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact : 요청 파라미터의 비밀 값을 마스킹한 사본 반환 (kubeconfig는 앞/뒤 6자만 유지, 그 외는 전체 대체)
+// 비밀 키 아래의 객체/배열(예: credential.data, credentials.secretContents)은 하위 값 전체를 마스킹
+func Redact(value interface{}) interface{} {
+	return redact("", value, false)
+}
+
+// redact : 키 이름에 따라 재귀적으로 마스킹 (sensitive는 상위 키가 비밀 키인지 여부)
+func redact(key string, value interface{}, sensitive bool) interface{} {
+	sensitive = sensitive || isSensitiveKey(key)
+
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for k, item := range v {
+			out[k] = redact(k, item, sensitive)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = redact(key, item, sensitive)
+		}
+		return out
+	case string:
+		if !sensitive {
+			return v
+		}
+		if strings.EqualFold(key, "kubeconfig") {
+			return cache.MaskKubeConfigString(v)
+		}
+		return redactedValue
+	default:
+		if sensitive && v != nil {
+			return redactedValue
+		}
+		return v
+	}
+}
+
+// ClusterTargets : 요청 본문의 kubeconfig와 clusterId에서 대상 클러스터 추출
+// kubeconfig는 원문 대신 SHA-256 지문과 API 서버 주소만 기록
+func ClusterTargets(body interface{}, clusterID string) []Target {
+	var targets []Target
+	if clusterID != "" {
+		targets = append(targets, Target{Field: "clusterId", ClusterID: clusterID})
+	}
+	collectTargets("", body, &targets)
+
+	sort.SliceStable(targets, func(i, j int) bool { return targets[i].Field < targets[j].Field })
+	return targets
+}
+
+// collectTargets : 본문을 순회하며 kubeconfig/clusterId(sourceClusterId 등 포함) 필드 수집
+func collectTargets(prefix string, value interface{}, targets *[]Target) {
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return
+	}
+
+	for key, item := range object {
+		field := key
+		if prefix != "" {
+			field = prefix + "." + key
+		}
+
+		switch v := item.(type) {
+		case string:
+			switch {
+			case strings.EqualFold(key, "kubeconfig") && v != "":
+				*targets = append(*targets, kubeConfigTarget(field, v))
+			case (key == "clusterId" || strings.HasSuffix(key, "ClusterId")) && v != "":
+				*targets = append(*targets, Target{Field: field, ClusterID: v})
+			}
+		case map[string]interface{}:
+			collectTargets(field, v, targets)
+		}
+	}
+}
+
+// kubeConfigTarget : kubeconfig 지문과 API 서버 주소 계산
+func kubeConfigTarget(field, kubeConfig string) Target {
+	decoded, _ := validator.DecodeIfBase64(kubeConfig)
+	target := Target{Field: field, Fingerprint: validator.KubeConfigFingerprint(kubeConfig)}
+
+	if parsed, err := clientcmd.Load([]byte(decoded)); err == nil {
+		if ctx, ok := parsed.Contexts[parsed.CurrentContext]; ok {
+			if cluster, ok := parsed.Clusters[ctx.Cluster]; ok {
+				target.Server = cluster.Server
+			}
+		}
+	}
+	return target
+}
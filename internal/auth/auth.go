@@ -0,0 +1,100 @@
+// Package auth API 요청 인증(정적 토큰, JWT)과 역할 기반 권한을 관리합니다.
+package auth
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// 인증 에러
+var (
+	ErrNoCredentials      = errors.New("missing bearer token")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+)
+
+// Role : API 역할 (viewer < operator < admin)
+type Role string
+
+// 역할 목록
+const (
+	RoleViewer   Role = "viewer"   // 조회 및 읽기 전용 검증
+	RoleOperator Role = "operator" // 백업/복원/설치 등 변경 작업
+	RoleAdmin    Role = "admin"    // 삭제 등 파괴적 작업 및 자격 증명 관리
+)
+
+// roleLevels : 역할별 권한 수준
+var roleLevels = map[Role]int{
+	RoleViewer:   1,
+	RoleOperator: 2,
+	RoleAdmin:    3,
+}
+
+// ParseRole : 문자열을 역할로 변환 (대소문자 무시)
+func ParseRole(value string) (Role, error) {
+	role := Role(strings.ToLower(strings.TrimSpace(value)))
+	if _, ok := roleLevels[role]; !ok {
+		return "", fmt.Errorf("unknown role: %s (valid: viewer, operator, admin)", value)
+	}
+	return role, nil
+}
+
+// Allows : 역할이 요구 역할 이상의 권한을 가지는지 여부
+func (r Role) Allows(required Role) bool {
+	return roleLevels[r] >= roleLevels[required] && roleLevels[r] > 0
+}
+
+// higherRole : 두 역할 중 권한이 높은 역할
+func higherRole(a, b Role) Role {
+	if roleLevels[b] > roleLevels[a] {
+		return b
+	}
+	return a
+}
+
+// Principal : 인증된 요청 주체
+type Principal struct {
+	Subject string `json:"subject"`
+	Role    Role   `json:"role"`
+	Method  string `json:"method"` // "token", "jwt" 또는 "anonymous"
+}
+
+// Authenticator : 요청 인증 인터페이스
+// 자격 증명이 없으면 ErrNoCredentials, 자신이 처리할 수 없는 자격 증명이면 ErrInvalidCredentials 반환
+type Authenticator interface {
+	Authenticate(r *http.Request) (*Principal, error)
+}
+
+// Chain : 여러 인증 방식을 순서대로 시도하는 인증기
+type Chain []Authenticator
+
+// Authenticate : 첫 번째로 성공한 인증 결과 반환
+func (c Chain) Authenticate(r *http.Request) (*Principal, error) {
+	var lastErr error = ErrNoCredentials
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(r)
+		if err == nil {
+			return principal, nil
+		}
+		if errors.Is(err, ErrNoCredentials) {
+			return nil, err
+		}
+		lastErr = err
+	}
+	return nil, lastErr
+}
+
+// BearerToken : Authorization 헤더에서 Bearer 토큰 추출
+func BearerToken(r *http.Request) (string, error) {
+	header := strings.TrimSpace(r.Header.Get("Authorization"))
+	if header == "" {
+		return "", ErrNoCredentials
+	}
+
+	scheme, token, found := strings.Cut(header, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") || strings.TrimSpace(token) == "" {
+		return "", fmt.Errorf("%w: authorization header must use the Bearer scheme", ErrInvalidCredentials)
+	}
+	return strings.TrimSpace(token), nil
+}
@@ -0,0 +1,274 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// newRequest : Bearer 토큰이 설정된 테스트 요청 생성
+func newRequest(token string) *http.Request {
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/velero/backups", nil)
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return req
+}
+
+// writeJWKS : 공개키를 JWKS 파일로 저장
+func writeJWKS(t *testing.T, keys ...map[string]string) string {
+	t.Helper()
+	data, err := json.Marshal(map[string]interface{}{"keys": keys})
+	if err != nil {
+		t.Fatalf("failed to marshal jwks: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatalf("failed to write jwks: %v", err)
+	}
+	return path
+}
+
+// rsaJWK : RSA 공개키를 JWK로 변환
+func rsaJWK(kid string, pub *rsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "RSA",
+		"kid": kid,
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+	}
+}
+
+// ecJWK : EC P-256 공개키를 JWK로 변환
+func ecJWK(kid string, pub *ecdsa.PublicKey) map[string]string {
+	return map[string]string{
+		"kty": "EC",
+		"kid": kid,
+		"crv": "P-256",
+		"x":   base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, 32))),
+	}
+}
+
+// signJWT : 테스트용 JWT 서명 (RS256/ES256)
+func signJWT(t *testing.T, alg, kid string, key crypto.Signer, claims map[string]interface{}) string {
+	t.Helper()
+	encode := func(v interface{}) string {
+		data, err := json.Marshal(v)
+		if err != nil {
+			t.Fatalf("failed to marshal jwt segment: %v", err)
+		}
+		return base64.RawURLEncoding.EncodeToString(data)
+	}
+
+	signingInput := encode(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"}) + "." + encode(claims)
+	digest := crypto.SHA256.New()
+	digest.Write([]byte(signingInput))
+	sum := digest.Sum(nil)
+
+	var signature []byte
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		sig, err := rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, sum)
+		if err != nil {
+			t.Fatalf("failed to sign jwt: %v", err)
+		}
+		signature = sig
+	case *ecdsa.PrivateKey:
+		r, s, err := ecdsa.Sign(rand.Reader, k, sum)
+		if err != nil {
+			t.Fatalf("failed to sign jwt: %v", err)
+		}
+		signature = append(r.FillBytes(make([]byte, 32)), s.FillBytes(make([]byte, 32))...)
+	}
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func TestTokenAuthenticator(t *testing.T) {
+	authenticator, err := NewTokenAuthenticator([]string{"ci:operator:ci-secret", "# comment", "ops:Admin:ops-secret"})
+	if err != nil {
+		t.Fatalf("NewTokenAuthenticator() error = %v", err)
+	}
+
+	principal, err := authenticator.Authenticate(newRequest("ops-secret"))
+	if err != nil {
+		t.Fatalf("Authenticate() error = %v", err)
+	}
+	if principal.Subject != "ops" || principal.Role != RoleAdmin || principal.Method != "token" {
+		t.Errorf("unexpected principal: %+v", principal)
+	}
+
+	if _, err := authenticator.Authenticate(newRequest("wrong")); !errors.Is(err, ErrInvalidCredentials) {
+		t.Errorf("expected ErrInvalidCredentials for unknown token, got %v", err)
+	}
+	if _, err := authenticator.Authenticate(newRequest("")); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("expected ErrNoCredentials without header, got %v", err)
+	}
+
+	for _, entries := range [][]string{{"ci:superuser:secret"}, {"missing-token"}, {}} {
+		if _, err := NewTokenAuthenticator(entries); err == nil {
+			t.Errorf("expected error for entries %v", entries)
+		}
+	}
+}
+
+func TestLoadTokenEntries(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "tokens")
+	if err := os.WriteFile(path, []byte("# api tokens\nviewer:viewer:v-secret\n\n"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+
+	entries, err := LoadTokenEntries("a:admin:x, b:operator:y", path)
+	if err != nil {
+		t.Fatalf("LoadTokenEntries() error = %v", err)
+	}
+	if len(entries) != 3 || entries[2] != "viewer:viewer:v-secret" {
+		t.Errorf("unexpected entries: %v", entries)
+	}
+}
+
+func TestJWTAuthenticator(t *testing.T) {
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ec key: %v", err)
+	}
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+
+	authenticator, err := NewJWTAuthenticator(JWTOptions{
+		JWKSFile:    writeJWKS(t, rsaJWK("rsa-1", &rsaKey.PublicKey), ecJWK("ec-1", &ecKey.PublicKey)),
+		Issuer:      "https://idp.example.com",
+		Audience:    "kubemigrate",
+		RoleClaim:   "realm_access.roles",
+		RoleMapping: map[string]string{"platform-admins": "admin"},
+	})
+	if err != nil {
+		t.Fatalf("NewJWTAuthenticator() error = %v", err)
+	}
+
+	now := time.Now()
+	claims := func(overrides map[string]interface{}) map[string]interface{} {
+		c := map[string]interface{}{
+			"sub":          "user-1",
+			"iss":          "https://idp.example.com",
+			"aud":          []string{"account", "kubemigrate"},
+			"exp":          now.Add(time.Hour).Unix(),
+			"realm_access": map[string]interface{}{"roles": []string{"viewer", "platform-admins"}},
+		}
+		for k, v := range overrides {
+			c[k] = v
+		}
+		return c
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		wantRole Role
+	}{
+		{"rsa signed", signJWT(t, "RS256", "rsa-1", rsaKey, claims(nil)), RoleAdmin},
+		{"ec signed", signJWT(t, "ES256", "ec-1", ecKey, claims(map[string]interface{}{
+			"realm_access": map[string]interface{}{"roles": []string{"operator"}},
+		})), RoleOperator},
+		{"expired", signJWT(t, "RS256", "rsa-1", rsaKey, claims(map[string]interface{}{"exp": now.Add(-time.Hour).Unix()})), ""},
+		{"missing exp", signJWT(t, "RS256", "rsa-1", rsaKey, claims(map[string]interface{}{"exp": nil})), ""},
+		{"wrong issuer", signJWT(t, "RS256", "rsa-1", rsaKey, claims(map[string]interface{}{"iss": "https://evil.example.com"})), ""},
+		{"wrong audience", signJWT(t, "RS256", "rsa-1", rsaKey, claims(map[string]interface{}{"aud": "other"})), ""},
+		{"unknown signer", signJWT(t, "RS256", "rsa-1", otherKey, claims(nil)), ""},
+		{"no role", signJWT(t, "RS256", "rsa-1", rsaKey, claims(map[string]interface{}{"realm_access": map[string]interface{}{}})), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			principal, err := authenticator.Authenticate(newRequest(tt.token))
+			if tt.wantRole == "" {
+				if !errors.Is(err, ErrInvalidCredentials) {
+					t.Errorf("expected ErrInvalidCredentials, got principal=%+v err=%v", principal, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Authenticate() error = %v", err)
+			}
+			if principal.Role != tt.wantRole || principal.Subject != "user-1" || principal.Method != "jwt" {
+				t.Errorf("unexpected principal: %+v", principal)
+			}
+		})
+	}
+
+	t.Run("alg none rejected", func(t *testing.T) {
+		token := signJWT(t, "RS256", "rsa-1", rsaKey, claims(nil))
+		parts := strings.Split(token, ".")
+		header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"none","kid":"rsa-1"}`))
+		if _, err := authenticator.Authenticate(newRequest(header + "." + parts[1] + ".")); !errors.Is(err, ErrInvalidCredentials) {
+			t.Errorf("expected alg none to be rejected, got %v", err)
+		}
+	})
+}
+
+func TestNewAuthenticator(t *testing.T) {
+	authenticator, err := NewAuthenticator(config.AuthConfig{Mode: ModeNone})
+	if err != nil {
+		t.Fatalf("NewAuthenticator() error = %v", err)
+	}
+	principal, err := authenticator.Authenticate(newRequest(""))
+	if err != nil || principal.Role != RoleAdmin {
+		t.Errorf("expected anonymous admin when auth is disabled, got principal=%+v err=%v", principal, err)
+	}
+
+	authenticator, err = NewAuthenticator(config.AuthConfig{Mode: "token", Tokens: "ci:viewer:secret"})
+	if err != nil {
+		t.Fatalf("NewAuthenticator() error = %v", err)
+	}
+	if _, err := authenticator.Authenticate(newRequest("")); !errors.Is(err, ErrNoCredentials) {
+		t.Errorf("expected ErrNoCredentials, got %v", err)
+	}
+	if principal, err := authenticator.Authenticate(newRequest("secret")); err != nil || principal.Role != RoleViewer {
+		t.Errorf("expected viewer principal, got principal=%+v err=%v", principal, err)
+	}
+
+	for _, cfg := range []config.AuthConfig{
+		{Mode: "token"},
+		{Mode: "jwt"},
+		{Mode: "ldap"},
+	} {
+		if _, err := NewAuthenticator(cfg); err == nil {
+			t.Errorf("expected error for config %+v", cfg)
+		}
+	}
+}
+
+func TestRoleAllows(t *testing.T) {
+	if !RoleAdmin.Allows(RoleOperator) || !RoleOperator.Allows(RoleViewer) {
+		t.Error("higher roles should satisfy lower requirements")
+	}
+	if RoleViewer.Allows(RoleOperator) || RoleOperator.Allows(RoleAdmin) {
+		t.Error("lower roles should not satisfy higher requirements")
+	}
+	if Role("").Allows(RoleViewer) {
+		t.Error("empty role should not be allowed")
+	}
+}
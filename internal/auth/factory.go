@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// 인증 방식
+const (
+	ModeNone  = "none"
+	ModeToken = "token"
+	ModeJWT   = "jwt"
+)
+
+// anonymousAuthenticator : 인증 비활성화 시 모든 요청을 관리자로 취급
+type anonymousAuthenticator struct{}
+
+// Authenticate : 익명 관리자 주체 반환
+func (anonymousAuthenticator) Authenticate(_ *http.Request) (*Principal, error) {
+	return &Principal{Subject: "anonymous", Role: RoleAdmin, Method: "anonymous"}, nil
+}
+
+// Enabled : 인증 설정이 활성화되어 있는지 여부
+func Enabled(cfg config.AuthConfig) bool {
+	for _, mode := range strings.Split(cfg.Mode, ",") {
+		if mode = strings.TrimSpace(mode); mode != "" && mode != ModeNone {
+			return true
+		}
+	}
+	return false
+}
+
+// NewAuthenticator : 설정된 인증 방식("token", "jwt", "token,jwt")으로 인증기 생성
+func NewAuthenticator(cfg config.AuthConfig) (Authenticator, error) {
+	if !Enabled(cfg) {
+		return anonymousAuthenticator{}, nil
+	}
+
+	var chain Chain
+	for _, mode := range strings.Split(cfg.Mode, ",") {
+		switch strings.TrimSpace(mode) {
+		case "", ModeNone:
+			continue
+
+		case ModeToken:
+			entries, err := LoadTokenEntries(cfg.Tokens, cfg.TokensFile)
+			if err != nil {
+				return nil, err
+			}
+			authenticator, err := NewTokenAuthenticator(entries)
+			if err != nil {
+				return nil, err
+			}
+			chain = append(chain, authenticator)
+
+		case ModeJWT:
+			mapping, err := ParseRoleMapping(cfg.JWTRoleMapping)
+			if err != nil {
+				return nil, err
+			}
+			authenticator, err := NewJWTAuthenticator(JWTOptions{
+				JWKSFile:    cfg.JWKSFile,
+				Issuer:      cfg.JWTIssuer,
+				Audience:    cfg.JWTAudience,
+				RoleClaim:   cfg.JWTRoleClaim,
+				RoleMapping: mapping,
+				Leeway:      cfg.JWTLeeway,
+			})
+			if err != nil {
+				return nil, err
+			}
+			chain = append(chain, authenticator)
+
+		default:
+			return nil, fmt.Errorf("unsupported auth mode: %s (valid: none, token, jwt)", mode)
+		}
+	}
+
+	return chain, nil
+}
+
+// denyAuthenticator : 인증 설정 오류 시 모든 요청을 거부 (fail closed)
+type denyAuthenticator struct{}
+
+// DenyAll : 모든 요청을 거부하는 인증기 (설정 오류 상세는 시작 시 로그로만 기록)
+func DenyAll() Authenticator {
+	return denyAuthenticator{}
+}
+
+// Authenticate : 항상 인증 실패
+func (denyAuthenticator) Authenticate(_ *http.Request) (*Principal, error) {
+	return nil, fmt.Errorf("%w: authentication is misconfigured on the server", ErrInvalidCredentials)
+}
@@ -0,0 +1,396 @@
+package auth
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	_ "crypto/sha256" // RS256/PS256/ES256 해시 등록
+	_ "crypto/sha512" // RS384/RS512 등 해시 등록
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// JWTOptions : JWT 검증 옵션
+type JWTOptions struct {
+	JWKSFile    string            // 서명 검증용 JWKS 파일 경로
+	Issuer      string            // 기대하는 iss (비어 있으면 검증 생략)
+	Audience    string            // 기대하는 aud (비어 있으면 검증 생략)
+	RoleClaim   string            // 역할 클레임 경로 (점으로 구분, 예: "realm_access.roles")
+	RoleMapping map[string]string // 클레임 값 → 역할 매핑 (예: "kubemigrate-admins" → "admin")
+	Leeway      time.Duration     // exp/nbf 허용 오차
+}
+
+// jwk : JSON Web Key (RSA/EC 공개키)
+type jwk struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// verificationKey : JWKS에서 읽은 검증 키
+type verificationKey struct {
+	kid string
+	alg string
+	key crypto.PublicKey
+}
+
+// JWTAuthenticator : JWT/OIDC Bearer 토큰 인증기 (로컬 JWKS 파일로 서명 검증)
+type JWTAuthenticator struct {
+	opts JWTOptions
+	keys []verificationKey
+	now  func() time.Time
+}
+
+// NewJWTAuthenticator : JWKS 파일을 읽어 JWT 인증기 생성
+func NewJWTAuthenticator(opts JWTOptions) (*JWTAuthenticator, error) {
+	if opts.JWKSFile == "" {
+		return nil, fmt.Errorf("jwks file is required for jwt authentication")
+	}
+	if opts.RoleClaim == "" {
+		opts.RoleClaim = "roles"
+	}
+
+	data, err := os.ReadFile(opts.JWKSFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read jwks file %s: %w", opts.JWKSFile, err)
+	}
+
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwks file %s: %w", opts.JWKSFile, err)
+	}
+
+	return &JWTAuthenticator{opts: opts, keys: keys, now: time.Now}, nil
+}
+
+// Authenticate : 서명, 유효 기간, 발급자/대상 검증 후 역할 클레임으로 주체 생성
+func (a *JWTAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	token, err := BearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := a.verify(token)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCredentials, err)
+	}
+
+	role := a.resolveRole(claims)
+	if role == "" {
+		return nil, fmt.Errorf("%w: token has no recognized role in claim %q", ErrInvalidCredentials, a.opts.RoleClaim)
+	}
+
+	subject, _ := claims["sub"].(string)
+	if username, ok := claims["preferred_username"].(string); ok && username != "" {
+		subject = username
+	}
+
+	return &Principal{Subject: subject, Role: role, Method: "jwt"}, nil
+}
+
+// verify : 토큰 서명 및 표준 클레임 검증
+func (a *JWTAuthenticator) verify(token string) (map[string]interface{}, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, errors.New("malformed jwt")
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("invalid jwt header: %w", err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("invalid jwt signature encoding: %w", err)
+	}
+
+	if err := a.verifySignature(header.Alg, header.Kid, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var claims map[string]interface{}
+	if err := decodeSegment(parts[1], &claims); err != nil {
+		return nil, fmt.Errorf("invalid jwt claims: %w", err)
+	}
+
+	now := a.now()
+	exp, ok := numericClaim(claims, "exp")
+	if !ok {
+		return nil, errors.New("token has no exp claim")
+	}
+	if now.After(exp.Add(a.opts.Leeway)) {
+		return nil, errors.New("token is expired")
+	}
+	if nbf, ok := numericClaim(claims, "nbf"); ok && now.Add(a.opts.Leeway).Before(nbf) {
+		return nil, errors.New("token is not valid yet")
+	}
+
+	if a.opts.Issuer != "" {
+		if iss, _ := claims["iss"].(string); iss != a.opts.Issuer {
+			return nil, fmt.Errorf("unexpected issuer %q", iss)
+		}
+	}
+	if a.opts.Audience != "" && !containsClaimValue(claims["aud"], a.opts.Audience) {
+		return nil, errors.New("token audience does not match")
+	}
+
+	return claims, nil
+}
+
+// verifySignature : 알고리즘과 kid에 맞는 키로 서명 검증 (none/HMAC 알고리즘은 거부)
+func (a *JWTAuthenticator) verifySignature(alg, kid, signingInput string, signature []byte) error {
+	hash, err := hashForAlgorithm(alg)
+	if err != nil {
+		return err
+	}
+
+	hasher := hash.New()
+	hasher.Write([]byte(signingInput))
+	digest := hasher.Sum(nil)
+
+	for _, key := range a.keys {
+		if kid != "" && key.kid != "" && key.kid != kid {
+			continue
+		}
+		if key.alg != "" && key.alg != alg {
+			continue
+		}
+
+		switch pub := key.key.(type) {
+		case *rsa.PublicKey:
+			switch {
+			case strings.HasPrefix(alg, "RS"):
+				if rsa.VerifyPKCS1v15(pub, hash, digest, signature) == nil {
+					return nil
+				}
+			case strings.HasPrefix(alg, "PS"):
+				if rsa.VerifyPSS(pub, hash, digest, signature, &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash}) == nil {
+					return nil
+				}
+			}
+		case *ecdsa.PublicKey:
+			if !strings.HasPrefix(alg, "ES") {
+				continue
+			}
+			size := (pub.Curve.Params().BitSize + 7) / 8
+			if len(signature) != 2*size {
+				continue
+			}
+			r := new(big.Int).SetBytes(signature[:size])
+			s := new(big.Int).SetBytes(signature[size:])
+			if ecdsa.Verify(pub, digest, r, s) {
+				return nil
+			}
+		}
+	}
+
+	return errors.New("signature verification failed")
+}
+
+// resolveRole : 역할 클레임 값 중 가장 높은 역할 반환 (매핑 우선 적용)
+func (a *JWTAuthenticator) resolveRole(claims map[string]interface{}) Role {
+	var value interface{} = claims
+	for _, key := range strings.Split(a.opts.RoleClaim, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return ""
+		}
+		value = object[key]
+	}
+
+	var values []string
+	switch v := value.(type) {
+	case string:
+		values = strings.Fields(strings.ReplaceAll(v, ",", " "))
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+	}
+
+	var role Role
+	for _, v := range values {
+		if mapped, ok := a.opts.RoleMapping[v]; ok {
+			v = mapped
+		}
+		if parsed, err := ParseRole(v); err == nil {
+			role = higherRole(role, parsed)
+		}
+	}
+	return role
+}
+
+// ParseRoleMapping : "claimValue=role" 콤마 구분 문자열을 매핑으로 변환
+func ParseRoleMapping(value string) (map[string]string, error) {
+	mapping := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		claimValue, role, found := strings.Cut(entry, "=")
+		if !found || strings.TrimSpace(claimValue) == "" {
+			return nil, fmt.Errorf("invalid role mapping %q: expected claimValue=role", entry)
+		}
+		parsed, err := ParseRole(role)
+		if err != nil {
+			return nil, fmt.Errorf("invalid role mapping %q: %w", entry, err)
+		}
+		mapping[strings.TrimSpace(claimValue)] = string(parsed)
+	}
+	return mapping, nil
+}
+
+// parseJWKS : JWKS 문서에서 서명 검증용 RSA/EC 공개키 추출
+func parseJWKS(data []byte) ([]verificationKey, error) {
+	var set struct {
+		Keys []jwk `json:"keys"`
+	}
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, err
+	}
+
+	var keys []verificationKey
+	for _, k := range set.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var key crypto.PublicKey
+		var err error
+		switch k.Kty {
+		case "RSA":
+			key, err = rsaPublicKey(k)
+		case "EC":
+			key, err = ecPublicKey(k)
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", k.Kid, err)
+		}
+
+		keys = append(keys, verificationKey{kid: k.Kid, alg: k.Alg, key: key})
+	}
+
+	if len(keys) == 0 {
+		return nil, errors.New("no usable signing keys (RSA or EC)")
+	}
+	return keys, nil
+}
+
+// rsaPublicKey : JWK의 n/e로 RSA 공개키 생성
+func rsaPublicKey(k jwk) (*rsa.PublicKey, error) {
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil || len(e) == 0 || len(e) > 4 {
+		return nil, errors.New("invalid exponent")
+	}
+
+	exponent := 0
+	for _, b := range e {
+		exponent = exponent<<8 | int(b)
+	}
+	return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: exponent}, nil
+}
+
+// ecPublicKey : JWK의 crv/x/y로 EC 공개키 생성
+func ecPublicKey(k jwk) (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+	}
+
+	x, err := base64.RawURLEncoding.DecodeString(k.X)
+	if err != nil {
+		return nil, fmt.Errorf("invalid x coordinate: %w", err)
+	}
+	y, err := base64.RawURLEncoding.DecodeString(k.Y)
+	if err != nil {
+		return nil, fmt.Errorf("invalid y coordinate: %w", err)
+	}
+
+	key := &ecdsa.PublicKey{Curve: curve, X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+	if !curve.IsOnCurve(key.X, key.Y) {
+		return nil, errors.New("point is not on curve")
+	}
+	return key, nil
+}
+
+// hashForAlgorithm : JWS 알고리즘별 해시 함수
+func hashForAlgorithm(alg string) (crypto.Hash, error) {
+	switch alg {
+	case "RS256", "PS256", "ES256":
+		return crypto.SHA256, nil
+	case "RS384", "PS384", "ES384":
+		return crypto.SHA384, nil
+	case "RS512", "PS512", "ES512":
+		return crypto.SHA512, nil
+	default:
+		return 0, fmt.Errorf("unsupported jwt algorithm %q", alg)
+	}
+}
+
+// decodeSegment : base64url JWT 세그먼트를 JSON으로 디코딩
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// numericClaim : NumericDate 클레임 조회
+func numericClaim(claims map[string]interface{}, name string) (time.Time, bool) {
+	value, ok := claims[name].(float64)
+	if !ok {
+		return time.Time{}, false
+	}
+	return time.Unix(int64(value), 0), true
+}
+
+// containsClaimValue : 문자열 또는 문자열 배열 클레임에 값이 포함되는지 여부
+func containsClaimValue(claim interface{}, expected string) bool {
+	switch v := claim.(type) {
+	case string:
+		return v == expected
+	case []interface{}:
+		for _, item := range v {
+			if s, ok := item.(string); ok && s == expected {
+				return true
+			}
+		}
+	}
+	return false
+}
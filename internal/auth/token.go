@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// staticToken : 등록된 정적 토큰 (토큰 원문 대신 해시 보관)
+type staticToken struct {
+	name string
+	role Role
+	hash [sha256.Size]byte
+}
+
+// TokenAuthenticator : 정적 API 토큰 인증기
+type TokenAuthenticator struct {
+	tokens []staticToken
+}
+
+// NewTokenAuthenticator : "name:role:token" 형식의 항목 목록으로 인증기 생성
+func NewTokenAuthenticator(entries []string) (*TokenAuthenticator, error) {
+	a := &TokenAuthenticator{}
+	for i, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" || strings.HasPrefix(entry, "#") {
+			continue
+		}
+
+		parts := strings.SplitN(entry, ":", 3)
+		if len(parts) != 3 || parts[0] == "" || parts[2] == "" {
+			return nil, fmt.Errorf("invalid token entry %d: expected name:role:token", i+1)
+		}
+		role, err := ParseRole(parts[1])
+		if err != nil {
+			return nil, fmt.Errorf("invalid token entry %s: %w", parts[0], err)
+		}
+
+		a.tokens = append(a.tokens, staticToken{
+			name: parts[0],
+			role: role,
+			hash: sha256.Sum256([]byte(parts[2])),
+		})
+	}
+
+	if len(a.tokens) == 0 {
+		return nil, fmt.Errorf("no api tokens configured")
+	}
+	return a, nil
+}
+
+// LoadTokenEntries : 콤마 구분 문자열과 토큰 파일(한 줄에 하나, '#' 주석)에서 항목 수집
+func LoadTokenEntries(inline, path string) ([]string, error) {
+	var entries []string
+	for _, entry := range strings.Split(inline, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+
+	if path == "" {
+		return entries, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open token file %s: %w", path, err)
+	}
+	defer file.Close() //nolint:errcheck
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		if line := strings.TrimSpace(scanner.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			entries = append(entries, line)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read token file %s: %w", path, err)
+	}
+
+	return entries, nil
+}
+
+// Authenticate : Bearer 토큰을 등록된 토큰과 상수 시간 비교
+func (a *TokenAuthenticator) Authenticate(r *http.Request) (*Principal, error) {
+	token, err := BearerToken(r)
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.Sum256([]byte(token))
+	var matched *staticToken
+	for i := range a.tokens {
+		if subtle.ConstantTimeCompare(hash[:], a.tokens[i].hash[:]) == 1 {
+			matched = &a.tokens[i]
+		}
+	}
+	if matched == nil {
+		return nil, fmt.Errorf("%w: unknown api token", ErrInvalidCredentials)
+	}
+
+	return &Principal{Subject: matched.name, Role: matched.role, Method: "token"}, nil
+}
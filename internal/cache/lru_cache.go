@@ -6,6 +6,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/taking/kubemigrate/internal/metrics"
 	"github.com/taking/kubemigrate/pkg/client"
 	"github.com/taking/kubemigrate/pkg/config"
 	"github.com/taking/kubemigrate/pkg/utils"
@@ -22,6 +23,7 @@ type LRUItem struct {
 	KubeConfig   config.KubeConfig
 	VeleroConfig config.VeleroConfig
 	MinioConfig  config.MinioConfig
+	Data         interface{} // 클라이언트가 아닌 캐시 값 (SetData로 저장)
 }
 
 // LRUCache : 메모리 효율적인 LRU 캐시
@@ -32,6 +34,8 @@ type LRUCache struct {
 	mutex       sync.RWMutex
 	totalHits   int64
 	totalMisses int64
+	evictions   int64 // 용량 초과로 제거된 항목 수
+	expirations int64 // TTL 만료로 제거된 항목 수
 }
 
 // NewLRUCache : 새로운 LRU 캐시 생성
@@ -45,6 +49,24 @@ func NewLRUCache(capacity int) *LRUCache {
 
 // Get : 캐시에서 값 조회 (TTL 검사 포함)
 func (c *LRUCache) Get(key string) (client.Client, bool) {
+	item, exists := c.lookup(key)
+	if !exists {
+		return nil, false
+	}
+	return item.Value, true
+}
+
+// GetData : SetData로 저장한 값 조회 (TTL 검사 포함)
+func (c *LRUCache) GetData(key string) (interface{}, bool) {
+	item, exists := c.lookup(key)
+	if !exists || item.Data == nil {
+		return nil, false
+	}
+	return item.Data, true
+}
+
+// lookup : 항목 조회 후 최근 사용으로 표시 (만료된 항목은 제거)
+func (c *LRUCache) lookup(key string) (*LRUItem, bool) {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
 
@@ -54,6 +76,7 @@ func (c *LRUCache) Get(key string) (client.Client, bool) {
 		// TTL 검사
 		if c.isExpired(item) {
 			c.removeElement(elem)
+			c.expirations++
 			c.totalMisses++
 			return nil, false
 		}
@@ -61,7 +84,7 @@ func (c *LRUCache) Get(key string) (client.Client, bool) {
 		item.LastAccess = time.Now()
 		c.list.MoveToFront(elem)
 		c.totalHits++
-		return item.Value, true
+		return item, true
 	}
 
 	c.totalMisses++
@@ -109,7 +132,7 @@ func (c *LRUCache) SetWithTTLAndConfigs(key string, value client.Client, ttl tim
 	defer c.mutex.Unlock()
 
 	now := time.Now()
-	item := &LRUItem{
+	c.storeLocked(&LRUItem{
 		Key:          key,
 		Value:        value,
 		CreatedAt:    now,
@@ -119,9 +142,28 @@ func (c *LRUCache) SetWithTTLAndConfigs(key string, value client.Client, ttl tim
 		KubeConfig:   kubeConfig,
 		VeleroConfig: veleroConfig,
 		MinioConfig:  minioConfig,
-	}
+	})
+}
 
-	if elem, exists := c.items[key]; exists {
+// SetData : 클라이언트가 아닌 값(조회 결과 등)을 TTL과 함께 캐시에 저장
+func (c *LRUCache) SetData(key string, data interface{}, ttl time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	now := time.Now()
+	c.storeLocked(&LRUItem{
+		Key:        key,
+		Data:       data,
+		CreatedAt:  now,
+		LastAccess: now,
+		TTL:        ttl,
+		ApiType:    "data",
+	})
+}
+
+// storeLocked : 항목 저장 (기존 항목 교체, 용량 초과 시 가장 오래된 항목 제거, mutex를 잡은 상태에서 호출)
+func (c *LRUCache) storeLocked(item *LRUItem) {
+	if elem, exists := c.items[item.Key]; exists {
 		c.removeElement(elem)
 	}
 
@@ -130,7 +172,7 @@ func (c *LRUCache) SetWithTTLAndConfigs(key string, value client.Client, ttl tim
 	}
 
 	elem := c.list.PushFront(item)
-	c.items[key] = elem
+	c.items[item.Key] = elem
 }
 
 // SetWithConfigs : 설정 정보와 함께 캐시에 값 저장 (기본 TTL: 30분)
@@ -161,6 +203,7 @@ func (c *LRUCache) CleanupExpired() int {
 		c.removeElement(elem)
 		expiredCount++
 	}
+	c.expirations += int64(expiredCount)
 
 	return expiredCount
 }
@@ -234,6 +277,40 @@ func (c *LRUCache) Stats() map[string]interface{} {
 	}
 }
 
+// Counters : 누적 적중/실패/제거 횟수와 현재 항목 수 반환
+func (c *LRUCache) Counters() CacheCounters {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return CacheCounters{
+		Hits:        c.totalHits,
+		Misses:      c.totalMisses,
+		Evictions:   c.evictions,
+		Expirations: c.expirations,
+		Items:       len(c.items),
+	}
+}
+
+// RegisterMetrics : 캐시 통계를 Prometheus 메트릭으로 노출 (같은 이름으로 다시 등록하면 교체)
+func (c *LRUCache) RegisterMetrics(name string) {
+	metrics.CacheHits.Register(name, func() []metrics.Sample {
+		return []metrics.Sample{{LabelValues: []string{name}, Value: float64(c.Counters().Hits)}}
+	})
+	metrics.CacheMisses.Register(name, func() []metrics.Sample {
+		return []metrics.Sample{{LabelValues: []string{name}, Value: float64(c.Counters().Misses)}}
+	})
+	metrics.CacheEvictions.Register(name, func() []metrics.Sample {
+		counters := c.Counters()
+		return []metrics.Sample{
+			{LabelValues: []string{name, "capacity"}, Value: float64(counters.Evictions)},
+			{LabelValues: []string{name, "expired"}, Value: float64(counters.Expirations)},
+		}
+	})
+	metrics.CacheItems.Register(name, func() []metrics.Sample {
+		return []metrics.Sample{{LabelValues: []string{name}, Value: float64(c.Counters().Items)}}
+	})
+}
+
 // GetDetailedStats : 상세한 캐시 통계 반환
 func (c *LRUCache) GetDetailedStats() CacheStats {
 	c.mutex.RLock()
@@ -341,8 +418,23 @@ func (c *LRUCache) GetOrCreateWithApiType(
 	apiType string,
 	createFunc func() client.Client,
 ) client.Client {
-	// 복합 캐시 키 생성
-	key := utils.GenerateCompositeCacheKey(
+	key := ClientCacheKey(kubeConfig, helmConfig, veleroConfig, minioConfig, apiType)
+
+	newClient, _ := c.GetOrCreateWithKey(key, kubeConfig, veleroConfig, minioConfig, apiType, func() (client.Client, error) {
+		return createFunc(), nil
+	})
+	return newClient
+}
+
+// ClientCacheKey : 설정과 API 타입으로 복합 캐시 키 생성
+func ClientCacheKey(
+	kubeConfig config.KubeConfig,
+	helmConfig config.KubeConfig,
+	veleroConfig config.VeleroConfig,
+	minioConfig config.MinioConfig,
+	apiType string,
+) string {
+	return utils.GenerateCompositeCacheKey(
 		kubeConfig.KubeConfig,
 		helmConfig.KubeConfig,
 		veleroConfig.KubeConfig.KubeConfig,
@@ -351,17 +443,31 @@ func (c *LRUCache) GetOrCreateWithApiType(
 		minioConfig.SecretKey,
 		apiType,
 	)
+}
 
+// GetOrCreateWithKey : 지정한 키로 캐시에서 조회하거나 새로 생성 (등록 ID 기반 키 등)
+// 생성에 실패하면 캐시에 저장하지 않고 에러 반환
+func (c *LRUCache) GetOrCreateWithKey(
+	key string,
+	kubeConfig config.KubeConfig,
+	veleroConfig config.VeleroConfig,
+	minioConfig config.MinioConfig,
+	apiType string,
+	createFunc func() (client.Client, error),
+) (client.Client, error) {
 	// 캐시에서 조회 시도
 	if cached, exists := c.Get(key); exists {
-		return cached
+		return cached, nil
 	}
 
 	// 캐시에 없으면 새로 생성
-	newClient := createFunc()
+	newClient, err := createFunc()
+	if err != nil {
+		return nil, err
+	}
 	c.SetWithConfigs(key, newClient, apiType, kubeConfig, veleroConfig, minioConfig)
 
-	return newClient
+	return newClient, nil
 }
 
 // determineApiType : 설정을 기반으로 API 타입을 결정
@@ -395,6 +501,7 @@ func (c *LRUCache) evictOldest() {
 	// 리스트의 마지막 요소(가장 오래된) 제거
 	elem := c.list.Back()
 	c.removeElement(elem)
+	c.evictions++
 }
 
 // getMaskedConfig : API 타입에 따라 마스킹된 설정 반환
@@ -415,7 +522,7 @@ func (c *LRUCache) getMaskedConfig(apiType, key string) any {
 		default:
 			return map[string]interface{}{
 				"api_type": apiType,
-				"key":      maskString(key),
+				"key":      MaskString(key),
 			}
 		}
 	}
@@ -424,7 +531,7 @@ func (c *LRUCache) getMaskedConfig(apiType, key string) any {
 	switch apiType {
 	case "kubernetes":
 		return MaskedKubeConfig{
-			KubeConfig: maskString(key),
+			KubeConfig: MaskString(key),
 			HasConfig:  false,
 		}
 	case "minio":
@@ -443,13 +550,13 @@ func (c *LRUCache) getMaskedConfig(apiType, key string) any {
 		}
 	case "helm":
 		return MaskedHelmConfig{
-			KubeConfig: maskString(key),
+			KubeConfig: MaskString(key),
 			HasConfig:  false,
 		}
 	default:
 		return map[string]interface{}{
 			"api_type": apiType,
-			"key":      maskString(key),
+			"key":      MaskString(key),
 		}
 	}
 }
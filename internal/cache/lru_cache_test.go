@@ -53,6 +53,26 @@ func TestLRUCache_SetAndGet(t *testing.T) {
 	}
 }
 
+// TestLRUCache_SetData 임의 데이터 저장 및 조회 테스트
+func TestLRUCache_SetData(t *testing.T) {
+	cache := NewLRUCache(5)
+
+	cache.SetData("data1", map[string]int{"items": 3}, time.Minute)
+
+	data, exists := cache.GetData("data1")
+	if !exists {
+		t.Fatal("Expected data to exist")
+	}
+	if data.(map[string]int)["items"] != 3 {
+		t.Errorf("Expected stored data, got %v", data)
+	}
+
+	// 클라이언트 조회로는 데이터 항목이 반환되지 않아야 함
+	if client, _ := cache.Get("data1"); client != nil {
+		t.Error("Expected no client for data item")
+	}
+}
+
 // TestLRUCache_CapacityOverflow 용량 초과 시 오래된 항목 제거 테스트
 func TestLRUCache_CapacityOverflow(t *testing.T) {
 	cache := NewLRUCache(2)
@@ -80,6 +100,32 @@ func TestLRUCache_CapacityOverflow(t *testing.T) {
 	}
 }
 
+// TestLRUCache_Counters 적중/실패/제거 카운터 테스트
+func TestLRUCache_Counters(t *testing.T) {
+	cache := NewLRUCache(2)
+
+	cache.Set("key1", &MockClient{})
+	cache.Set("key2", &MockClient{})
+	cache.Set("key3", &MockClient{}) // key1 용량 초과로 제거
+	cache.SetWithTTL("key4", &MockClient{}, time.Nanosecond)
+	time.Sleep(time.Millisecond)
+
+	cache.Get("key3")
+	cache.Get("key1")
+	cache.Get("key4") // 만료로 제거
+
+	counters := cache.Counters()
+	if counters.Hits != 1 || counters.Misses != 2 {
+		t.Errorf("Expected 1 hit and 2 misses, got %+v", counters)
+	}
+	if counters.Evictions != 2 || counters.Expirations != 1 {
+		t.Errorf("Expected 2 evictions and 1 expiration, got %+v", counters)
+	}
+	if counters.Items != 1 {
+		t.Errorf("Expected 1 item, got %d", counters.Items)
+	}
+}
+
 // TestLRUCache_Expiration LRU 캐시에서는 만료 개념이 없으므로 제거됨
 // LRU 캐시는 용량 제한으로만 관리됩니다.
 
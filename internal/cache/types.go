@@ -40,6 +40,15 @@ type PerformanceStats struct {
 	NewestClient int     `json:"newest_client_seconds"`
 }
 
+// CacheCounters : 누적 캐시 카운터 (메트릭용)
+type CacheCounters struct {
+	Hits        int64
+	Misses      int64
+	Evictions   int64 // 용량 초과로 제거된 항목 수
+	Expirations int64 // TTL 만료로 제거된 항목 수
+	Items       int
+}
+
 // MaskedKubeConfig : 마스킹된 Kubernetes 설정
 type MaskedKubeConfig struct {
 	KubeConfig string `json:"kubeconfig"` // 마스킹된 kubeconfig
@@ -18,13 +18,13 @@ func maskMiddle(s string, prefix, suffix int) string {
 	return s[:prefix] + strings.Repeat("*", len(s)-prefix-suffix) + s[len(s)-suffix:]
 }
 
-// maskString : 기본 문자열 마스킹 (앞 4, 뒤 4)
-func maskString(s string) string {
+// MaskString : 기본 문자열 마스킹 (앞 4, 뒤 4)
+func MaskString(s string) string {
 	return maskMiddle(s, 4, 4)
 }
 
-// maskKubeConfigString : kubeconfig 문자열 마스킹 (앞 6, 뒤 6)
-func maskKubeConfigString(s string) string {
+// MaskKubeConfigString : kubeconfig 문자열 마스킹 (앞 6, 뒤 6)
+func MaskKubeConfigString(s string) string {
 	if len(s) == 0 {
 		return ""
 	}
@@ -37,7 +37,7 @@ func maskKubeConfigString(s string) string {
 // maskKubeConfig : kubeconfig 마스킹 처리
 func maskKubeConfig(kubeConfig config.KubeConfig) MaskedKubeConfig {
 	return MaskedKubeConfig{
-		KubeConfig: maskKubeConfigString(kubeConfig.KubeConfig),
+		KubeConfig: MaskKubeConfigString(kubeConfig.KubeConfig),
 		HasConfig:  kubeConfig.KubeConfig != "",
 	}
 }
@@ -51,8 +51,8 @@ func maskKubernetesConfig(kubeConfig config.KubeConfig) MaskedKubernetesConfig {
 func maskMinioConfig(minioConfig config.MinioConfig) MaskedMinioConfig {
 	return MaskedMinioConfig{
 		Endpoint:  minioConfig.Endpoint,
-		AccessKey: maskString(minioConfig.AccessKey),
-		SecretKey: maskString(minioConfig.SecretKey),
+		AccessKey: MaskString(minioConfig.AccessKey),
+		SecretKey: MaskString(minioConfig.SecretKey),
 		UseSSL:    minioConfig.UseSSL,
 		HasConfig: minioConfig.Endpoint != "",
 	}
@@ -70,7 +70,7 @@ func maskVeleroConfig(veleroConfig config.VeleroConfig, minioConfig config.Minio
 // maskHelmConfig : Helm 설정 마스킹 처리
 func maskHelmConfig(helmConfig config.KubeConfig) MaskedHelmConfig {
 	return MaskedHelmConfig{
-		KubeConfig: maskKubeConfigString(helmConfig.KubeConfig),
+		KubeConfig: MaskKubeConfigString(helmConfig.KubeConfig),
 		HasConfig:  helmConfig.KubeConfig != "",
 	}
 }
@@ -3,6 +3,7 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -14,6 +15,7 @@ import (
 	"github.com/taking/kubemigrate/internal/job"
 	"github.com/taking/kubemigrate/internal/logger"
 	"github.com/taking/kubemigrate/internal/mocks"
+	"github.com/taking/kubemigrate/internal/registry"
 	"github.com/taking/kubemigrate/internal/response"
 	"github.com/taking/kubemigrate/internal/validator"
 	"github.com/taking/kubemigrate/pkg/client"
@@ -22,6 +24,9 @@ import (
 	pkgutils "github.com/taking/kubemigrate/pkg/utils"
 )
 
+// clusterLabelContextKey : 요청 대상 클러스터 레이블을 저장하는 echo 컨텍스트 키
+const clusterLabelContextKey = "kubemigrate.clusterLabel"
+
 // BaseHandler : 모든 핸들러의 기본 구조
 type BaseHandler struct {
 	KubernetesValidator *validator.KubernetesValidator
@@ -29,6 +34,7 @@ type BaseHandler struct {
 	ConfigManager       *config.ConfigManager
 	ValidationManager   *validator.ValidationManager
 	ConfigBinder        *pkgutils.ConfigBinder
+	Registry            *registry.Registry // 등록된 클러스터/스토리지 (초기화 실패 시 nil)
 	workerPool          *job.WorkerPool
 	clientCache         *cache.LRUCache
 	useMockClient       bool // 테스트용 Mock 클라이언트 사용 여부
@@ -47,6 +53,12 @@ func NewBaseHandler(workerPool *job.WorkerPool) *BaseHandler {
 		useMockClient:       false,
 	}
 
+	// 클러스터/스토리지 레지스트리 초기화
+	baseHandler.Registry = baseHandler.newRegistry()
+
+	// 클라이언트 캐시 적중/제거 메트릭 노출
+	baseHandler.clientCache.RegisterMetrics("client")
+
 	// 설정 검증
 	if err := baseHandler.ValidateConfiguration(); err != nil {
 		// 로그만 출력하고 계속 진행 (개발 환경에서는 유연하게)
@@ -65,6 +77,7 @@ func NewBaseHandlerWithMock(workerPool *job.WorkerPool) *BaseHandler {
 		ValidationManager:   validator.NewValidationManager(),
 		ConfigBinder:        pkgutils.NewConfigBinder(),
 		workerPool:          workerPool,
+		Registry:            newMockRegistry(),
 		clientCache:         cache.NewLRUCache(100), // 최대 100개 항목
 		useMockClient:       true,
 	}
@@ -113,9 +126,9 @@ func (h *BaseHandler) HandleValidationError(c echo.Context, serviceName, operati
 	return response.HandleValidationError(c, serviceName, operation, err)
 }
 
-// HandleConnectionError : 공통 연결 에러 처리 함수
+// HandleConnectionError : 공통 연결 에러 처리 함수 (인증 실패, TLS 오류, 연결 불가 등은 유형별 상태 코드)
 func (h *BaseHandler) HandleConnectionError(c echo.Context, serviceName, operation string, err error) error {
-	return response.HandleConnectionError(c, serviceName, operation, err)
+	return response.HandleConnectionError(c, serviceName, operation, client.ClassifyConnectionError(serviceName, err))
 }
 
 // HandleInternalError : 공통 내부 에러 처리 함수
@@ -123,6 +136,18 @@ func (h *BaseHandler) HandleInternalError(c echo.Context, serviceName, operation
 	return response.HandleInternalError(c, serviceName, operation, err)
 }
 
+// HandleJobCancelError : 작업 취소 에러 처리 함수 (없음: 404, 이미 종료: 409)
+func (h *BaseHandler) HandleJobCancelError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, job.ErrJobNotFound):
+		return response.RespondWithErrorModel(c, http.StatusNotFound, "JOB_NOT_FOUND", err.Error(), "")
+	case errors.Is(err, job.ErrJobNotRunning):
+		return response.RespondWithErrorModel(c, http.StatusConflict, "JOB_NOT_RUNNING", err.Error(), "")
+	default:
+		return response.RespondWithErrorModel(c, http.StatusInternalServerError, "JOB_CANCEL_FAILED", err.Error(), "")
+	}
+}
+
 // ValidationConfig : 검증 설정
 type ValidationConfig struct {
 	ServiceName string
@@ -147,75 +172,52 @@ func (h *BaseHandler) ValidateVeleroConfig(c echo.Context, serviceName string) (
 // HandleResourceClient : 통합 클라이언트를 사용한 리소스 처리
 func (h *BaseHandler) HandleResourceClient(c echo.Context, cacheKey string,
 	getResource func(client.Client, context.Context) (interface{}, error)) error {
+	return h.handleResourceClient(c, cacheKey, getResource, false)
+}
+
+// HandleManifestClient : 리소스 매니페스트 조회 처리 (?output=yaml 요청 시 YAML로 응답)
+func (h *BaseHandler) HandleManifestClient(c echo.Context, cacheKey string,
+	getResource func(client.Client, context.Context) (interface{}, error)) error {
+	return h.handleResourceClient(c, cacheKey, getResource, true)
+}
+
+// handleResourceClient : 리소스 처리 공통 구현 (allowYAML이면 output 쿼리 파라미터로 응답 형식 결정)
+func (h *BaseHandler) handleResourceClient(c echo.Context, cacheKey string,
+	getResource func(client.Client, context.Context) (interface{}, error), allowYAML bool) error {
 
 	// API 타입별 설정 파싱 및 검증
-	kubeConfig, veleroConfig, minioConfig, err := h.parseConfig(c, cacheKey)
+	kubeConfig, veleroConfig, minioConfig, ref, err := h.parseConfig(c, cacheKey)
 	if err != nil {
 		// 설정 파싱 실패 시 공통 에러 처리 함수 사용
-		return h.handleConfigError(c, err)
+		return h.HandleConfigError(c, err)
 	}
 
 	// API 경로를 기반으로 정확한 API 타입 결정
 	apiType := h.determineApiTypeFromPath(c.Request().URL.Path)
 
+	// 메트릭에서 대상 클러스터를 구분할 수 있도록 저장
+	c.Set(clusterLabelContextKey, ref.clusterLabel(kubeConfig))
+
 	// 캐시에서 클라이언트 조회 또는 생성
 	var unifiedClient client.Client
 	if h.useMockClient {
-		// 테스트용 Mock 클라이언트 사용
+		// 테스트용 Mock 클라이언트 사용 (NewBaseHandlerWithMock으로 생성한 경우만)
 		unifiedClient = mocks.NewMockClient()
 	} else {
-		unifiedClient = h.clientCache.GetOrCreateWithApiType(
-			kubeConfig,
-			kubeConfig,
-			veleroConfig,
-			minioConfig,
-			apiType,
-			func() client.Client {
-				// MinIO API인 경우 minioConfig만 유효하므로 명시적으로 처리
-				if strings.Contains(c.Request().URL.Path, "/minio/") {
-					// MinIO 설정이 유효한지 먼저 확인
-					if minioConfig.Endpoint == "" {
-						logger.Error("MinIO configuration is missing",
-							logger.String("path", c.Request().URL.Path),
-						)
-						return mocks.NewMockClient()
-					}
-
-					client, err := client.NewClientWithConfig(nil, nil, nil, minioConfig)
-					if err != nil {
-						logger.Error("Failed to create MinIO client",
-							logger.String("error", err.Error()),
-							logger.String("endpoint", minioConfig.Endpoint),
-						)
-						return mocks.NewMockClient()
-					}
-					return client
-				}
-
-				// Velero API인 경우 Kubernetes + MinIO 조합 클라이언트 생성
-				if strings.Contains(c.Request().URL.Path, "/velero/") {
-					// Velero는 Kubernetes 클라이언트를 사용하지만, MinIO 설정도 함께 전달
-					client, err := client.NewClientWithConfig(kubeConfig, kubeConfig, veleroConfig, minioConfig)
-					if err != nil {
-						logger.Error("Failed to create Velero client",
-							logger.String("error", err.Error()),
-						)
-						return mocks.NewMockClient()
-					}
-					return client
-				}
-
-				// 기본 Kubernetes/Helm API
-				client, err := client.NewClientWithConfig(kubeConfig, kubeConfig, veleroConfig, minioConfig)
-				if err != nil {
-					logger.Error("Failed to create client",
-						logger.String("error", err.Error()),
-					)
-					return mocks.NewMockClient()
-				}
-				return client
-			},
-		)
+		createClient := func() (client.Client, error) {
+			return h.newUnifiedClient(apiType, kubeConfig, veleroConfig, minioConfig)
+		}
+
+		key := cache.ClientCacheKey(kubeConfig, kubeConfig, veleroConfig, minioConfig, apiType)
+		if !ref.isEmpty() {
+			// 등록 ID를 참조한 경우 ID 기반 키로 캐시
+			key = ref.cacheKey(kubeConfig, minioConfig, apiType)
+		}
+
+		unifiedClient, err = h.clientCache.GetOrCreateWithKey(key, kubeConfig, veleroConfig, minioConfig, apiType, createClient)
+		if err != nil {
+			return h.HandleConnectionError(c, apiType, "client creation", err)
+		}
 	}
 
 	// 리소스 조회 (타임아웃 설정)
@@ -224,6 +226,12 @@ func (h *BaseHandler) HandleResourceClient(c echo.Context, cacheKey string,
 
 	resource, err := getResource(unifiedClient, ctx)
 	if err != nil {
+		// 인증 실패, TLS 오류, 연결 불가 등 연결 에러는 유형별 상태 코드로 응답
+		var connErr *client.ConnectionError
+		if errors.As(client.ClassifyConnectionError(apiType, err), &connErr) {
+			return h.HandleConnectionError(c, apiType, cacheKey, connErr)
+		}
+
 		// 에러 타입에 따른 상태 코드 결정
 		statusCode := http.StatusInternalServerError
 		errorCode := "RESOURCE_FETCH_FAILED"
@@ -246,40 +254,115 @@ func (h *BaseHandler) HandleResourceClient(c echo.Context, cacheKey string,
 		return response.RespondWithErrorModel(c, statusCode, errorCode, message, err.Error())
 	}
 
+	// 매니페스트 조회에서 ?output=yaml 요청 시 YAML로 응답
+	if allowYAML && c.QueryParam("output") == "yaml" {
+		return response.RespondWithYAML(c, http.StatusOK, resource)
+	}
+
 	return response.RespondWithData(c, http.StatusOK, resource)
 }
 
-// parseConfig : API 타입별 설정 파싱
+// ClusterLabel : HandleResourceClient에서 결정한 대상 클러스터 레이블 (등록 ID 또는 kubeconfig 지문)
+func (h *BaseHandler) ClusterLabel(c echo.Context) string {
+	label, _ := c.Get(clusterLabelContextKey).(string)
+	return label
+}
+
+// NewMinioClient : MinIO 설정으로 클라이언트 생성 (요청 본문을 설정 대신 데이터로 사용하는 스트리밍 API용)
+func (h *BaseHandler) NewMinioClient(minioConfig config.MinioConfig) (client.Client, error) {
+	if err := h.MinioValidator.ValidateMinioConfig(&minioConfig); err != nil {
+		return nil, &client.ConnectionError{
+			Service: "minio",
+			Kind:    client.ConnectionInvalidConfig,
+			Err:     fmt.Errorf("invalid minio configuration: %w", err),
+		}
+	}
+
+	if h.useMockClient {
+		// 테스트용 Mock 클라이언트 사용
+		return mocks.NewMockClient(), nil
+	}
+
+	return client.NewClientWithConfig(nil, nil, nil, minioConfig)
+}
+
+// NewVeleroClient : kubeconfig와 MinIO 설정으로 Velero 작업용 통합 클라이언트 생성 (본문을 직접 바인딩하는 Velero/마이그레이션 API용)
+// minioConfig가 nil이면 MinIO 없이 생성
+func (h *BaseHandler) NewVeleroClient(kubeConfig config.KubeConfig, minioConfig *config.MinioConfig) (client.Client, error) {
+	if h.useMockClient {
+		// 테스트용 Mock 클라이언트 사용
+		return mocks.NewMockClient(), nil
+	}
+
+	veleroConfig := config.VeleroConfig{KubeConfig: kubeConfig}
+	if minioConfig != nil {
+		veleroConfig.MinioConfig = *minioConfig
+	}
+	return client.NewClientWithConfig(&kubeConfig, &kubeConfig, &veleroConfig, minioConfig)
+}
+
+// newUnifiedClient : API 타입에 필요한 설정만으로 통합 클라이언트 생성
+// 요청하지 않은 서비스 설정은 nil로 전달하며, 생성 실패는 Mock 대체 없이 ConnectionError로 반환
+func (h *BaseHandler) newUnifiedClient(apiType string, kubeConfig config.KubeConfig,
+	veleroConfig config.VeleroConfig, minioConfig config.MinioConfig) (client.Client, error) {
+
+	var minioArg interface{}
+	if minioConfig.Endpoint != "" {
+		minioArg = minioConfig
+	}
+
+	switch apiType {
+	case "minio":
+		// MinIO API인 경우 minioConfig만 유효
+		if minioArg == nil {
+			return nil, &client.ConnectionError{
+				Service: "minio",
+				Kind:    client.ConnectionInvalidConfig,
+				Err:     errors.New("minio configuration is missing"),
+			}
+		}
+		return client.NewClientWithConfig(nil, nil, nil, minioArg)
+	case "velero":
+		// Velero API인 경우 Kubernetes + MinIO 조합 클라이언트 생성
+		return client.NewClientWithConfig(kubeConfig, kubeConfig, veleroConfig, minioArg)
+	default:
+		// 기본 Kubernetes/Helm API
+		return client.NewClientWithConfig(kubeConfig, kubeConfig, nil, minioArg)
+	}
+}
+
+// parseConfig : API 타입별 설정 파싱 (clusterId/storageId는 레지스트리에서 조회)
 func (h *BaseHandler) parseConfig(c echo.Context, cacheKey string) (
-	config.KubeConfig, config.VeleroConfig, config.MinioConfig, error) {
+	config.KubeConfig, config.VeleroConfig, config.MinioConfig, registryRef, error) {
 
 	var kubeConfig config.KubeConfig
 	var veleroConfig config.VeleroConfig
 	var minioConfig config.MinioConfig
+	var ref registryRef
 
 	// MinIO API인지 확인
 	isMinioAPI := strings.Contains(c.Request().URL.Path, "/minio/")
 
 	if isMinioAPI {
 		// MinIO API: minio 설정만 필요
-		return kubeConfig, veleroConfig, minioConfig, h.parseMinioConfig(c, &minioConfig)
+		return kubeConfig, veleroConfig, minioConfig, ref, h.parseMinioConfig(c, &minioConfig, &ref)
 	}
 
 	// Kubernetes/Helm API: kubeconfig만 필요
 	if strings.Contains(c.Request().URL.Path, "/kubernetes/") || strings.Contains(c.Request().URL.Path, "/helm/") {
-		return kubeConfig, veleroConfig, minioConfig, h.parseKubeConfig(c, &kubeConfig)
+		return kubeConfig, veleroConfig, minioConfig, ref, h.parseKubeConfig(c, &kubeConfig, &ref)
 	}
 
 	// Velero API: kubeconfig와 minio 설정 필요
 	if strings.Contains(c.Request().URL.Path, "/velero/") {
-		return kubeConfig, veleroConfig, minioConfig, h.parseVeleroConfig(c, &kubeConfig, &veleroConfig, &minioConfig)
+		return kubeConfig, veleroConfig, minioConfig, ref, h.parseVeleroConfig(c, &kubeConfig, &veleroConfig, &minioConfig, &ref)
 	}
 
-	return kubeConfig, veleroConfig, minioConfig, fmt.Errorf("unsupported API path: %s", c.Request().URL.Path)
+	return kubeConfig, veleroConfig, minioConfig, ref, fmt.Errorf("unsupported API path: %s", c.Request().URL.Path)
 }
 
 // parseKubeConfig : Kubernetes 설정 파싱 및 검증 (통합 파서 사용)
-func (h *BaseHandler) parseKubeConfig(c echo.Context, kubeConfig *config.KubeConfig) error {
+func (h *BaseHandler) parseKubeConfig(c echo.Context, kubeConfig *config.KubeConfig, ref *registryRef) error {
 	parser := NewKubeConfigParser(kubeConfig)
 
 	// 파싱
@@ -287,6 +370,12 @@ func (h *BaseHandler) parseKubeConfig(c echo.Context, kubeConfig *config.KubeCon
 		return err
 	}
 
+	// 등록된 클러스터 참조
+	ref.ClusterID = parser.ClusterID
+	if err := h.ResolveClusterRef(parser.ClusterID, kubeConfig); err != nil {
+		return err
+	}
+
 	// 검증
 	if err := parser.Validate(); err != nil {
 		return err
@@ -301,7 +390,7 @@ func (h *BaseHandler) parseKubeConfig(c echo.Context, kubeConfig *config.KubeCon
 }
 
 // parseMinioConfig : MinIO 설정 파싱 및 검증 (통합 파서 사용)
-func (h *BaseHandler) parseMinioConfig(c echo.Context, minioConfig *config.MinioConfig) error {
+func (h *BaseHandler) parseMinioConfig(c echo.Context, minioConfig *config.MinioConfig, ref *registryRef) error {
 	parser := NewMinioConfigParser(minioConfig)
 
 	// 파싱
@@ -309,6 +398,12 @@ func (h *BaseHandler) parseMinioConfig(c echo.Context, minioConfig *config.Minio
 		return err
 	}
 
+	// 등록된 스토리지 참조
+	ref.StorageID = parser.StorageID
+	if err := h.ResolveStorageRef(parser.StorageID, minioConfig); err != nil {
+		return err
+	}
+
 	// 검증
 	if err := parser.Validate(); err != nil {
 		return err
@@ -324,7 +419,7 @@ func (h *BaseHandler) parseMinioConfig(c echo.Context, minioConfig *config.Minio
 
 // parseVeleroConfig : Velero 설정 파싱 및 검증 (통합 파서 사용)
 func (h *BaseHandler) parseVeleroConfig(c echo.Context, kubeConfig *config.KubeConfig,
-	veleroConfig *config.VeleroConfig, minioConfig *config.MinioConfig) error {
+	veleroConfig *config.VeleroConfig, minioConfig *config.MinioConfig, ref *registryRef) error {
 
 	parser := NewVeleroConfigParser(kubeConfig, veleroConfig, minioConfig)
 
@@ -333,6 +428,17 @@ func (h *BaseHandler) parseVeleroConfig(c echo.Context, kubeConfig *config.KubeC
 		return err
 	}
 
+	// 등록된 클러스터/스토리지 참조
+	ref.ClusterID = parser.ClusterID
+	ref.StorageID = parser.StorageID
+	if err := h.ResolveClusterRef(parser.ClusterID, kubeConfig); err != nil {
+		return err
+	}
+	if err := h.ResolveStorageRef(parser.StorageID, minioConfig); err != nil {
+		return err
+	}
+	veleroConfig.KubeConfig = *kubeConfig
+
 	// 검증
 	if err := parser.Validate(); err != nil {
 		return err
@@ -366,7 +472,8 @@ type ConfigParser interface {
 
 // MinioConfigParser : MinIO 설정 파서
 type MinioConfigParser struct {
-	config *config.MinioConfig
+	config    *config.MinioConfig
+	StorageID string // 등록된 스토리지 ID (본문 또는 storageId 쿼리 파라미터)
 }
 
 // NewMinioConfigParser : MinIO 설정 파서 생성
@@ -381,12 +488,15 @@ func (p *MinioConfigParser) Parse(c echo.Context) error {
 		AccessKey string `json:"accessKey"`
 		SecretKey string `json:"secretKey"`
 		UseSSL    bool   `json:"useSSL"`
+		StorageID string `json:"storageId"`
 	}
 
 	if err := c.Bind(&req); err != nil {
 		return fmt.Errorf("invalid request body: %w", err)
 	}
 
+	p.StorageID = firstNonEmpty(req.StorageID, c.QueryParam("storageId"))
+
 	// MinIO 설정 매핑
 	p.config.Endpoint = req.Endpoint
 	p.config.AccessKey = req.AccessKey
@@ -413,7 +523,8 @@ func (p *MinioConfigParser) Validate() error {
 
 // KubeConfigParser : Kubernetes 설정 파서
 type KubeConfigParser struct {
-	config *config.KubeConfig
+	config    *config.KubeConfig
+	ClusterID string // 등록된 클러스터 ID (본문 또는 clusterId 쿼리 파라미터)
 }
 
 // NewKubeConfigParser : Kubernetes 설정 파서 생성
@@ -425,6 +536,7 @@ func NewKubeConfigParser(config *config.KubeConfig) *KubeConfigParser {
 func (p *KubeConfigParser) Parse(c echo.Context) error {
 	var req struct {
 		KubeConfig string `json:"kubeconfig"`
+		ClusterID  string `json:"clusterId"`
 	}
 
 	if err := c.Bind(&req); err != nil {
@@ -432,6 +544,7 @@ func (p *KubeConfigParser) Parse(c echo.Context) error {
 	}
 
 	p.config.KubeConfig = req.KubeConfig
+	p.ClusterID = firstNonEmpty(req.ClusterID, c.QueryParam("clusterId"))
 	return nil
 }
 
@@ -448,6 +561,8 @@ type VeleroConfigParser struct {
 	kubeConfig   *config.KubeConfig
 	veleroConfig *config.VeleroConfig
 	minioConfig  *config.MinioConfig
+	ClusterID    string // 등록된 클러스터 ID (본문 또는 clusterId 쿼리 파라미터)
+	StorageID    string // 등록된 스토리지 ID (본문 또는 storageId 쿼리 파라미터)
 }
 
 // NewVeleroConfigParser : Velero 설정 파서 생성
@@ -465,13 +580,18 @@ func (p *VeleroConfigParser) Parse(c echo.Context) error {
 		KubeConfig struct {
 			KubeConfig string `json:"kubeconfig"`
 		} `json:"kubeconfig"`
-		Minio config.MinioConfig `json:"minio"`
+		Minio     config.MinioConfig `json:"minio"`
+		ClusterID string             `json:"clusterId"`
+		StorageID string             `json:"storageId"`
 	}
 
 	if err := c.Bind(&req); err != nil {
 		return fmt.Errorf("invalid request body: %w", err)
 	}
 
+	p.ClusterID = firstNonEmpty(req.ClusterID, c.QueryParam("clusterId"))
+	p.StorageID = firstNonEmpty(req.StorageID, c.QueryParam("storageId"))
+
 	// Kubernetes 설정
 	p.kubeConfig.KubeConfig = req.KubeConfig.KubeConfig
 	p.veleroConfig.KubeConfig = *p.kubeConfig
@@ -504,14 +624,28 @@ func (p *VeleroConfigParser) Validate() error {
 
 // ===== 공통 에러 처리 함수들 =====
 
-// handleConfigError : 설정 파싱 에러를 적절한 HTTP 응답으로 변환
-func (h *BaseHandler) handleConfigError(c echo.Context, err error) error {
+// HandleConfigError : 설정 파싱 에러를 적절한 HTTP 응답으로 변환
+func (h *BaseHandler) HandleConfigError(c echo.Context, err error) error {
 	if err == nil {
 		return nil
 	}
 
 	errorMsg := err.Error()
 
+	// 등록 ID 참조 에러
+	if errors.Is(err, registry.ErrNotFound) {
+		return response.RespondWithErrorModel(c, http.StatusNotFound,
+			"REGISTRY_ENTRY_NOT_FOUND",
+			"Registered cluster or storage not found",
+			errorMsg)
+	}
+	if errors.Is(err, ErrRegistryUnavailable) {
+		return response.RespondWithErrorModel(c, http.StatusServiceUnavailable,
+			"REGISTRY_UNAVAILABLE",
+			"Cluster/storage registry is not available",
+			errorMsg)
+	}
+
 	// 에러 타입별 처리
 	if strings.Contains(errorMsg, "template variables") {
 		return response.RespondWithErrorModel(c, http.StatusBadRequest,
@@ -604,6 +738,65 @@ func (h *BaseHandler) GetConfigBool(key string, defaultValue bool) bool {
 	return config.GetBoolOrDefault(key, defaultValue)
 }
 
+// NewJobManager : 환경변수 설정에 따라 작업 관리자 생성
+// 저장소 초기화에 실패하면 메모리 관리자로 대체
+func (h *BaseHandler) NewJobManager(name string, workers int) job.JobManager {
+	opts := job.ManagerOptions{
+		Name:      name,
+		Workers:   workers,
+		StoreType: h.GetConfigValue("JOB_STORE_TYPE", job.StoreTypeMemory),
+		StorePath: h.GetConfigValue("JOB_STORE_PATH", "./data/jobs"),
+		Retention: job.RetentionPolicy{
+			MaxAge:  h.GetConfigDuration("JOB_RETENTION_PERIOD", 7*24*time.Hour),
+			MaxJobs: h.GetConfigInt("JOB_MAX_COUNT", 1000),
+		},
+		PruneInterval: h.GetConfigDuration("JOB_PRUNE_INTERVAL", 10*time.Minute),
+	}
+	if opts.StoreType == job.StoreTypeFile {
+		opts.Sealer = h.newJobStoreSealer(name)
+	}
+
+	manager, err := job.NewJobManager(opts)
+	if err != nil {
+		logger.Error("Failed to create job manager, falling back to memory store",
+			logger.String("name", name),
+			logger.String("store_type", opts.StoreType),
+			logger.String("error", err.Error()),
+		)
+		opts.StoreType = job.StoreTypeMemory
+		manager, _ = job.NewJobManager(opts)
+	}
+
+	return manager
+}
+
+// newJobStoreSealer : 작업 저장소 복구 정보 암호화 설정
+// JOB_STORE_ENCRYPTION_KEY가 없으면 REGISTRY_ENCRYPTION_KEY를 사용하며,
+// 둘 다 없으면 kubeconfig를 디스크에 기록하지 않음 (재시작 후 Velero 작업 재연결 불가)
+func (h *BaseHandler) newJobStoreSealer(name string) job.Sealer {
+	value := h.GetConfigValue("JOB_STORE_ENCRYPTION_KEY", h.GetConfigValue("REGISTRY_ENCRYPTION_KEY", ""))
+	if value == "" {
+		logger.Warn("No job store encryption key configured; kubeconfigs will not be persisted and interrupted jobs cannot be re-attached after restart",
+			logger.String("name", name),
+		)
+		return nil
+	}
+
+	key, err := registry.ParseKey(value)
+	if err == nil {
+		var sealer *registry.Cipher
+		if sealer, err = registry.NewCipher(key); err == nil {
+			return sealer
+		}
+	}
+
+	logger.Error("Invalid job store encryption key; kubeconfigs will not be persisted",
+		logger.String("name", name),
+		logger.String("error", err.Error()),
+	)
+	return nil
+}
+
 // ===== Query Parameter 처리 함수들 =====
 
 // ResolveNamespace : 네임스페이스 쿼리 파라미터 결정
@@ -695,3 +888,13 @@ func (h *BaseHandler) determineApiTypeFromPath(path string) string {
 	// 기본값 (대부분의 경우 Kubernetes)
 	return "kubernetes"
 }
+
+// firstNonEmpty : 비어 있지 않은 첫 번째 값 반환
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
@@ -2,13 +2,17 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/registry"
 	"github.com/taking/kubemigrate/pkg/config"
 )
 
@@ -382,8 +386,8 @@ func TestVeleroConfigParser_Validate(t *testing.T) {
 	}
 }
 
-// TestBaseHandler_handleConfigError : 공통 에러 처리 함수 테스트
-func TestBaseHandler_handleConfigError(t *testing.T) {
+// TestBaseHandler_HandleConfigError : 공통 에러 처리 함수 테스트
+func TestBaseHandler_HandleConfigError(t *testing.T) {
 	baseHandler := &BaseHandler{}
 
 	tests := []struct {
@@ -439,7 +443,7 @@ func TestBaseHandler_handleConfigError(t *testing.T) {
 			c := e.NewContext(req, rec)
 
 			// When
-			err := baseHandler.handleConfigError(c, tt.error)
+			err := baseHandler.HandleConfigError(c, tt.error)
 
 			// Then
 			if err != nil {
@@ -463,3 +467,73 @@ func TestBaseHandler_handleConfigError(t *testing.T) {
 		})
 	}
 }
+
+// TestBaseHandler_parseConfigWithRegistry : clusterId/storageId 참조 설정 파싱 테스트
+func TestBaseHandler_parseConfigWithRegistry(t *testing.T) {
+	baseHandler := NewBaseHandlerWithMock(nil)
+	ctx := context.Background()
+
+	if _, err := baseHandler.Registry.CreateCluster(ctx, registry.Cluster{ID: "prod-a", KubeConfig: "apiVersion: v1\nkind: Config"}); err != nil {
+		t.Fatalf("CreateCluster() error = %v", err)
+	}
+	if _, err := baseHandler.Registry.CreateStorage(ctx, registry.Storage{ID: "backup", Minio: config.MinioConfig{
+		Endpoint: "localhost:9000", AccessKey: "minioadmin", SecretKey: "minioadmin123",
+	}}); err != nil {
+		t.Fatalf("CreateStorage() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		path       string
+		body       string
+		wantErr    error
+		wantKube   bool
+		wantMinio  bool
+		wantKeyHas string
+	}{
+		{name: "query clusterId", path: "/api/v1/kubernetes/pods?clusterId=prod-a", wantKube: true, wantKeyHas: "|cluster=prod-a|"},
+		{name: "body storageId", path: "/api/v1/minio/buckets", body: `{"storageId":"backup"}`, wantMinio: true, wantKeyHas: "|storage=backup|"},
+		{name: "velero ids", path: "/api/v1/velero/backups", body: `{"clusterId":"prod-a","storageId":"backup"}`, wantKube: true, wantMinio: true, wantKeyHas: "|cluster=prod-a|storage=backup|"},
+		{name: "unknown cluster", path: "/api/v1/helm/charts?clusterId=missing", wantErr: registry.ErrNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, bytes.NewReader([]byte(tt.body)))
+			if tt.body != "" {
+				req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			}
+			c := echo.New().NewContext(req, httptest.NewRecorder())
+
+			kubeConfig, _, minioConfig, ref, err := baseHandler.parseConfig(c, "test")
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Expected error %v, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseConfig() error = %v", err)
+			}
+
+			if (kubeConfig.KubeConfig != "") != tt.wantKube {
+				t.Errorf("Unexpected kubeconfig resolution: %q", kubeConfig.KubeConfig)
+			}
+			if (minioConfig.SecretKey == "minioadmin123") != tt.wantMinio {
+				t.Errorf("Unexpected minio resolution: %+v", minioConfig)
+			}
+			apiType := baseHandler.determineApiTypeFromPath(c.Request().URL.Path)
+			if key := ref.cacheKey(kubeConfig, minioConfig, apiType); !strings.Contains(key, tt.wantKeyHas) {
+				t.Errorf("Expected cache key to contain %q, got %q", tt.wantKeyHas, key)
+			}
+		})
+	}
+
+	// 인라인 설정과 등록 ID를 함께 지정하면 에러
+	req := httptest.NewRequest(http.MethodPost, "/api/v1/kubernetes/pods",
+		bytes.NewReader([]byte(`{"kubeconfig":"apiVersion: v1","clusterId":"prod-a"}`)))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	if _, _, _, _, err := baseHandler.parseConfig(echo.New().NewContext(req, httptest.NewRecorder()), "test"); err == nil {
+		t.Errorf("Expected error when both kubeconfig and clusterId are given")
+	}
+}
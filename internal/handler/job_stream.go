@@ -0,0 +1,211 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/job"
+	"github.com/taking/kubemigrate/internal/response"
+)
+
+// SSE 이벤트 종류
+const (
+	jobEventStatus = "status" // 작업 상태/진행률 변경 (로그 제외 JobInfo)
+	jobEventLog    = "log"    // 작업 로그 한 줄
+	jobEventEnd    = "end"    // 작업 종료 또는 삭제로 스트림 종료
+)
+
+// jobLogEvent : 로그 이벤트 데이터
+type jobLogEvent struct {
+	Index int    `json:"index"`
+	Line  string `json:"line"`
+}
+
+// DefaultStreamIdleTimeout : 스트리밍 연결의 기본 유휴 제한 시간 (STREAM_IDLE_TIMEOUT)
+const DefaultStreamIdleTimeout = 2 * time.Minute
+
+// IdleDeadline : 장시간 스트리밍(SSE, 객체 업로드/다운로드)의 연결 데드라인을 읽기/쓰기마다 유휴 제한 시간만큼 연장
+//
+// 서버 ReadTimeout/WriteTimeout은 요청 전체 시간에 적용되어 대용량 전송을 끊으므로 대신 사용하며,
+// 데이터가 오가지 않는 연결은 유휴 제한 시간이 지나면 끊깁니다. 요청 타임아웃 미들웨어는
+// isStreamingRequest로 제외되며, 전체 상한은 요청 컨텍스트나 작업 컨텍스트로 처리합니다.
+type IdleDeadline struct {
+	controller *http.ResponseController
+	idle       time.Duration
+}
+
+// NewIdleDeadline : 유휴 데드라인 생성 후 즉시 적용 (STREAM_IDLE_TIMEOUT, 기본 2분)
+func (h *BaseHandler) NewIdleDeadline(c echo.Context) *IdleDeadline {
+	idle := h.GetConfigDuration("STREAM_IDLE_TIMEOUT", DefaultStreamIdleTimeout)
+	if idle <= 0 {
+		idle = DefaultStreamIdleTimeout
+	}
+
+	d := &IdleDeadline{
+		controller: http.NewResponseController(c.Response().Writer),
+		idle:       idle,
+	}
+	d.Extend()
+	return d
+}
+
+// Extend : 읽기/쓰기 데드라인을 지금부터 유휴 제한 시간 뒤로 연장
+// 응답 전송 중에도 읽기 데드라인이 지나면 서버가 요청 컨텍스트를 취소하므로 두 데드라인을 함께 연장
+func (d *IdleDeadline) Extend() {
+	deadline := time.Now().Add(d.idle)
+	_ = d.controller.SetReadDeadline(deadline)
+	_ = d.controller.SetWriteDeadline(deadline)
+}
+
+// Reader : 읽을 때마다 데드라인을 연장하는 요청 본문
+func (d *IdleDeadline) Reader(r io.Reader) io.Reader {
+	return &idleDeadlineReader{reader: r, deadline: d}
+}
+
+// Writer : 쓸 때마다 데드라인을 연장하는 응답 본문
+func (d *IdleDeadline) Writer(w io.Writer) io.Writer {
+	return &idleDeadlineWriter{writer: w, deadline: d}
+}
+
+// idleDeadlineReader : 읽기 전 데드라인 연장
+type idleDeadlineReader struct {
+	reader   io.Reader
+	deadline *IdleDeadline
+}
+
+func (r *idleDeadlineReader) Read(p []byte) (int, error) {
+	r.deadline.Extend()
+	return r.reader.Read(p)
+}
+
+// idleDeadlineWriter : 쓰기 전 데드라인 연장
+type idleDeadlineWriter struct {
+	writer   io.Writer
+	deadline *IdleDeadline
+}
+
+func (w *idleDeadlineWriter) Write(p []byte) (int, error) {
+	w.deadline.Extend()
+	return w.writer.Write(p)
+}
+
+// StreamJob : 작업 진행 상황과 로그를 Server-Sent Events로 전송
+//
+// 이벤트 ID는 지금까지 전송된 로그 줄 수이며, 재연결 시 Last-Event-ID 헤더
+// (또는 lastEventId 쿼리)로 전달하면 이후 로그부터 다시 전송합니다.
+// 상태 이벤트는 연결 시 항상 최신 상태로 한 번 전송됩니다.
+func (h *BaseHandler) StreamJob(c echo.Context, manager job.JobManager, jobID string) error {
+	// 구독 후 조회해야 그 사이의 변경을 놓치지 않음
+	updates, unsubscribe := manager.Subscribe(jobID)
+	defer unsubscribe()
+
+	current, exists := manager.Snapshot(jobID)
+	if !exists {
+		return response.RespondWithErrorModel(c, http.StatusNotFound, "JOB_NOT_FOUND", fmt.Sprintf("job not found: %s", jobID), "")
+	}
+
+	cursor := resolveLastEventID(c)
+	if cursor > len(current.Logs) {
+		cursor = len(current.Logs)
+	}
+
+	res := c.Response()
+
+	// 서버 WriteTimeout 대신 이벤트/keep-alive 전송마다 유휴 데드라인 연장
+	deadline := h.NewIdleDeadline(c)
+
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set(echo.HeaderConnection, "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no") // 프록시 버퍼링 비활성화
+	res.WriteHeader(http.StatusOK)
+
+	heartbeat := time.NewTicker(h.GetConfigDuration("JOB_STREAM_HEARTBEAT", 15*time.Second))
+	defer heartbeat.Stop()
+
+	var lastStatus *job.JobInfo
+	for {
+		deadline.Extend()
+
+		// 1. 커서 이후 로그 전송
+		for ; cursor < len(current.Logs); cursor++ {
+			if err := writeSSE(res, cursor+1, jobEventLog, jobLogEvent{Index: cursor, Line: current.Logs[cursor]}); err != nil {
+				return nil
+			}
+		}
+
+		// 2. 상태가 바뀐 경우에만 상태 전송
+		if statusChanged(lastStatus, current) {
+			status := *current
+			status.Logs = nil
+			if err := writeSSE(res, cursor, jobEventStatus, status); err != nil {
+				return nil
+			}
+			lastStatus = current
+		}
+		res.Flush()
+
+		if current.Status.IsFinished() {
+			_ = writeSSE(res, cursor, jobEventEnd, map[string]interface{}{"jobId": jobID, "status": current.Status})
+			res.Flush()
+			return nil
+		}
+
+		// 3. 다음 변경 대기
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case <-heartbeat.C:
+			deadline.Extend()
+			if _, err := fmt.Fprint(res, ": keep-alive\n\n"); err != nil {
+				return nil
+			}
+			res.Flush()
+			continue
+		case <-updates:
+		}
+
+		if current, exists = manager.Snapshot(jobID); !exists {
+			_ = writeSSE(res, cursor, jobEventEnd, map[string]interface{}{"jobId": jobID, "status": "deleted"})
+			res.Flush()
+			return nil
+		}
+	}
+}
+
+// resolveLastEventID : 재연결 커서 조회 (Last-Event-ID 헤더 우선, 없으면 lastEventId 쿼리)
+func resolveLastEventID(c echo.Context) int {
+	value := c.Request().Header.Get("Last-Event-ID")
+	if value == "" {
+		value = c.QueryParam("lastEventId")
+	}
+
+	cursor, err := strconv.Atoi(value)
+	if err != nil || cursor < 0 {
+		return 0
+	}
+	return cursor
+}
+
+// statusChanged : 상태 이벤트 전송 필요 여부 확인
+func statusChanged(prev, current *job.JobInfo) bool {
+	return prev == nil ||
+		prev.Status != current.Status ||
+		prev.Progress != current.Progress ||
+		prev.Message != current.Message
+}
+
+// writeSSE : SSE 이벤트 한 건 기록
+func writeSSE(res *echo.Response, id int, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(res, "id: %d\nevent: %s\ndata: %s\n\n", id, event, payload)
+	return err
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/job"
+)
+
+// TestBaseHandler_StreamJob : 작업 진행 SSE 스트리밍 및 Last-Event-ID 재개 테스트
+func TestBaseHandler_StreamJob(t *testing.T) {
+	workerPool := job.NewWorkerPool(1)
+	defer workerPool.Close()
+	h := NewBaseHandlerWithMock(workerPool)
+
+	manager := job.NewMemoryJobManagerWithWorkers(1)
+	defer manager.Close()
+
+	manager.CreateJob("restore-create-1", nil)
+	manager.AddJobLog("restore-create-1", "first")
+	manager.AddJobLog("restore-create-1", "second")
+
+	// 스트림 시작 후 작업 진행 및 완료
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		manager.UpdateJobStatus("restore-create-1", job.JobStatusProcessing, 50, "Waiting for restore...")
+		manager.AddJobLog("restore-create-1", "third")
+		manager.CompleteJob("restore-create-1", map[string]interface{}{"restoreName": "r1"})
+	}()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/velero/jobs/restore-create-1/stream", nil)
+	req.Header.Set("Last-Event-ID", "1")
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if err := h.StreamJob(c, manager, "restore-create-1"); err != nil {
+		t.Fatalf("StreamJob() error = %v", err)
+	}
+
+	body := rec.Body.String()
+	if rec.Header().Get(echo.HeaderContentType) != "text/event-stream" {
+		t.Errorf("Expected text/event-stream content type, got %q", rec.Header().Get(echo.HeaderContentType))
+	}
+	if strings.Contains(body, "first") {
+		t.Error("Expected logs before Last-Event-ID to be skipped")
+	}
+	for _, want := range []string{"id: 2\nevent: log", "second", "third", `"status":"completed"`, "event: end"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Expected stream to contain %q, got:\n%s", want, body)
+		}
+	}
+}
+
+// TestBaseHandler_StreamJobNotFound : 존재하지 않는 작업 스트리밍 테스트
+func TestBaseHandler_StreamJobNotFound(t *testing.T) {
+	workerPool := job.NewWorkerPool(1)
+	defer workerPool.Close()
+	h := NewBaseHandlerWithMock(workerPool)
+
+	manager := job.NewMemoryJobManager()
+	defer manager.Close()
+
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/api/v1/helm/jobs/missing/stream", nil)
+	rec := httptest.NewRecorder()
+	c := e.NewContext(req, rec)
+
+	if err := h.StreamJob(c, manager, "missing"); err != nil {
+		t.Fatalf("StreamJob() error = %v", err)
+	}
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("Expected status %d, got %d", http.StatusNotFound, rec.Code)
+	}
+}
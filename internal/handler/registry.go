@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/logger"
+	"github.com/taking/kubemigrate/internal/registry"
+	"github.com/taking/kubemigrate/internal/validator"
+	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/constants"
+	"github.com/taking/kubemigrate/pkg/types"
+	pkgutils "github.com/taking/kubemigrate/pkg/utils"
+)
+
+// ErrRegistryUnavailable : 레지스트리 초기화 실패 시 등록 ID 참조 에러
+var ErrRegistryUnavailable = errors.New("cluster/storage registry is not available")
+
+// 레지스트리 캐시 키 구분자
+const (
+	RegistryKindCluster = "cluster"
+	RegistryKindStorage = "storage"
+)
+
+// registryRef : 요청에서 참조한 등록 ID
+type registryRef struct {
+	ClusterID string
+	StorageID string
+}
+
+// isEmpty : 등록 ID 참조가 없는지 여부
+func (r registryRef) isEmpty() bool {
+	return r.ClusterID == "" && r.StorageID == ""
+}
+
+// clusterLabel : 메트릭에서 대상 클러스터를 구분하는 값 (등록 ID 또는 kubeconfig 지문)
+func (r registryRef) clusterLabel(kubeConfig config.KubeConfig) string {
+	switch {
+	case r.ClusterID != "":
+		return r.ClusterID
+	case kubeConfig.KubeConfig != "":
+		return validator.KubeConfigFingerprint(kubeConfig.KubeConfig)
+	default:
+		return ""
+	}
+}
+
+// cacheKey : 등록 ID 기반 클라이언트 캐시 키
+// 인라인으로 전달된 설정은 해시로 구분하며, 등록 항목 변경 시 InvalidateRegistryClients로 정리
+func (r registryRef) cacheKey(kubeConfig config.KubeConfig, minioConfig config.MinioConfig, apiType string) string {
+	parts := []string{"registry"}
+
+	switch {
+	case r.ClusterID != "":
+		parts = append(parts, RegistryKindCluster+"="+r.ClusterID)
+	case kubeConfig.KubeConfig != "":
+		parts = append(parts, "kube="+pkgutils.GenerateCompositeCacheKey(kubeConfig.KubeConfig))
+	}
+
+	switch {
+	case r.StorageID != "":
+		parts = append(parts, RegistryKindStorage+"="+r.StorageID)
+	case minioConfig.Endpoint != "":
+		parts = append(parts, "minio="+pkgutils.GenerateCompositeCacheKey(
+			minioConfig.Endpoint, minioConfig.AccessKey, minioConfig.SecretKey))
+	}
+
+	return strings.Join(append(parts, apiType), "|")
+}
+
+// newRegistry : 환경변수 설정에 따라 레지스트리 생성
+// 초기화에 실패하면 nil을 반환하며, 이 경우 등록 ID를 참조하는 요청은 에러 처리
+func (h *BaseHandler) newRegistry() *registry.Registry {
+	opts := registry.Options{
+		StoreType:       h.GetConfigValue("REGISTRY_STORE_TYPE", registry.StoreTypeFile),
+		StorePath:       h.GetConfigValue("REGISTRY_STORE_PATH", "./data/registry/registry.enc"),
+		SecretNamespace: h.GetConfigValue("REGISTRY_SECRET_NAMESPACE", "kubemigrate"),
+		SecretName:      h.GetConfigValue("REGISTRY_SECRET_NAME", "kubemigrate-registry"),
+		EncryptionKey:   h.GetConfigValue("REGISTRY_ENCRYPTION_KEY", ""),
+		KeyFile:         h.GetConfigValue("REGISTRY_KEY_FILE", ""),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), constants.DefaultRequestTimeout)
+	defer cancel()
+
+	reg, generated, err := registry.NewFromOptions(ctx, opts)
+	if err != nil {
+		if errors.Is(err, registry.ErrKeyRequired) || errors.Is(err, registry.ErrKeyInStoreDir) {
+			// 암호화 파일 옆에 키를 자동 생성하지 않으므로 키 설정 없이는 레지스트리를 비활성화
+			logger.Error("Registry encryption key is not configured; set REGISTRY_ENCRYPTION_KEY or REGISTRY_KEY_FILE outside the store directory",
+				logger.String("store_path", opts.StorePath),
+				logger.String("key_file", opts.KeyFile),
+				logger.String("error", err.Error()),
+			)
+			return nil
+		}
+		logger.Error("Failed to initialize cluster/storage registry",
+			logger.String("store_type", opts.StoreType),
+			logger.String("error", err.Error()),
+		)
+		return nil
+	}
+
+	if generated {
+		logger.Warn("Generated a new registry encryption key file; set REGISTRY_ENCRYPTION_KEY to manage the key explicitly",
+			logger.String("key_file", opts.KeyFile),
+		)
+	}
+
+	return reg
+}
+
+// newMockRegistry : 메모리 레지스트리 생성 (테스트용)
+func newMockRegistry() *registry.Registry {
+	reg, _, err := registry.NewFromOptions(context.Background(), registry.Options{StoreType: registry.StoreTypeMemory})
+	if err != nil {
+		return nil
+	}
+	return reg
+}
+
+// ResolveClusterRef : 등록된 클러스터 ID로 kubeconfig 설정 (ID가 비어 있으면 무시)
+func (h *BaseHandler) ResolveClusterRef(clusterID string, kubeConfig *config.KubeConfig) error {
+	if clusterID == "" {
+		return nil
+	}
+	if kubeConfig.KubeConfig != "" {
+		return fmt.Errorf("specify either kubeconfig or clusterId, not both")
+	}
+	if h.Registry == nil {
+		return ErrRegistryUnavailable
+	}
+
+	cluster, err := h.Registry.GetCluster(clusterID)
+	if err != nil {
+		return err
+	}
+
+	kubeConfig.KubeConfig = cluster.KubeConfig
+	return nil
+}
+
+// ResolveStorageRef : 등록된 스토리지 ID로 MinIO 설정 (ID가 비어 있으면 무시)
+func (h *BaseHandler) ResolveStorageRef(storageID string, minioConfig *config.MinioConfig) error {
+	if storageID == "" {
+		return nil
+	}
+	if minioConfig.Endpoint != "" {
+		return fmt.Errorf("specify either minio configuration or storageId, not both")
+	}
+	if h.Registry == nil {
+		return ErrRegistryUnavailable
+	}
+
+	storage, err := h.Registry.GetStorage(storageID)
+	if err != nil {
+		return err
+	}
+
+	*minioConfig = storage.Minio
+	return nil
+}
+
+// ResolveRegistryRefs : 본문 또는 쿼리 파라미터(clusterId/storageId)의 등록 ID로 kubeconfig와 MinIO 설정을 채움
+// 본문을 직접 바인딩하는 핸들러에서 사용하며, minioConfig가 nil이면 storageId는 해석하지 않음
+func (h *BaseHandler) ResolveRegistryRefs(c echo.Context, ref types.RegistryRef, kubeConfig *config.KubeConfig, minioConfig *config.MinioConfig) error {
+	resolved := registryRef{
+		ClusterID: firstNonEmpty(ref.ClusterID, c.QueryParam("clusterId")),
+		StorageID: firstNonEmpty(ref.StorageID, c.QueryParam("storageId")),
+	}
+	if err := h.ResolveClusterRef(resolved.ClusterID, kubeConfig); err != nil {
+		return err
+	}
+	if minioConfig != nil {
+		if err := h.ResolveStorageRef(resolved.StorageID, minioConfig); err != nil {
+			return err
+		}
+	}
+
+	// 메트릭에서 대상 클러스터를 구분할 수 있도록 저장
+	c.Set(clusterLabelContextKey, resolved.clusterLabel(*kubeConfig))
+	return nil
+}
+
+// InvalidateRegistryClients : 등록 항목 변경/삭제 시 해당 ID로 캐시된 클라이언트 정리
+func (h *BaseHandler) InvalidateRegistryClients(kind, id string) int {
+	return h.clientCache.CleanByPattern(fmt.Sprintf("|%s=%s|", kind, id))
+}
@@ -0,0 +1,164 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/pkg/client"
+)
+
+// kubeConfigFor : 지정한 API 서버 주소를 가리키는 kubeconfig 생성
+func kubeConfigFor(server string) string {
+	return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+    insecure-skip-tls-verify: true
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: test-token
+`, server)
+}
+
+// statusServer : 모든 요청에 지정한 상태 코드의 Kubernetes Status를 반환하는 API 서버
+func statusServer(t *testing.T, code int, reason string) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(code)
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"kind": "Status", "apiVersion": "v1", "status": "Failure",
+			"reason": reason, "code": code, "message": strings.ToLower(reason),
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// TestBaseHandler_HandleResourceClientConnectionErrors : 클라이언트 생성/연결 실패 시 Mock 대체 없이 유형별 상태 코드 응답 테스트
+func TestBaseHandler_HandleResourceClientConnectionErrors(t *testing.T) {
+	tests := []struct {
+		name         string
+		kubeConfig   string
+		expectedCode int
+		expectedErr  string
+	}{
+		{
+			name:         "invalid kubeconfig",
+			kubeConfig:   "apiVersion: v1\nclusters: [broken",
+			expectedCode: http.StatusBadRequest,
+			expectedErr:  "CONNECTION_INVALID_CONFIG",
+		},
+		{
+			name:         "unauthorized",
+			kubeConfig:   kubeConfigFor(statusServer(t, http.StatusUnauthorized, "Unauthorized").URL),
+			expectedCode: http.StatusUnauthorized,
+			expectedErr:  "CONNECTION_AUTH_FAILED",
+		},
+		{
+			name:         "forbidden",
+			kubeConfig:   kubeConfigFor(statusServer(t, http.StatusForbidden, "Forbidden").URL),
+			expectedCode: http.StatusForbidden,
+			expectedErr:  "CONNECTION_FORBIDDEN",
+		},
+		{
+			name:         "unreachable",
+			kubeConfig:   kubeConfigFor("https://127.0.0.1:1"),
+			expectedCode: http.StatusBadGateway,
+			expectedErr:  "CONNECTION_UNREACHABLE",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 실제 클라이언트 생성 경로 사용
+			baseHandler := NewBaseHandlerWithMock(nil)
+			baseHandler.useMockClient = false
+
+			body, _ := json.Marshal(map[string]string{"kubeconfig": tt.kubeConfig})
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/kubernetes/health", strings.NewReader(string(body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			called := false
+			err := baseHandler.HandleResourceClient(c, "kubernetes-health", func(cl client.Client, ctx context.Context) (interface{}, error) {
+				called = true
+				return nil, cl.Kubernetes().HealthCheck(ctx)
+			})
+			if err != nil {
+				t.Fatalf("HandleResourceClient() error = %v", err)
+			}
+
+			if rec.Code != tt.expectedCode {
+				t.Errorf("Expected status %d, got %d: %s", tt.expectedCode, rec.Code, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.expectedErr) {
+				t.Errorf("Expected error code %s, got %s", tt.expectedErr, rec.Body.String())
+			}
+			if tt.expectedCode == http.StatusBadRequest {
+				if called {
+					t.Error("Resource function should not be called when client creation fails")
+				}
+				if size := baseHandler.clientCache.Stats()["total_items"]; size != 0 {
+					t.Errorf("Failed client creation should not be cached, cache size = %v", size)
+				}
+			}
+		})
+	}
+}
+
+// TestBaseHandler_OutputFormat : ?output=yaml은 매니페스트 조회(HandleManifestClient)에만 적용되는지 테스트
+func TestBaseHandler_OutputFormat(t *testing.T) {
+	tests := []struct {
+		name        string
+		manifest    bool
+		contentType string
+	}{
+		{name: "resource client ignores output", manifest: false, contentType: echo.MIMEApplicationJSON},
+		{name: "manifest client responds with yaml", manifest: true, contentType: "application/yaml"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseHandler := NewBaseHandlerWithMock(nil)
+
+			body, _ := json.Marshal(map[string]string{"kubeconfig": "apiVersion: v1\nkind: Config"})
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/kubernetes/pods?output=yaml", strings.NewReader(string(body)))
+			req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(req, rec)
+
+			getResource := func(client.Client, context.Context) (interface{}, error) {
+				return map[string]string{"name": "test"}, nil
+			}
+
+			handle := baseHandler.HandleResourceClient
+			if tt.manifest {
+				handle = baseHandler.HandleManifestClient
+			}
+			if err := handle(c, "resources", getResource); err != nil {
+				t.Fatalf("handler error = %v", err)
+			}
+
+			if got := rec.Header().Get(echo.HeaderContentType); !strings.HasPrefix(got, tt.contentType) {
+				t.Errorf("Expected content type %s, got %s (body: %s)", tt.contentType, got, rec.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package handler
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/auth"
+	"github.com/taking/kubemigrate/internal/middleware"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// lastAppliedAnnotation : kubectl apply가 원본 매니페스트(Secret 데이터 포함)를 저장하는 주석
+const lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+
+// secretDataRole : Secret 데이터(data/stringData)를 조회할 수 있는 최소 역할
+const secretDataRole = auth.RoleOperator
+
+// CanReadSecretData : 요청 주체가 Secret 데이터를 조회할 수 있는지 여부 (viewer는 불가)
+func (h *BaseHandler) CanReadSecretData(c echo.Context) bool {
+	return middleware.HasRole(c, secretDataRole)
+}
+
+// RedactSecretData : Secret 객체/목록/매니페스트에서 data, stringData, last-applied 주석을 제거한 사본 반환
+// Secret이 아니면 원본을 그대로 반환하며, 원본(캐시된 매니페스트 등)은 수정하지 않음
+func RedactSecretData(obj interface{}) interface{} {
+	switch v := obj.(type) {
+	case *corev1.Secret:
+		secret := v.DeepCopy()
+		redactTypedSecret(secret)
+		return secret
+	case *corev1.SecretList:
+		list := v.DeepCopy()
+		for i := range list.Items {
+			redactTypedSecret(&list.Items[i])
+		}
+		return list
+	case *unstructured.Unstructured:
+		if !isSecretManifest(v.Object) {
+			return v
+		}
+		copied := v.DeepCopy()
+		redactSecretManifest(copied.Object)
+		return copied
+	case *unstructured.UnstructuredList:
+		list := v.DeepCopy()
+		for i := range list.Items {
+			if isSecretManifest(list.Items[i].Object) {
+				redactSecretManifest(list.Items[i].Object)
+			}
+		}
+		return list
+	case map[string]interface{}:
+		if !isSecretManifest(v) {
+			return v
+		}
+		copied := runtime.DeepCopyJSON(v)
+		redactSecretManifest(copied)
+		return copied
+	default:
+		return obj
+	}
+}
+
+// isSecretManifest : core/v1 Secret 매니페스트인지 여부
+func isSecretManifest(manifest map[string]interface{}) bool {
+	kind, _ := manifest["kind"].(string)
+	apiVersion, _ := manifest["apiVersion"].(string)
+	return kind == "Secret" && apiVersion == "v1"
+}
+
+// redactTypedSecret : Secret 데이터와 last-applied 주석 제거
+func redactTypedSecret(secret *corev1.Secret) {
+	secret.Data = nil
+	secret.StringData = nil
+	delete(secret.Annotations, lastAppliedAnnotation)
+}
+
+// redactSecretManifest : Secret 매니페스트의 데이터와 last-applied 주석 제거
+func redactSecretManifest(manifest map[string]interface{}) {
+	delete(manifest, "data")
+	delete(manifest, "stringData")
+	unstructured.RemoveNestedField(manifest, "metadata", "annotations", lastAppliedAnnotation)
+}
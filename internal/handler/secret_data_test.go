@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// TestRedactSecretData : Secret 객체/목록/매니페스트의 데이터 제거 및 원본 보존 테스트
+func TestRedactSecretData(t *testing.T) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "db", Annotations: map[string]string{lastAppliedAnnotation: "{}", "team": "db"}},
+		Data:       map[string][]byte{"password": []byte("secret")},
+		StringData: map[string]string{"token": "secret"},
+	}
+	redacted := RedactSecretData(&corev1.SecretList{Items: []corev1.Secret{*secret}}).(*corev1.SecretList)
+	item := redacted.Items[0]
+	if item.Data != nil || item.StringData != nil || item.Annotations[lastAppliedAnnotation] != "" || item.Annotations["team"] != "db" {
+		t.Errorf("Unexpected redacted secret: %+v", item)
+	}
+	if string(secret.Data["password"]) != "secret" {
+		t.Error("RedactSecretData should not modify the original secret")
+	}
+
+	// 백업 tarball 매니페스트 (캐시된 원본은 유지)
+	manifest := map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":        "db",
+			"annotations": map[string]interface{}{lastAppliedAnnotation: "{}"},
+		},
+		"data": map[string]interface{}{"password": "c2VjcmV0"},
+	}
+	redactedManifest := RedactSecretData(manifest).(map[string]interface{})
+	if _, ok := redactedManifest["data"]; ok {
+		t.Errorf("Expected data to be removed, got %+v", redactedManifest)
+	}
+	if _, found, _ := unstructured.NestedString(redactedManifest, "metadata", "annotations", lastAppliedAnnotation); found {
+		t.Errorf("Expected last-applied annotation to be removed, got %+v", redactedManifest)
+	}
+	if _, ok := manifest["data"]; !ok {
+		t.Error("RedactSecretData should not modify the original manifest")
+	}
+
+	// Secret이 아닌 객체는 그대로 반환
+	configMap := map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "data": map[string]interface{}{"key": "value"}}
+	if out := RedactSecretData(configMap).(map[string]interface{}); out["data"] == nil {
+		t.Errorf("Non-secret manifest should be kept, got %+v", out)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/taking/kubemigrate/pkg/client"
+	"github.com/taking/kubemigrate/pkg/types"
 )
 
 // Service : 통합 설치 서비스
@@ -37,3 +38,13 @@ func (s *Service) CleanupVelero(ctx context.Context, client client.Client, names
 func (s *Service) DetermineStrategy(ctx context.Context, client client.Client, config VeleroInstallConfig) (InstallationStrategy, *VeleroStatus, error) {
 	return s.veleroInstaller.DetermineStrategy(ctx, client, config)
 }
+
+// GetVeleroStatus : Velero 설치 상태 조회
+func (s *Service) GetVeleroStatus(ctx context.Context, client client.Client, namespace string) (*VeleroStatus, error) {
+	return s.veleroInstaller.GetStatus(ctx, client, namespace)
+}
+
+// ListVeleroResources : Velero 리소스 인벤토리 조회
+func (s *Service) ListVeleroResources(ctx context.Context, client client.Client, namespace string) (*types.VeleroResources, error) {
+	return s.veleroInstaller.ListResources(ctx, client, namespace)
+}
@@ -6,13 +6,15 @@ import (
 
 	"github.com/taking/kubemigrate/pkg/client"
 	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
 )
 
 // VeleroInstallConfig : Velero 설치 설정
 type VeleroInstallConfig struct {
-	MinioConfig config.MinioConfig `json:"minioConfig"`
-	Namespace   string             `json:"namespace"`
-	Force       bool               `json:"force"`
+	MinioConfig config.MinioConfig          `json:"minioConfig"`
+	Namespace   string                      `json:"namespace"`
+	Force       bool                        `json:"force"`
+	Options     config.VeleroInstallOptions `json:"options"` // 비어 있는 항목은 기본값 사용
 }
 
 // VeleroUninstallConfig : Velero 제거 설정
@@ -55,4 +57,6 @@ type InstallerService interface {
 	UninstallVelero(ctx context.Context, client client.Client, config VeleroUninstallConfig) error
 	CleanupVelero(ctx context.Context, client client.Client, namespace string, force bool) error
 	DetermineStrategy(ctx context.Context, client client.Client, config VeleroInstallConfig) (InstallationStrategy, *VeleroStatus, error)
+	GetVeleroStatus(ctx context.Context, client client.Client, namespace string) (*VeleroStatus, error)
+	ListVeleroResources(ctx context.Context, client client.Client, namespace string) (*types.VeleroResources, error)
 }
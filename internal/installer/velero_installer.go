@@ -7,10 +7,14 @@ import (
 	"time"
 
 	"github.com/taking/kubemigrate/pkg/client"
+	"github.com/taking/kubemigrate/pkg/client/kubernetes"
 	"github.com/taking/kubemigrate/pkg/config"
+	"github.com/taking/kubemigrate/pkg/types"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
 // VeleroInstaller : Velero 설치 담당
@@ -25,6 +29,9 @@ func NewVeleroInstaller() *VeleroInstaller {
 func (v *VeleroInstaller) Install(ctx context.Context, client client.Client, config VeleroInstallConfig) (*InstallResult, error) {
 	start := time.Now()
 
+	// 설치 옵션 기본값 적용
+	config.Options = resolveInstallOptions(config.Options)
+
 	result := &InstallResult{
 		Status:          "in_progress",
 		VeleroNamespace: config.Namespace,
@@ -135,6 +142,103 @@ func (v *VeleroInstaller) checkVeleroStatus(ctx context.Context, client client.C
 	return status, nil
 }
 
+// GetStatus : Velero 설치 상태 조회
+func (v *VeleroInstaller) GetStatus(ctx context.Context, client client.Client, namespace string) (*VeleroStatus, error) {
+	return v.checkVeleroStatus(ctx, client, namespace)
+}
+
+// ListResources : Velero 관련 리소스 인벤토리 조회
+// 네임스페이스 리소스는 Velero 네임스페이스 전체를, 클러스터 리소스는 이름에 velero가 포함된 항목과 velero.io 그룹 CRD를 수집합니다
+func (v *VeleroInstaller) ListResources(ctx context.Context, client client.Client, namespace string) (*types.VeleroResources, error) {
+	resources := &types.VeleroResources{
+		Pods:       []string{},
+		ConfigMaps: []string{},
+		Secrets:    []string{},
+		CRDs:       []string{},
+	}
+
+	// 1. Core 리소스 (Pod, ConfigMap, Secret)
+	pods, err := client.Kubernetes().GetPods(ctx, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pods: %w", err)
+	}
+	if podList, ok := pods.(*v1.PodList); ok {
+		for _, pod := range podList.Items {
+			resources.Pods = append(resources.Pods, pod.Name)
+		}
+	}
+
+	configMaps, err := client.Kubernetes().GetConfigMaps(ctx, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list configmaps: %w", err)
+	}
+	if configMapList, ok := configMaps.(*v1.ConfigMapList); ok {
+		for _, configMap := range configMapList.Items {
+			resources.ConfigMaps = append(resources.ConfigMaps, configMap.Name)
+		}
+	}
+
+	secrets, err := client.Kubernetes().GetSecrets(ctx, namespace, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list secrets: %w", err)
+	}
+	if secretList, ok := secrets.(*v1.SecretList); ok {
+		for _, secret := range secretList.Items {
+			resources.Secrets = append(resources.Secrets, secret.Name)
+		}
+	}
+
+	// 2. 워크로드 및 RBAC 리소스 (dynamic 조회)
+	kinds := []struct {
+		kind      string
+		namespace string
+		target    *[]string
+	}{
+		{"deployments", namespace, &resources.Deployments},
+		{"daemonsets", namespace, &resources.DaemonSets},
+		{"statefulsets", namespace, &resources.StatefulSets},
+		{"services", namespace, &resources.Services},
+		{"serviceaccounts", namespace, &resources.ServiceAccounts},
+		{"clusterroles", "", &resources.ClusterRoles},
+		{"clusterrolebindings", "", &resources.ClusterRoleBindings},
+	}
+
+	for _, k := range kinds {
+		result, err := client.Kubernetes().GetResources(ctx, k.kind, k.namespace, "", kubernetes.ResourceQuery{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list %s: %w", k.kind, err)
+		}
+
+		*k.target = []string{}
+		list, ok := result.(*unstructured.UnstructuredList)
+		if !ok {
+			continue
+		}
+		for _, item := range list.Items {
+			// 클러스터 범위 리소스는 Velero 관련 항목만 수집
+			if k.namespace == "" && !strings.Contains(item.GetName(), "velero") {
+				continue
+			}
+			*k.target = append(*k.target, item.GetName())
+		}
+	}
+
+	// 3. Velero CRD
+	crds, err := client.Kubernetes().GetCRDs(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list crds: %w", err)
+	}
+	if crdList, ok := crds.(*apiextensionsv1.CustomResourceDefinitionList); ok {
+		for _, crd := range crdList.Items {
+			if crd.Spec.Group == velerov1.SchemeGroupVersion.Group {
+				resources.CRDs = append(resources.CRDs, crd.Name)
+			}
+		}
+	}
+
+	return resources, nil
+}
+
 // checkPodsInstalled : Velero Pods 설치 확인
 func (v *VeleroInstaller) checkPodsInstalled(ctx context.Context, client client.Client, namespace string) (bool, error) {
 	pods, err := client.Kubernetes().GetPods(ctx, namespace, "")
@@ -195,7 +299,7 @@ func (v *VeleroInstaller) executeFreshInstall(ctx context.Context, client client
 
 	// 2. MinIO Secret 생성
 	fmt.Printf("  - Creating MinIO Secret...\n")
-	if err := v.ensureMinIOSecretWithRetry(ctx, client, config.MinioConfig, config.Namespace); err != nil {
+	if err := v.ensureMinIOSecretWithRetry(ctx, client, config.MinioConfig, config.Options, config.Namespace); err != nil {
 		fmt.Printf("    Error: Failed to ensure minio secret: %v\n", err)
 	} else {
 		fmt.Printf("    ✓ MinIO Secret created successfully\n")
@@ -209,7 +313,7 @@ func (v *VeleroInstaller) executeFreshInstall(ctx context.Context, client client
 		return fmt.Errorf("namespace verification failed: %w", err)
 	}
 
-	if err := v.installVeleroViaHelmWithRetry(ctx, client, config.Namespace, config.MinioConfig); err != nil {
+	if err := v.installVeleroViaHelmWithRetry(ctx, client, config.Namespace, config.MinioConfig, config.Options); err != nil {
 		fmt.Printf("    Error: Failed to install velero: %v\n", err)
 		// Velero 설치 실패 시 더 이상 진행할 수 없음
 		return fmt.Errorf("critical error: velero installation failed: %w", err)
@@ -227,7 +331,7 @@ func (v *VeleroInstaller) executeFreshInstall(ctx context.Context, client client
 
 	// 5. BSL 생성
 	fmt.Printf("  - Creating BackupStorageLocation...\n")
-	if err := v.ensureBackupStorageLocationWithRetry(ctx, client, config.MinioConfig, config.Namespace); err != nil {
+	if err := v.ensureBackupStorageLocationWithRetry(ctx, client, config.MinioConfig, config.Options, config.Namespace); err != nil {
 		fmt.Printf("    Warning: Failed to ensure bsl: %v\n", err)
 	} else {
 		fmt.Printf("    ✓ BackupStorageLocation created successfully\n")
@@ -249,9 +353,15 @@ func (v *VeleroInstaller) executeFreshInstall(ctx context.Context, client client
 
 // performCompleteCleanup : 완전 정리
 func (v *VeleroInstaller) performCompleteCleanup(ctx context.Context, client client.Client, namespace string) error {
-	// 1. Helm Release 삭제
-	if err := v.deleteHelmRelease(ctx, client, "velero", namespace); err != nil {
-		return fmt.Errorf("failed to delete helm release: %w", err)
+	// 1. Helm Release 삭제 (이미 제거된 경우 남은 리소스만 정리)
+	installed, err := v.checkHelmRelease(ctx, client, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to check helm release: %w", err)
+	}
+	if installed {
+		if err := v.deleteHelmRelease(ctx, client, "velero", namespace); err != nil {
+			return fmt.Errorf("failed to delete helm release: %w", err)
+		}
 	}
 
 	// 2. Helm Release Secrets 삭제
@@ -333,8 +443,8 @@ func (v *VeleroInstaller) ensureNamespace(ctx context.Context, client client.Cli
 }
 
 // ensureMinIOSecret : MinIO Secret 확인/생성
-func (v *VeleroInstaller) ensureMinIOSecret(ctx context.Context, client client.Client, minioConfig config.MinioConfig, namespace string) error {
-	secretName := "cloud-credentials"
+func (v *VeleroInstaller) ensureMinIOSecret(ctx context.Context, client client.Client, minioConfig config.MinioConfig, opts config.VeleroInstallOptions, namespace string) error {
+	secretName := veleroCredentialSecret
 
 	// Secret 존재 확인
 	_, err := client.Kubernetes().GetSecrets(ctx, namespace, secretName)
@@ -344,11 +454,11 @@ func (v *VeleroInstaller) ensureMinIOSecret(ctx context.Context, client client.C
 
 	// Secret 생성
 	secretData := map[string]string{
-		"cloud": fmt.Sprintf(`[default]
+		veleroCredentialKey: fmt.Sprintf(`[default]
 aws_access_key_id=%s
 aws_secret_access_key=%s
-region=minio
-`, minioConfig.AccessKey, minioConfig.SecretKey),
+region=%s
+`, minioConfig.AccessKey, minioConfig.SecretKey, opts.Region),
 	}
 
 	_, err = client.Kubernetes().CreateSecret(ctx, namespace, secretName, secretData)
@@ -360,72 +470,14 @@ region=minio
 }
 
 // installVeleroViaHelm : Helm을 통한 Velero 설치
-func (v *VeleroInstaller) installVeleroViaHelm(ctx context.Context, client client.Client, namespace string, minioConfig config.MinioConfig) error {
-	chartURL := "https://github.com/vmware-tanzu/helm-charts/releases/download/velero-11.1.0/velero-11.1.0.tgz"
+func (v *VeleroInstaller) installVeleroViaHelm(ctx context.Context, client client.Client, namespace string, minioConfig config.MinioConfig, opts config.VeleroInstallOptions) error {
+	chartURL := opts.ChartURL
 	releaseName := "velero"
-	version := "11.1.0"
+	version := opts.ChartVersion
 
-	values := map[string]interface{}{
-		"image": map[string]interface{}{
-			"repository": "docker.io/velero/velero",
-			"tag":        "v1.17.0",
-		},
-		"kubectl": map[string]interface{}{
-			"image": map[string]interface{}{
-				"repository": "docker.io/bitnamilegacy/kubectl",
-			},
-		},
-		"credentials": map[string]interface{}{
-			"useSecret":      true,
-			"existingSecret": "cloud-credentials",
-		},
-		"features":                 "EnableCSI",
-		"deployNodeAgent":          true,
-		"defaultVolumesToFsBackup": true,
-		"configuration": map[string]interface{}{
-			"backupStorageLocation": []interface{}{
-				map[string]interface{}{
-					"name":     "minio",
-					"provider": "aws",
-					"bucket":   "velero",
-					"config": map[string]interface{}{
-						"region":           "minio",
-						"s3Url":            fmt.Sprintf("http://%s", minioConfig.Endpoint),
-						"s3ForcePathStyle": "true",
-					},
-					"credential": map[string]interface{}{
-						"name": "cloud-credentials",
-						"key":  "cloud",
-					},
-				},
-			},
-			"volumeSnapshotLocation": []interface{}{
-				map[string]interface{}{
-					"name":     "minio-snapshot",
-					"provider": "aws",
-					"config": map[string]interface{}{
-						"region": "minio",
-					},
-					"credential": map[string]interface{}{
-						"name": "cloud-credentials",
-						"key":  "cloud",
-					},
-				},
-			},
-		},
-		"initContainers": []interface{}{
-			map[string]interface{}{
-				"name":            "velero-plugin-for-aws",
-				"image":           "docker.io/velero/velero-plugin-for-aws:v1.13.0",
-				"imagePullPolicy": "IfNotPresent",
-				"volumeMounts": []interface{}{
-					map[string]interface{}{
-						"name":      "plugins",
-						"mountPath": "/target",
-					},
-				},
-			},
-		},
+	values, err := buildHelmValues(minioConfig, opts)
+	if err != nil {
+		return fmt.Errorf("failed to build velero values: %w", err)
 	}
 
 	// 1. 먼저 install 시도
@@ -444,7 +496,7 @@ func (v *VeleroInstaller) installVeleroViaHelm(ctx context.Context, client clien
 }
 
 // ensureBackupStorageLocation : BSL 확인/생성
-func (v *VeleroInstaller) ensureBackupStorageLocation(ctx context.Context, client client.Client, minioConfig config.MinioConfig, namespace string) error {
+func (v *VeleroInstaller) ensureBackupStorageLocation(ctx context.Context, client client.Client, minioConfig config.MinioConfig, opts config.VeleroInstallOptions, namespace string) error {
 	bslName := "minio"
 
 	bsl := &velerov1.BackupStorageLocation{
@@ -456,20 +508,17 @@ func (v *VeleroInstaller) ensureBackupStorageLocation(ctx context.Context, clien
 			Provider: "aws",
 			StorageType: velerov1.StorageType{
 				ObjectStorage: &velerov1.ObjectStorageLocation{
-					Bucket: "velero",
-					Prefix: "backups",
+					Bucket: opts.Bucket,
+					Prefix: opts.Prefix,
+					CACert: []byte(opts.CACert),
 				},
 			},
-			Config: map[string]string{
-				"region":           "us-west-2",
-				"s3Url":            fmt.Sprintf("http://%s", minioConfig.Endpoint),
-				"s3ForcePathStyle": "true",
-			},
+			Config: storageLocationConfig(minioConfig, opts),
 			Credential: &v1.SecretKeySelector{
 				LocalObjectReference: v1.LocalObjectReference{
-					Name: "cloud-credentials",
+					Name: veleroCredentialSecret,
 				},
-				Key: "cloud",
+				Key: veleroCredentialKey,
 			},
 			Default: true,
 		},
@@ -543,8 +592,8 @@ func (v *VeleroInstaller) deleteHelmReleaseSecrets(ctx context.Context, client c
 			continue // 조회 실패해도 다음 네임스페이스 시도
 		}
 
-		if secretList, ok := secrets.([]v1.Secret); ok {
-			for _, secret := range secretList {
+		if secretList, ok := secrets.(*v1.SecretList); ok {
+			for _, secret := range secretList.Items {
 				// Helm Release Secret 패턴 확인
 				if strings.HasPrefix(secret.Name, fmt.Sprintf("sh.helm.release.v1.%s.", releaseName)) {
 					if err := client.Kubernetes().DeleteSecret(ctx, namespace, secret.Name); err != nil {
@@ -618,23 +667,23 @@ func (v *VeleroInstaller) ensureNamespaceWithRetry(ctx context.Context, client c
 }
 
 // ensureMinIOSecretWithRetry : MinIO Secret 확인/생성 (재시도 포함)
-func (v *VeleroInstaller) ensureMinIOSecretWithRetry(ctx context.Context, client client.Client, minioConfig config.MinioConfig, namespace string) error {
+func (v *VeleroInstaller) ensureMinIOSecretWithRetry(ctx context.Context, client client.Client, minioConfig config.MinioConfig, opts config.VeleroInstallOptions, namespace string) error {
 	return v.retryOperation(func() error {
-		return v.ensureMinIOSecret(ctx, client, minioConfig, namespace)
+		return v.ensureMinIOSecret(ctx, client, minioConfig, opts, namespace)
 	}, 3, 2*time.Second)
 }
 
 // installVeleroViaHelmWithRetry : Velero Helm 설치 (재시도 포함)
-func (v *VeleroInstaller) installVeleroViaHelmWithRetry(ctx context.Context, client client.Client, namespace string, minioConfig config.MinioConfig) error {
+func (v *VeleroInstaller) installVeleroViaHelmWithRetry(ctx context.Context, client client.Client, namespace string, minioConfig config.MinioConfig, opts config.VeleroInstallOptions) error {
 	return v.retryOperation(func() error {
-		return v.installVeleroViaHelm(ctx, client, namespace, minioConfig)
+		return v.installVeleroViaHelm(ctx, client, namespace, minioConfig, opts)
 	}, 3, 5*time.Second)
 }
 
 // ensureBackupStorageLocationWithRetry : BSL 확인/생성 (재시도 포함)
-func (v *VeleroInstaller) ensureBackupStorageLocationWithRetry(ctx context.Context, client client.Client, minioConfig config.MinioConfig, namespace string) error {
+func (v *VeleroInstaller) ensureBackupStorageLocationWithRetry(ctx context.Context, client client.Client, minioConfig config.MinioConfig, opts config.VeleroInstallOptions, namespace string) error {
 	return v.retryOperation(func() error {
-		return v.ensureBackupStorageLocation(ctx, client, minioConfig, namespace)
+		return v.ensureBackupStorageLocation(ctx, client, minioConfig, opts, namespace)
 	}, 3, 2*time.Second)
 }
 
@@ -0,0 +1,215 @@
+package installer
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// Velero 설치 기본값
+const (
+	defaultVeleroChartVersion = "11.1.0"
+	defaultVeleroImage        = "docker.io/velero/velero"
+	defaultVeleroImageTag     = "v1.17.0"
+	defaultAWSPluginImage     = "docker.io/velero/velero-plugin-for-aws:v1.13.0"
+	defaultKubectlImage       = "docker.io/bitnamilegacy/kubectl"
+	defaultVeleroBucket       = "velero"
+	defaultVeleroRegion       = "minio"
+
+	veleroCredentialSecret = "cloud-credentials"
+	veleroCredentialKey    = "cloud"
+)
+
+// resolveInstallOptions : 비어 있는 설치 옵션을 기본값으로 채움
+func resolveInstallOptions(opts config.VeleroInstallOptions) config.VeleroInstallOptions {
+	if opts.ChartVersion == "" {
+		opts.ChartVersion = defaultVeleroChartVersion
+	}
+	if opts.ChartURL == "" {
+		opts.ChartURL = fmt.Sprintf("https://github.com/vmware-tanzu/helm-charts/releases/download/velero-%s/velero-%s.tgz",
+			opts.ChartVersion, opts.ChartVersion)
+	}
+	if opts.Image == "" {
+		opts.Image = defaultVeleroImage
+	}
+	if opts.ImageTag == "" {
+		opts.ImageTag = defaultVeleroImageTag
+	}
+	if opts.AWSPluginImage == "" {
+		opts.AWSPluginImage = defaultAWSPluginImage
+	}
+	if opts.KubectlImage == "" {
+		opts.KubectlImage = defaultKubectlImage
+	}
+	if opts.Bucket == "" {
+		opts.Bucket = defaultVeleroBucket
+	}
+	if opts.Region == "" {
+		opts.Region = defaultVeleroRegion
+	}
+	if opts.NodeAgent == nil {
+		enabled := true
+		opts.NodeAgent = &enabled
+	}
+	if opts.DefaultVolumesToFsBackup == nil {
+		// node-agent 없이는 파일 시스템 백업을 사용할 수 없으므로 node-agent 배포 여부를 따름
+		enabled := *opts.NodeAgent
+		opts.DefaultVolumesToFsBackup = &enabled
+	}
+	return opts
+}
+
+// s3URL : MinIO S3 엔드포인트 URL (UseSSL에 따라 https/http)
+func s3URL(minioConfig config.MinioConfig) string {
+	if minioConfig.UseSSL {
+		return fmt.Sprintf("https://%s", minioConfig.Endpoint)
+	}
+	return fmt.Sprintf("http://%s", minioConfig.Endpoint)
+}
+
+// storageLocationConfig : BSL config 항목 생성
+func storageLocationConfig(minioConfig config.MinioConfig, opts config.VeleroInstallOptions) map[string]string {
+	locationConfig := map[string]string{
+		"region":           opts.Region,
+		"s3Url":            s3URL(minioConfig),
+		"s3ForcePathStyle": "true",
+	}
+	if opts.InsecureSkipTLSVerify {
+		locationConfig["insecureSkipTLSVerify"] = "true"
+	}
+	return locationConfig
+}
+
+// buildHelmValues : 설치 옵션으로 Velero 차트 values 생성 (opts는 기본값이 채워진 상태여야 함)
+func buildHelmValues(minioConfig config.MinioConfig, opts config.VeleroInstallOptions) (map[string]interface{}, error) {
+	credential := map[string]interface{}{
+		"name": veleroCredentialSecret,
+		"key":  veleroCredentialKey,
+	}
+
+	locationConfig := map[string]interface{}{}
+	for k, v := range storageLocationConfig(minioConfig, opts) {
+		locationConfig[k] = v
+	}
+
+	backupStorageLocation := map[string]interface{}{
+		"name":       "minio",
+		"provider":   "aws",
+		"bucket":     opts.Bucket,
+		"config":     locationConfig,
+		"credential": credential,
+	}
+	if opts.Prefix != "" {
+		backupStorageLocation["prefix"] = opts.Prefix
+	}
+	if opts.CACert != "" {
+		// 차트는 base64 인코딩된 CA 번들을 요구
+		backupStorageLocation["caCert"] = base64.StdEncoding.EncodeToString([]byte(opts.CACert))
+	}
+
+	values := map[string]interface{}{
+		"image": map[string]interface{}{
+			"repository": opts.Image,
+			"tag":        opts.ImageTag,
+		},
+		"kubectl": map[string]interface{}{
+			"image": map[string]interface{}{
+				"repository": opts.KubectlImage,
+			},
+		},
+		"credentials": map[string]interface{}{
+			"useSecret":      true,
+			"existingSecret": veleroCredentialSecret,
+		},
+		"features":                 "EnableCSI",
+		"deployNodeAgent":          *opts.NodeAgent,
+		"defaultVolumesToFsBackup": *opts.DefaultVolumesToFsBackup,
+		"configuration": map[string]interface{}{
+			"backupStorageLocation": []interface{}{backupStorageLocation},
+			"volumeSnapshotLocation": []interface{}{
+				map[string]interface{}{
+					"name":     "minio-snapshot",
+					"provider": "aws",
+					"config": map[string]interface{}{
+						"region": opts.Region,
+					},
+					"credential": credential,
+				},
+			},
+		},
+		"initContainers": []interface{}{
+			map[string]interface{}{
+				"name":            "velero-plugin-for-aws",
+				"image":           opts.AWSPluginImage,
+				"imagePullPolicy": "IfNotPresent",
+				"volumeMounts": []interface{}{
+					map[string]interface{}{
+						"name":      "plugins",
+						"mountPath": "/target",
+					},
+				},
+			},
+		},
+	}
+
+	nodeAgent := map[string]interface{}{}
+	if opts.Resources != nil {
+		resources, err := toValue(opts.Resources)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resources: %w", err)
+		}
+		values["resources"] = resources
+	}
+	if opts.NodeAgentResources != nil {
+		resources, err := toValue(opts.NodeAgentResources)
+		if err != nil {
+			return nil, fmt.Errorf("invalid node agent resources: %w", err)
+		}
+		nodeAgent["resources"] = resources
+	}
+	if len(opts.Tolerations) > 0 {
+		tolerations, err := toValue(opts.Tolerations)
+		if err != nil {
+			return nil, fmt.Errorf("invalid tolerations: %w", err)
+		}
+		values["tolerations"] = tolerations
+		nodeAgent["tolerations"] = tolerations
+	}
+	if len(nodeAgent) > 0 {
+		values["nodeAgent"] = nodeAgent
+	}
+
+	// 사용자 지정 values 병합 (사용자 값 우선)
+	mergeValues(values, opts.Values)
+
+	return values, nil
+}
+
+// toValue : 구조체를 Helm values에서 사용하는 map/slice 형태로 변환
+func toValue(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
+
+// mergeValues : override를 base에 재귀적으로 병합 (map은 병합, 그 외 값은 교체)
+func mergeValues(base, override map[string]interface{}) {
+	for key, value := range override {
+		overrideMap, isMap := value.(map[string]interface{})
+		baseMap, baseIsMap := base[key].(map[string]interface{})
+		if isMap && baseIsMap {
+			mergeValues(baseMap, overrideMap)
+			continue
+		}
+		base[key] = value
+	}
+}
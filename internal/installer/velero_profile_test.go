@@ -0,0 +1,117 @@
+package installer
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/taking/kubemigrate/pkg/config"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// TestBuildHelmValues_Defaults 설치 옵션 미지정 시 기존 기본값 유지 테스트
+func TestBuildHelmValues_Defaults(t *testing.T) {
+	opts := resolveInstallOptions(config.VeleroInstallOptions{})
+
+	if opts.ChartURL != "https://github.com/vmware-tanzu/helm-charts/releases/download/velero-11.1.0/velero-11.1.0.tgz" {
+		t.Errorf("Unexpected default chart URL: %s", opts.ChartURL)
+	}
+
+	values, err := buildHelmValues(config.MinioConfig{Endpoint: "minio:9000"}, opts)
+	if err != nil {
+		t.Fatalf("buildHelmValues() error = %v", err)
+	}
+
+	image := values["image"].(map[string]interface{})
+	if image["repository"] != "docker.io/velero/velero" || image["tag"] != "v1.17.0" {
+		t.Errorf("Unexpected default image: %v", image)
+	}
+	if values["deployNodeAgent"] != true || values["defaultVolumesToFsBackup"] != true {
+		t.Errorf("Expected node agent and fs backup to be enabled by default")
+	}
+
+	bsl := values["configuration"].(map[string]interface{})["backupStorageLocation"].([]interface{})[0].(map[string]interface{})
+	if bsl["bucket"] != "velero" {
+		t.Errorf("Expected default bucket 'velero', got %v", bsl["bucket"])
+	}
+	if _, ok := bsl["prefix"]; ok {
+		t.Errorf("Expected no prefix by default")
+	}
+	bslConfig := bsl["config"].(map[string]interface{})
+	if bslConfig["s3Url"] != "http://minio:9000" || bslConfig["region"] != "minio" {
+		t.Errorf("Unexpected default BSL config: %v", bslConfig)
+	}
+}
+
+// TestBuildHelmValues_Profile 설치 프로필 적용 테스트
+func TestBuildHelmValues_Profile(t *testing.T) {
+	nodeAgent := false
+	opts := resolveInstallOptions(config.VeleroInstallOptions{
+		ChartURL:       "oci://registry.local/charts/velero",
+		ChartVersion:   "11.2.0",
+		Image:          "registry.local/velero/velero",
+		ImageTag:       "v1.17.1",
+		AWSPluginImage: "registry.local/velero/velero-plugin-for-aws:v1.13.1",
+		Bucket:         "backups",
+		Prefix:         "cluster-a",
+		Region:         "us-east-1",
+		CACert:         "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+		NodeAgent:      &nodeAgent,
+		Resources: &corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+		},
+		Tolerations: []corev1.Toleration{{Key: "dedicated", Operator: corev1.TolerationOpExists}},
+		Values: map[string]interface{}{
+			"image":       map[string]interface{}{"pullPolicy": "Never"},
+			"upgradeCRDs": false,
+		},
+	})
+
+	if opts.ChartURL != "oci://registry.local/charts/velero" {
+		t.Errorf("Expected chart URL override to be kept, got %s", opts.ChartURL)
+	}
+	if *opts.DefaultVolumesToFsBackup {
+		t.Errorf("Expected fs backup default to follow disabled node agent")
+	}
+
+	values, err := buildHelmValues(config.MinioConfig{Endpoint: "minio.local:443", UseSSL: true}, opts)
+	if err != nil {
+		t.Fatalf("buildHelmValues() error = %v", err)
+	}
+
+	image := values["image"].(map[string]interface{})
+	if image["repository"] != "registry.local/velero/velero" || image["tag"] != "v1.17.1" || image["pullPolicy"] != "Never" {
+		t.Errorf("Expected image overrides to be merged, got %v", image)
+	}
+	if values["deployNodeAgent"] != false {
+		t.Errorf("Expected node agent to be disabled")
+	}
+	if values["upgradeCRDs"] != false {
+		t.Errorf("Expected extra values to be applied")
+	}
+
+	bsl := values["configuration"].(map[string]interface{})["backupStorageLocation"].([]interface{})[0].(map[string]interface{})
+	if bsl["bucket"] != "backups" || bsl["prefix"] != "cluster-a" {
+		t.Errorf("Unexpected bucket/prefix: %v/%v", bsl["bucket"], bsl["prefix"])
+	}
+	if bsl["caCert"] != base64.StdEncoding.EncodeToString([]byte(opts.CACert)) {
+		t.Errorf("Expected base64 encoded CA bundle, got %v", bsl["caCert"])
+	}
+	if s3 := bsl["config"].(map[string]interface{})["s3Url"]; s3 != "https://minio.local:443" {
+		t.Errorf("Expected https S3 URL when UseSSL is true, got %v", s3)
+	}
+
+	plugin := values["initContainers"].([]interface{})[0].(map[string]interface{})
+	if plugin["image"] != "registry.local/velero/velero-plugin-for-aws:v1.13.1" {
+		t.Errorf("Unexpected plugin image: %v", plugin["image"])
+	}
+
+	requests := values["resources"].(map[string]interface{})["requests"].(map[string]interface{})
+	if requests["cpu"] != "500m" {
+		t.Errorf("Expected cpu request 500m, got %v", requests["cpu"])
+	}
+	tolerations := values["nodeAgent"].(map[string]interface{})["tolerations"].([]interface{})
+	if len(tolerations) != 1 {
+		t.Errorf("Expected tolerations to be applied to node agent, got %v", tolerations)
+	}
+}
@@ -0,0 +1,64 @@
+package job
+
+import (
+	"fmt"
+	"path/filepath"
+	"time"
+)
+
+// 작업 저장소 타입
+const (
+	StoreTypeMemory = "memory"
+	StoreTypeFile   = "file"
+)
+
+// ManagerOptions : 작업 관리자 생성 옵션
+type ManagerOptions struct {
+	Name          string          // 관리자 이름 (파일 저장소 하위 디렉토리명)
+	Workers       int             // 워커 수
+	StoreType     string          // "memory" 또는 "file"
+	StorePath     string          // 파일 저장소 루트 경로
+	Retention     RetentionPolicy // 완료된 작업 보존 정책
+	PruneInterval time.Duration   // 보존 정책 적용 주기 (0이면 비활성화)
+	Sealer        Sealer          // 복구 정보 암호화 (nil이면 kubeconfig를 저장하지 않음)
+}
+
+// NewJobManager : 옵션에 따라 작업 관리자 생성
+func NewJobManager(opts ManagerOptions) (JobManager, error) {
+	switch opts.StoreType {
+	case "", StoreTypeMemory:
+		m := NewMemoryJobManagerWithWorkers(opts.Workers)
+		m.registerMetrics(managerName(opts.Name))
+		m.startPruner(opts.PruneInterval, func() { m.PruneJobs(opts.Retention) })
+		return m, nil
+
+	case StoreTypeFile:
+		store, err := NewFileJobStore(filepath.Join(opts.StorePath, opts.Name), opts.Sealer)
+		if err != nil {
+			return nil, err
+		}
+
+		m, err := NewPersistentJobManager(opts.Workers, store)
+		if err != nil {
+			return nil, err
+		}
+
+		m.registerMetrics(managerName(opts.Name))
+
+		// 시작 시 한 번 정리 후 주기적으로 적용
+		m.PruneJobs(opts.Retention)
+		m.startPruner(opts.PruneInterval, func() { m.PruneJobs(opts.Retention) })
+		return m, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported job store type: %s (valid: memory, file)", opts.StoreType)
+	}
+}
+
+// managerName : 메트릭 레이블로 사용할 관리자 이름
+func managerName(name string) string {
+	if name == "" {
+		return defaultManagerName
+	}
+	return name
+}
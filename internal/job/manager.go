@@ -2,9 +2,13 @@ package job
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
+
+	"github.com/taking/kubemigrate/internal/metrics"
 )
 
 // WorkerPool : 고루틴 풀을 관리하는 구조체
@@ -19,9 +23,14 @@ type WorkerPool struct {
 
 // MemoryJobManager : 메모리 기반 작업 관리자 (워커 풀 통합)
 type MemoryJobManager struct {
-	jobs       map[string]*JobInfo
-	mutex      sync.RWMutex
-	workerPool *WorkerPool
+	name        string // 메트릭 레이블 (velero, helm, minio, migration 등)
+	jobs        map[string]*JobInfo
+	cancels     map[string]context.CancelFunc
+	subscribers map[string]map[chan struct{}]struct{}
+	mutex       sync.RWMutex
+	workerPool  *WorkerPool
+	done        chan struct{}
+	closeOnce   sync.Once
 }
 
 // NewWorkerPool : 새로운 워커 풀을 생성합니다
@@ -80,6 +89,21 @@ func (p *WorkerPool) Submit(job func()) {
 	}
 }
 
+// QueueDepth : 대기 중인 작업 수
+func (p *WorkerPool) QueueDepth() int {
+	return len(p.jobs)
+}
+
+// RegisterMetrics : 워커 수와 대기열 길이를 Prometheus 메트릭으로 노출 (같은 이름으로 다시 등록하면 교체)
+func (p *WorkerPool) RegisterMetrics(name string) {
+	metrics.WorkerPoolQueueDepth.Register(name, func() []metrics.Sample {
+		return []metrics.Sample{{LabelValues: []string{name}, Value: float64(p.QueueDepth())}}
+	})
+	metrics.WorkerPoolWorkers.Register(name, func() []metrics.Sample {
+		return []metrics.Sample{{LabelValues: []string{name}, Value: float64(p.workers)}}
+	})
+}
+
 // Close : 워커 풀을 종료합니다
 func (p *WorkerPool) Close() {
 	p.cancel()
@@ -121,27 +145,57 @@ func WithTimeout(timeout time.Duration, fn func() error) error {
 	}
 }
 
+// IsCancelled : 컨텍스트가 사용자 취소로 종료되었는지 확인 (타임아웃과 구분)
+func IsCancelled(ctx context.Context) bool {
+	return errors.Is(ctx.Err(), context.Canceled)
+}
+
 // NewMemoryJobManager : 메모리 작업 관리자 생성
 func NewMemoryJobManager() *MemoryJobManager {
 	return &MemoryJobManager{
-		jobs:       make(map[string]*JobInfo),
-		workerPool: NewWorkerPool(5), // 기본 5개 워커
+		name:        defaultManagerName,
+		jobs:        make(map[string]*JobInfo),
+		cancels:     make(map[string]context.CancelFunc),
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+		workerPool:  NewWorkerPool(5), // 기본 5개 워커
+		done:        make(chan struct{}),
 	}
 }
 
 // NewMemoryJobManagerWithWorkers : 워커 수를 지정하여 메모리 작업 관리자 생성
 func NewMemoryJobManagerWithWorkers(workers int) *MemoryJobManager {
 	return &MemoryJobManager{
-		jobs:       make(map[string]*JobInfo),
-		workerPool: NewWorkerPool(workers),
+		name:        defaultManagerName,
+		jobs:        make(map[string]*JobInfo),
+		cancels:     make(map[string]context.CancelFunc),
+		subscribers: make(map[string]map[chan struct{}]struct{}),
+		workerPool:  NewWorkerPool(workers),
+		done:        make(chan struct{}),
 	}
 }
 
-// CreateJob : 작업 생성
+// CreateJob : 작업 생성 (워커가 갱신하는 원본 대신 복사본 반환)
 func (m *MemoryJobManager) CreateJob(jobID string, metadata map[string]interface{}) *JobInfo {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
+	return copyJob(m.createJobLocked(jobID, metadata))
+}
+
+// CreateJobIfAbsent : 같은 ID의 작업이 없을 때만 작업 생성 후 생성 여부 반환
+// 사용자가 작업 ID를 지정하는 경우 조회 후 생성 사이의 경쟁 없이 중복을 거부하기 위해 사용
+func (m *MemoryJobManager) CreateJobIfAbsent(jobID string, metadata map[string]interface{}) (*JobInfo, bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if existing, exists := m.jobs[jobID]; exists {
+		return copyJob(existing), false
+	}
+	return copyJob(m.createJobLocked(jobID, metadata)), true
+}
+
+// createJobLocked : 작업 생성 (mutex 보유 상태에서 호출)
+func (m *MemoryJobManager) createJobLocked(jobID string, metadata map[string]interface{}) *JobInfo {
 	job := &JobInfo{
 		JobID:     jobID,
 		Status:    JobStatusPending,
@@ -154,6 +208,8 @@ func (m *MemoryJobManager) CreateJob(jobID string, metadata map[string]interface
 	}
 
 	m.jobs[jobID] = job
+	m.notifyLocked(jobID)
+	metrics.JobsCreated.Inc(m.name, Type(job))
 	return job
 }
 
@@ -162,12 +218,36 @@ func (m *MemoryJobManager) UpdateJobStatus(jobID string, status JobStatus, progr
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	if job, exists := m.jobs[jobID]; exists {
+	// 취소된 작업은 백그라운드 고루틴이 상태를 덮어쓰지 않도록 유지
+	if job, exists := m.jobs[jobID]; exists && job.Status != JobStatusCancelled {
+		previous := job.Status
 		job.Status = status
 		job.Progress = progress
 		job.Message = message
 		job.UpdatedAt = time.Now()
+		m.observeFinishLocked(job, previous)
+		m.notifyLocked(jobID)
+	}
+}
+
+// TransitionJobStatus : 현재 상태가 from인 경우에만 상태 변경 후 성공 여부 반환
+// 동시에 들어온 재개 요청 중 하나만 작업을 가져가도록 잠금 안에서 확인과 변경을 함께 수행
+func (m *MemoryJobManager) TransitionJobStatus(jobID string, from, to JobStatus, message string) bool {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	job, exists := m.jobs[jobID]
+	if !exists || job.Status != from {
+		return false
 	}
+
+	previous := job.Status
+	job.Status = to
+	job.Message = message
+	job.UpdatedAt = time.Now()
+	m.observeFinishLocked(job, previous)
+	m.notifyLocked(jobID)
+	return true
 }
 
 // AddJobLog : 작업 로그 추가
@@ -176,38 +256,80 @@ func (m *MemoryJobManager) AddJobLog(jobID string, log string) {
 	defer m.mutex.Unlock()
 
 	if job, exists := m.jobs[jobID]; exists {
-		job.Logs = append(job.Logs, fmt.Sprintf("[%s] %s", time.Now().Format("15:04:05"), log))
-		job.UpdatedAt = time.Now()
+		appendLog(job, log)
+		m.notifyLocked(jobID)
 	}
 }
 
-// CompleteJob : 작업 완료
-func (m *MemoryJobManager) CompleteJob(jobID string, result interface{}) {
-	m.UpdateJobStatus(jobID, JobStatusCompleted, 100, "Job completed successfully")
-	m.AddJobLog(jobID, "Job completed successfully")
+// SetJobMetadata : 작업 메타데이터 값 설정 (기존 스냅샷과 공유하지 않도록 복사 후 갱신)
+func (m *MemoryJobManager) SetJobMetadata(jobID, key string, value interface{}) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	job, exists := m.jobs[jobID]
+	if !exists {
+		return
+	}
+
+	metadata := make(map[string]interface{}, len(job.Metadata)+1)
+	for k, v := range job.Metadata {
+		metadata[k] = v
+	}
+	metadata[key] = value
+
+	job.Metadata = metadata
+	job.UpdatedAt = time.Now()
+	m.notifyLocked(jobID)
+}
 
-	// 결과 저장
+// appendLog : 타임스탬프를 붙여 작업 로그 추가 (호출자가 잠금 보유)
+func appendLog(job *JobInfo, log string) {
+	job.Logs = append(job.Logs, fmt.Sprintf("[%s] %s", time.Now().Format("15:04:05"), log))
+	job.UpdatedAt = time.Now()
+}
+
+// CompleteJob : 작업 완료 (결과, 상태, 로그를 한 번에 반영)
+func (m *MemoryJobManager) CompleteJob(jobID string, result interface{}) {
 	m.mutex.Lock()
-	if job, exists := m.jobs[jobID]; exists {
-		job.Result = result
-		job.UpdatedAt = time.Now()
+	defer m.mutex.Unlock()
+
+	job, exists := m.jobs[jobID]
+	if !exists || job.Status == JobStatusCancelled {
+		return
 	}
-	m.mutex.Unlock()
+
+	previous := job.Status
+	job.Result = result
+	job.Status = JobStatusCompleted
+	job.Progress = 100
+	job.Message = "Job completed successfully"
+	appendLog(job, "Job completed successfully")
+	m.observeFinishLocked(job, previous)
+	m.notifyLocked(jobID)
 }
 
 // FailJob : 작업 실패
 func (m *MemoryJobManager) FailJob(jobID string, err error) {
-	m.UpdateJobStatus(jobID, JobStatusFailed, 0, err.Error())
-	m.AddJobLog(jobID, fmt.Sprintf("Job failed: %s", err.Error()))
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	job, exists := m.jobs[jobID]
+	if !exists || job.Status == JobStatusCancelled {
+		return
+	}
+
+	previous := job.Status
+	job.Status = JobStatusFailed
+	job.Progress = 0
+	job.Message = err.Error()
+	appendLog(job, fmt.Sprintf("Job failed: %s", err.Error()))
+	m.observeFinishLocked(job, previous)
+	m.notifyLocked(jobID)
 }
 
-// GetJob : 작업 조회
+// GetJob : 작업 조회 (동시 갱신과 경합하지 않도록 복사본 반환)
 func (m *MemoryJobManager) GetJob(jobID string) (*JobInfo, bool) {
-	m.mutex.RLock()
-	defer m.mutex.RUnlock()
-
-	job, exists := m.jobs[jobID]
-	return job, exists
+	return m.Snapshot(jobID)
 }
 
 // GetAllJobs : 모든 작업 조회
@@ -218,7 +340,7 @@ func (m *MemoryJobManager) GetAllJobs() map[string]*JobInfo {
 	// 복사본 반환
 	jobs := make(map[string]*JobInfo)
 	for k, v := range m.jobs {
-		jobs[k] = v
+		jobs[k] = copyJob(v)
 	}
 	return jobs
 }
@@ -229,6 +351,189 @@ func (m *MemoryJobManager) DeleteJob(jobID string) {
 	defer m.mutex.Unlock()
 
 	delete(m.jobs, jobID)
+	m.notifyLocked(jobID)
+}
+
+// JobContext : 취소 가능한 백그라운드 작업 컨텍스트 생성
+// 반환된 cancel 함수는 작업 종료 시 반드시 호출해야 합니다.
+func (m *MemoryJobManager) JobContext(jobID string, timeout time.Duration) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+
+	m.mutex.Lock()
+	m.cancels[jobID] = cancel
+	m.mutex.Unlock()
+
+	return ctx, func() {
+		m.mutex.Lock()
+		delete(m.cancels, jobID)
+		m.mutex.Unlock()
+		cancel()
+	}
+}
+
+// CancelJob : 진행 중인 작업 취소 (컨텍스트 취소 후 상태를 cancelled로 변경)
+func (m *MemoryJobManager) CancelJob(jobID string) error {
+	m.mutex.Lock()
+	job, exists := m.jobs[jobID]
+	if !exists {
+		m.mutex.Unlock()
+		return fmt.Errorf("%w: %s", ErrJobNotFound, jobID)
+	}
+	if job.Status.IsFinished() {
+		m.mutex.Unlock()
+		return fmt.Errorf("%w: %s (status: %s)", ErrJobNotRunning, jobID, job.Status)
+	}
+
+	previous := job.Status
+	job.Status = JobStatusCancelled
+	job.Message = "Job cancelled"
+	appendLog(job, "Job cancelled by user request")
+	m.observeFinishLocked(job, previous)
+	m.notifyLocked(jobID)
+	cancel := m.cancels[jobID]
+	m.mutex.Unlock()
+
+	if cancel != nil {
+		cancel()
+	}
+
+	return nil
+}
+
+// Subscribe : 작업 변경 알림 구독 (상태 변경/로그 추가 시 신호 전송)
+// 알림은 병합될 수 있으므로 수신 후 Snapshot으로 최신 상태를 조회해야 합니다.
+func (m *MemoryJobManager) Subscribe(jobID string) (<-chan struct{}, func()) {
+	ch := make(chan struct{}, 1)
+
+	m.mutex.Lock()
+	if m.subscribers[jobID] == nil {
+		m.subscribers[jobID] = make(map[chan struct{}]struct{})
+	}
+	m.subscribers[jobID][ch] = struct{}{}
+	m.mutex.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			m.mutex.Lock()
+			delete(m.subscribers[jobID], ch)
+			if len(m.subscribers[jobID]) == 0 {
+				delete(m.subscribers, jobID)
+			}
+			m.mutex.Unlock()
+		})
+	}
+}
+
+// notifyLocked : 구독자에게 변경 알림 전송 (호출자가 잠금 보유, 대기 중인 알림이 있으면 생략)
+func (m *MemoryJobManager) notifyLocked(jobID string) {
+	for ch := range m.subscribers[jobID] {
+		select {
+		case ch <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// SetJobRecovery : 서버 재시작 후 재연결을 위한 정보 설정
+func (m *MemoryJobManager) SetJobRecovery(jobID string, info RecoveryInfo) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if job, exists := m.jobs[jobID]; exists {
+		job.Recovery = &info
+	}
+}
+
+// InterruptedJobs : 재시작으로 중단된 작업 목록 (메모리 관리자는 항상 비어 있음)
+func (m *MemoryJobManager) InterruptedJobs() []*JobInfo {
+	return nil
+}
+
+// PruneJobs : 보존 정책에 따라 완료된 작업 정리 후 삭제된 작업 ID 반환
+func (m *MemoryJobManager) PruneJobs(policy RetentionPolicy) []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	var pruned []string
+	var finished []*JobInfo
+	now := time.Now()
+
+	for id, job := range m.jobs {
+		if !job.Status.IsFinished() {
+			continue
+		}
+		if policy.MaxAge > 0 && now.Sub(job.UpdatedAt) > policy.MaxAge {
+			delete(m.jobs, id)
+			pruned = append(pruned, id)
+			continue
+		}
+		finished = append(finished, job)
+	}
+
+	// 최대 개수 초과 시 오래된 완료 작업부터 삭제 (진행 중인 작업은 유지)
+	if policy.MaxJobs > 0 && len(m.jobs) > policy.MaxJobs {
+		sort.Slice(finished, func(i, j int) bool {
+			return finished[i].UpdatedAt.Before(finished[j].UpdatedAt)
+		})
+		for _, job := range finished {
+			if len(m.jobs) <= policy.MaxJobs {
+				break
+			}
+			delete(m.jobs, job.JobID)
+			pruned = append(pruned, job.JobID)
+		}
+	}
+
+	return pruned
+}
+
+// Snapshot : 작업 정보 복사본 반환 (영속화/스트리밍용)
+func (m *MemoryJobManager) Snapshot(jobID string) (*JobInfo, bool) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	job, exists := m.jobs[jobID]
+	if !exists {
+		return nil, false
+	}
+	return copyJob(job), true
+}
+
+// copyJob : 작업 정보 복사 (Logs는 append로 갱신되므로 별도 복사, Metadata는 갱신 시 교체되므로 공유)
+func copyJob(job *JobInfo) *JobInfo {
+	copied := *job
+	copied.Logs = append([]string(nil), job.Logs...)
+	return &copied
+}
+
+// restore : 저장소에서 불러온 작업 등록
+func (m *MemoryJobManager) restore(job *JobInfo) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.jobs[job.JobID] = job
+}
+
+// startPruner : 주기적으로 보존 정책을 적용하는 백그라운드 작업 시작
+func (m *MemoryJobManager) startPruner(interval time.Duration, prune func()) {
+	if interval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				prune()
+			case <-m.done:
+				return
+			}
+		}
+	}()
 }
 
 // RetryOperation : 재시도 로직이 포함된 작업 실행 (인터페이스 호환)
@@ -238,14 +543,24 @@ func (m *MemoryJobManager) RetryOperation(jobID, operationName string, maxAttemp
 
 // RetryOperationWithDelay : 재시도 로직이 포함된 작업 실행 (지연 포함)
 func (m *MemoryJobManager) RetryOperationWithDelay(jobID, operationName string, maxAttempts int, delay time.Duration, operation func() error) error {
-	return m.retryOperationInternal(jobID, operation, maxAttempts, delay, operationName)
+	return retryOperation(m, jobID, operation, maxAttempts, delay, operationName)
 }
 
 // retryOperationInternal : 재시도 로직 내부 구현
 func (m *MemoryJobManager) retryOperationInternal(jobID string, operation func() error, maxAttempts int, delay time.Duration, operationName string) error {
+	return retryOperation(m, jobID, operation, maxAttempts, delay, operationName)
+}
+
+// retryOperation : 재시도 로직 공통 구현 (상태 갱신은 전달된 관리자를 통해 기록)
+func retryOperation(m JobManager, jobID string, operation func() error, maxAttempts int, delay time.Duration, operationName string) error {
 	var lastErr error
 
 	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		// 취소된 작업은 재시도하지 않음
+		if job, exists := m.GetJob(jobID); exists && job.Status == JobStatusCancelled {
+			return fmt.Errorf("%s cancelled", operationName)
+		}
+
 		m.UpdateJobStatus(jobID, JobStatusProcessing, (attempt-1)*100/maxAttempts, fmt.Sprintf("%s (attempt %d/%d)", operationName, attempt, maxAttempts))
 
 		err := operation()
@@ -305,10 +620,15 @@ func (m *MemoryJobManager) ExecuteJobWithRetryAsync(jobID string, task func() er
 	return nil
 }
 
-// Close : 워커 풀 종료
+// Close : 워커 풀 및 백그라운드 정리 작업 종료
 func (m *MemoryJobManager) Close() error {
-	if m.workerPool != nil {
-		m.workerPool.Close()
-	}
+	m.closeOnce.Do(func() {
+		if m.done != nil {
+			close(m.done)
+		}
+		if m.workerPool != nil {
+			m.workerPool.Close()
+		}
+	})
 	return nil
 }
@@ -0,0 +1,412 @@
+package job
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/taking/kubemigrate/internal/metrics"
+)
+
+// TestPersistentJobManager_Reload 재시작 후 작업 복원 테스트
+func TestPersistentJobManager_Reload(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir(), nil)
+	if err != nil {
+		t.Fatalf("NewFileJobStore() error = %v", err)
+	}
+
+	manager, err := NewPersistentJobManager(1, store)
+	if err != nil {
+		t.Fatalf("NewPersistentJobManager() error = %v", err)
+	}
+	manager.CreateJob("backup-create-1", map[string]interface{}{"backupName": "b1"})
+	manager.SetJobRecovery("backup-create-1", RecoveryInfo{Kind: "velero-backup", Namespace: "velero", Name: "b1"})
+	manager.AddJobLog("backup-create-1", "started")
+	manager.CreateJob("backup-create-2", nil)
+	manager.FailJob("backup-create-2", errors.New("boom"))
+	_ = manager.Close()
+
+	// 재시작 시뮬레이션
+	reloaded, err := NewPersistentJobManager(1, store)
+	if err != nil {
+		t.Fatalf("NewPersistentJobManager() reload error = %v", err)
+	}
+	defer reloaded.Close()
+
+	if len(reloaded.GetAllJobs()) != 2 {
+		t.Fatalf("Expected 2 jobs after reload, got %d", len(reloaded.GetAllJobs()))
+	}
+
+	interrupted := reloaded.InterruptedJobs()
+	if len(interrupted) != 1 || interrupted[0].JobID != "backup-create-1" {
+		t.Fatalf("Expected backup-create-1 to be interrupted, got %+v", interrupted)
+	}
+	if interrupted[0].Recovery == nil || interrupted[0].Recovery.Name != "b1" {
+		t.Errorf("Expected recovery info to be restored, got %+v", interrupted[0].Recovery)
+	}
+	if len(interrupted[0].Logs) != 1 {
+		t.Errorf("Expected logs to be restored, got %v", interrupted[0].Logs)
+	}
+
+	failed, _ := reloaded.GetJob("backup-create-2")
+	if failed.Status != JobStatusFailed || failed.Message != "boom" {
+		t.Errorf("Expected failed job to be restored, got %+v", failed)
+	}
+}
+
+// TestPersistentJobManager_Prune 보존 정책 적용 테스트
+func TestPersistentJobManager_Prune(t *testing.T) {
+	dir := t.TempDir()
+	store, err := NewFileJobStore(dir, nil)
+	if err != nil {
+		t.Fatalf("NewFileJobStore() error = %v", err)
+	}
+
+	manager, err := NewPersistentJobManager(1, store)
+	if err != nil {
+		t.Fatalf("NewPersistentJobManager() error = %v", err)
+	}
+	defer manager.Close()
+
+	manager.CreateJob("old", nil)
+	manager.CompleteJob("old", nil)
+	time.Sleep(10 * time.Millisecond)
+	manager.CreateJob("new", nil)
+	manager.CompleteJob("new", nil)
+	manager.CreateJob("running", nil)
+
+	pruned := manager.PruneJobs(RetentionPolicy{MaxJobs: 2})
+	if len(pruned) != 1 || pruned[0] != "old" {
+		t.Fatalf("Expected only 'old' to be pruned, got %v", pruned)
+	}
+	if _, exists := manager.GetJob("running"); !exists {
+		t.Error("Expected running job to be kept")
+	}
+	if _, err := os.Stat(filepath.Join(dir, "old.json")); !os.IsNotExist(err) {
+		t.Errorf("Expected pruned job file to be removed, stat err = %v", err)
+	}
+}
+
+// TestFileJobStore_InvalidJobID 잘못된 작업 ID 거부 테스트
+func TestFileJobStore_InvalidJobID(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir(), nil)
+	if err != nil {
+		t.Fatalf("NewFileJobStore() error = %v", err)
+	}
+
+	if err := store.Save(&JobRecord{Job: &JobInfo{JobID: "../escape"}}); err == nil {
+		t.Error("Expected error for job ID with path separators")
+	}
+}
+
+// reverseSealer : 테스트용 암호화 (바이트 순서 반전)
+type reverseSealer struct{}
+
+func (reverseSealer) Seal(plaintext []byte) ([]byte, error) { return reverse(plaintext), nil }
+func (reverseSealer) Open(data []byte) ([]byte, error)      { return reverse(data), nil }
+
+func reverse(data []byte) []byte {
+	out := make([]byte, len(data))
+	for i, b := range data {
+		out[len(data)-1-i] = b
+	}
+	return out
+}
+
+// TestFileJobStore_RecoveryKubeConfig 복구 정보의 kubeconfig가 평문으로 기록되지 않는지 테스트
+func TestFileJobStore_RecoveryKubeConfig(t *testing.T) {
+	recovery := RecoveryInfo{Kind: "velero-restore", Namespace: "velero", Name: "r1", KubeConfig: "super-secret-kubeconfig"}
+
+	tests := []struct {
+		name           string
+		sealer         Sealer
+		wantKubeConfig string
+	}{
+		{"sealed", reverseSealer{}, recovery.KubeConfig},
+		{"no sealer", nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			store, err := NewFileJobStore(dir, tt.sealer)
+			if err != nil {
+				t.Fatalf("NewFileJobStore() error = %v", err)
+			}
+
+			if err := store.Save(&JobRecord{Job: &JobInfo{JobID: "restore-1"}, Recovery: &recovery}); err != nil {
+				t.Fatalf("Save() error = %v", err)
+			}
+
+			data, err := os.ReadFile(filepath.Join(dir, "restore-1.json"))
+			if err != nil {
+				t.Fatalf("ReadFile() error = %v", err)
+			}
+			if bytes.Contains(data, []byte(recovery.KubeConfig)) {
+				t.Errorf("kubeconfig was written in plaintext: %s", data)
+			}
+
+			records, err := store.LoadAll()
+			if err != nil || len(records) != 1 || records[0].Recovery == nil {
+				t.Fatalf("LoadAll() = %+v, %v", records, err)
+			}
+			if got := records[0].Recovery; got.Name != "r1" || got.KubeConfig != tt.wantKubeConfig {
+				t.Errorf("Unexpected recovery info after reload: %+v", got)
+			}
+		})
+	}
+}
+
+// TestPersistentJobManager_ConcurrentPersist 동시 갱신 후 마지막 기록이 최신 상태인지 테스트
+func TestPersistentJobManager_ConcurrentPersist(t *testing.T) {
+	store, err := NewFileJobStore(t.TempDir(), nil)
+	if err != nil {
+		t.Fatalf("NewFileJobStore() error = %v", err)
+	}
+
+	manager, err := NewPersistentJobManager(1, store)
+	if err != nil {
+		t.Fatalf("NewPersistentJobManager() error = %v", err)
+	}
+	defer manager.Close()
+
+	manager.CreateJob("job-1", nil)
+
+	const writers = 50
+	var wg sync.WaitGroup
+	for i := 0; i < writers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			manager.AddJobLog("job-1", fmt.Sprintf("log %d", i))
+		}(i)
+	}
+	wg.Wait()
+
+	records, err := store.LoadAll()
+	if err != nil || len(records) != 1 {
+		t.Fatalf("LoadAll() = %+v, %v", records, err)
+	}
+	if got := len(records[0].Job.Logs); got != writers {
+		t.Errorf("Expected the persisted job to have %d logs, got %d", writers, got)
+	}
+}
+
+// TestMemoryJobManager_TransitionJobStatus 동시 상태 전환 중 하나만 성공하는지 테스트
+func TestMemoryJobManager_TransitionJobStatus(t *testing.T) {
+	manager := NewMemoryJobManagerWithWorkers(1)
+	defer manager.Close()
+
+	manager.CreateJob("migration-1", nil)
+	manager.FailJob("migration-1", errors.New("step failed"))
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	claimed := 0
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if manager.TransitionJobStatus("migration-1", JobStatusFailed, JobStatusProcessing, "Resuming") {
+				mu.Lock()
+				claimed++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if claimed != 1 {
+		t.Fatalf("Expected exactly one transition to succeed, got %d", claimed)
+	}
+	if job, _ := manager.Snapshot("migration-1"); job.Status != JobStatusProcessing || job.Message != "Resuming" {
+		t.Errorf("Unexpected job after transition: %+v", job)
+	}
+	if manager.TransitionJobStatus("missing", JobStatusFailed, JobStatusProcessing, "Resuming") {
+		t.Error("Expected transition of a missing job to fail")
+	}
+}
+
+// TestMemoryJobManager_CancelJob 작업 취소 테스트
+// TestMemoryJobManager_GetJobReturnsCopy 조회 결과가 이후 상태 갱신과 공유되지 않는지 테스트
+func TestMemoryJobManager_GetJobReturnsCopy(t *testing.T) {
+	manager := NewMemoryJobManagerWithWorkers(1)
+	defer manager.Close()
+
+	manager.CreateJob("backup-1", nil)
+	manager.AddJobLog("backup-1", "started")
+
+	job, exists := manager.GetJob("backup-1")
+	if !exists {
+		t.Fatal("Expected job to exist")
+	}
+
+	// 조회 후 갱신이 이미 반환된 값에 반영되지 않아야 함 (go test -race로 경합 확인)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		manager.UpdateJobStatus("backup-1", JobStatusProcessing, 50, "Working")
+		manager.AddJobLog("backup-1", "progress")
+	}()
+	status, logs := job.Status, len(job.Logs)
+	<-done
+
+	if status != JobStatusPending || logs != 1 {
+		t.Errorf("Expected pending job with 1 log, got %s with %d logs", status, logs)
+	}
+	if all := manager.GetAllJobs(); all["backup-1"] == job || all["backup-1"].Status != JobStatusProcessing {
+		t.Errorf("Expected GetAllJobs to return an updated copy, got %+v", all["backup-1"])
+	}
+}
+
+// TestMemoryJobManager_CreateJobReturnsCopy 생성 결과(응답의 job)가 워커의 상태 갱신과 공유되지 않는지 테스트
+func TestMemoryJobManager_CreateJobReturnsCopy(t *testing.T) {
+	manager := NewMemoryJobManagerWithWorkers(1)
+	defer manager.Close()
+
+	created := manager.CreateJob("install-1", map[string]interface{}{"namespace": "velero"})
+
+	// 워커와 응답 직렬화가 동시에 실행되는 상황 (go test -race로 경합 확인)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		manager.UpdateJobStatus("install-1", JobStatusProcessing, 10, "Starting")
+		manager.AddJobLog("install-1", "progress")
+		manager.SetJobMetadata("install-1", "step", "install")
+	}()
+	encoded, err := json.Marshal(created)
+	<-done
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+
+	if created.Status != JobStatusPending || len(created.Logs) != 0 || created.Metadata["step"] != nil {
+		t.Errorf("Expected created job to stay pending without updates, got %s", encoded)
+	}
+	if current, _ := manager.GetJob("install-1"); current.Status != JobStatusProcessing {
+		t.Errorf("Expected stored job to be updated, got %s", current.Status)
+	}
+}
+
+func TestMemoryJobManager_CreateJobIfAbsent(t *testing.T) {
+	manager := NewMemoryJobManagerWithWorkers(1)
+	defer manager.Close()
+
+	const callers = 16
+	var created atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < callers; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if _, ok := manager.CreateJobIfAbsent("upload-1", map[string]interface{}{"caller": i}); ok {
+				created.Add(1)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if created.Load() != 1 {
+		t.Fatalf("Expected exactly one job to be created, got %d", created.Load())
+	}
+
+	manager.UpdateJobStatus("upload-1", JobStatusProcessing, 10, "Uploading")
+	existing, ok := manager.CreateJobIfAbsent("upload-1", nil)
+	if ok {
+		t.Fatal("Expected existing job not to be replaced")
+	}
+	if existing.Status != JobStatusProcessing {
+		t.Errorf("Expected existing job status processing, got %s", existing.Status)
+	}
+}
+
+func TestMemoryJobManager_CancelJob(t *testing.T) {
+	manager := NewMemoryJobManagerWithWorkers(1)
+	defer manager.Close()
+
+	manager.CreateJob("install-1", nil)
+	ctx, cancel := manager.JobContext("install-1", time.Minute)
+	defer cancel()
+
+	if err := manager.CancelJob("install-1"); err != nil {
+		t.Fatalf("CancelJob() error = %v", err)
+	}
+	if !IsCancelled(ctx) {
+		t.Error("Expected job context to be cancelled")
+	}
+
+	// 취소 이후 백그라운드 고루틴의 상태 갱신은 무시되어야 함
+	manager.FailJob("install-1", errors.New("context canceled"))
+	got, _ := manager.GetJob("install-1")
+	if got.Status != JobStatusCancelled {
+		t.Errorf("Expected status %q, got %q", JobStatusCancelled, got.Status)
+	}
+
+	if err := manager.CancelJob("install-1"); !errors.Is(err, ErrJobNotRunning) {
+		t.Errorf("Expected ErrJobNotRunning, got %v", err)
+	}
+	if err := manager.CancelJob("missing"); !errors.Is(err, ErrJobNotFound) {
+		t.Errorf("Expected ErrJobNotFound, got %v", err)
+	}
+}
+
+// TestType 메트릭용 작업 종류 테스트
+func TestType(t *testing.T) {
+	tests := []struct {
+		job      *JobInfo
+		expected string
+	}{
+		{&JobInfo{JobID: "backup-create-1705312800000000000"}, "backup-create"},
+		{&JobInfo{JobID: "migration-42"}, "migration"},
+		{&JobInfo{JobID: "nightly-upload"}, "other"},
+		{&JobInfo{JobID: "12345"}, "other"},
+		{&JobInfo{JobID: "nightly-upload", Metadata: map[string]interface{}{MetadataKeyType: "minio-upload"}}, "minio-upload"},
+	}
+
+	for _, tt := range tests {
+		if got := Type(tt.job); got != tt.expected {
+			t.Errorf("Type(%q) = %q, want %q", tt.job.JobID, got, tt.expected)
+		}
+	}
+}
+
+// TestMemoryJobManager_Metrics 작업 종류/상태별 메트릭 테스트
+func TestMemoryJobManager_Metrics(t *testing.T) {
+	manager := NewMemoryJobManagerWithWorkers(1)
+	defer manager.Close()
+	manager.registerMetrics("metrics-test")
+
+	manager.CreateJob("install-1", nil)
+	manager.CreateJob("install-2", nil)
+	manager.CreateJob("upgrade-1", nil)
+	manager.CompleteJob("install-1", nil)
+	manager.CompleteJob("install-1", nil) // 이미 종료된 작업은 다시 기록하지 않음
+	manager.FailJob("upgrade-1", errors.New("boom"))
+
+	var buf bytes.Buffer
+	if err := metrics.Default.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	output := buf.String()
+
+	for _, line := range []string{
+		`kubemigrate_jobs{manager="metrics-test",type="install",status="completed"} 1`,
+		`kubemigrate_jobs{manager="metrics-test",type="install",status="pending"} 1`,
+		`kubemigrate_jobs{manager="metrics-test",type="upgrade",status="failed"} 1`,
+		`kubemigrate_jobs_created_total{manager="metrics-test",type="install"} 2`,
+		`kubemigrate_job_duration_seconds_count{manager="metrics-test",type="install",status="completed"} 1`,
+		`kubemigrate_job_duration_seconds_count{manager="metrics-test",type="upgrade",status="failed"} 1`,
+		`kubemigrate_worker_pool_workers{pool="jobs-metrics-test"} 1`,
+	} {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("Expected metrics output to contain %q", line)
+		}
+	}
+}
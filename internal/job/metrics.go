@@ -0,0 +1,68 @@
+package job
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/taking/kubemigrate/internal/metrics"
+)
+
+// defaultManagerName : 이름 없이 생성한 작업 관리자의 메트릭 레이블
+const defaultManagerName = "default"
+
+// MetadataKeyType : 작업 ID로 종류를 알 수 없는 작업(사용자 지정 ID 등)의 종류를 지정하는 메타데이터 키
+const MetadataKeyType = "jobType"
+
+// Type : 메트릭용 작업 종류
+// 메타데이터 jobType이 있으면 사용하고, 없으면 작업 ID의 "-<숫자>" 접미사를 제외한 접두사 (예: backup-create-1705312800 → backup-create)
+func Type(job *JobInfo) string {
+	if jobType, ok := job.Metadata[MetadataKeyType].(string); ok && jobType != "" {
+		return jobType
+	}
+
+	i := strings.LastIndex(job.JobID, "-")
+	if i <= 0 || i == len(job.JobID)-1 {
+		return "other"
+	}
+	for _, r := range job.JobID[i+1:] {
+		if r < '0' || r > '9' {
+			return "other"
+		}
+	}
+	return job.JobID[:i]
+}
+
+// observeFinishLocked : 작업이 종료 상태로 바뀐 경우 소요 시간 기록 (호출자가 잠금 보유)
+func (m *MemoryJobManager) observeFinishLocked(job *JobInfo, previous JobStatus) {
+	if previous.IsFinished() || !job.Status.IsFinished() {
+		return
+	}
+	metrics.JobDuration.Observe(time.Since(job.CreatedAt).Seconds(), m.name, Type(job), string(job.Status))
+}
+
+// jobSamples : 종류/상태별 작업 수
+func (m *MemoryJobManager) jobSamples() []metrics.Sample {
+	m.mutex.RLock()
+	counts := make(map[[2]string]int)
+	for _, job := range m.jobs {
+		counts[[2]string{Type(job), string(job.Status)}]++
+	}
+	m.mutex.RUnlock()
+
+	samples := make([]metrics.Sample, 0, len(counts))
+	for key, count := range counts {
+		samples = append(samples, metrics.Sample{LabelValues: []string{m.name, key[0], key[1]}, Value: float64(count)})
+	}
+	sort.Slice(samples, func(i, j int) bool {
+		return strings.Join(samples[i].LabelValues, "/") < strings.Join(samples[j].LabelValues, "/")
+	})
+	return samples
+}
+
+// registerMetrics : 작업 수와 워커 풀을 관리자 이름으로 메트릭에 등록 (같은 이름으로 다시 등록하면 교체)
+func (m *MemoryJobManager) registerMetrics(name string) {
+	m.name = name
+	metrics.Jobs.Register(name, m.jobSamples)
+	m.workerPool.RegisterMetrics("jobs-" + name)
+}
@@ -0,0 +1,199 @@
+package job
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/taking/kubemigrate/internal/logger"
+)
+
+// PersistentJobManager : 저장소에 작업 상태를 기록하는 작업 관리자
+// 메모리 관리자를 기반으로 모든 상태 변경을 JobStore에 반영하고,
+// 재시작 시 저장된 작업을 복원하여 중단된 작업 목록을 제공
+type PersistentJobManager struct {
+	*MemoryJobManager
+	store       JobStore
+	interrupted []*JobInfo
+
+	// 작업별 저장 잠금 (동시 갱신 시 오래된 스냅샷이 마지막으로 기록되지 않도록 직렬화)
+	persistMutex sync.Mutex
+	persistLocks map[string]*sync.Mutex
+}
+
+// NewPersistentJobManager : 저장소 기반 작업 관리자 생성 (저장된 작업 복원 포함)
+func NewPersistentJobManager(workers int, store JobStore) (*PersistentJobManager, error) {
+	records, err := store.LoadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load jobs: %w", err)
+	}
+
+	m := &PersistentJobManager{
+		MemoryJobManager: NewMemoryJobManagerWithWorkers(workers),
+		store:            store,
+		persistLocks:     make(map[string]*sync.Mutex),
+	}
+
+	for _, record := range records {
+		record.Job.Recovery = record.Recovery
+		m.restore(record.Job)
+
+		if !record.Job.Status.IsFinished() {
+			m.interrupted = append(m.interrupted, record.Job)
+		}
+	}
+
+	return m, nil
+}
+
+// CreateJob : 작업 생성
+func (m *PersistentJobManager) CreateJob(jobID string, metadata map[string]interface{}) *JobInfo {
+	job := m.MemoryJobManager.CreateJob(jobID, metadata)
+	m.persist(jobID)
+	return job
+}
+
+// CreateJobIfAbsent : 같은 ID의 작업이 없을 때만 작업 생성
+func (m *PersistentJobManager) CreateJobIfAbsent(jobID string, metadata map[string]interface{}) (*JobInfo, bool) {
+	job, created := m.MemoryJobManager.CreateJobIfAbsent(jobID, metadata)
+	if created {
+		m.persist(jobID)
+	}
+	return job, created
+}
+
+// UpdateJobStatus : 작업 상태 업데이트
+func (m *PersistentJobManager) UpdateJobStatus(jobID string, status JobStatus, progress int, message string) {
+	m.MemoryJobManager.UpdateJobStatus(jobID, status, progress, message)
+	m.persist(jobID)
+}
+
+// TransitionJobStatus : 현재 상태가 from인 경우에만 상태 변경
+func (m *PersistentJobManager) TransitionJobStatus(jobID string, from, to JobStatus, message string) bool {
+	if !m.MemoryJobManager.TransitionJobStatus(jobID, from, to, message) {
+		return false
+	}
+	m.persist(jobID)
+	return true
+}
+
+// AddJobLog : 작업 로그 추가
+func (m *PersistentJobManager) AddJobLog(jobID string, log string) {
+	m.MemoryJobManager.AddJobLog(jobID, log)
+	m.persist(jobID)
+}
+
+// CompleteJob : 작업 완료
+func (m *PersistentJobManager) CompleteJob(jobID string, result interface{}) {
+	m.MemoryJobManager.CompleteJob(jobID, result)
+	m.persist(jobID)
+}
+
+// FailJob : 작업 실패
+func (m *PersistentJobManager) FailJob(jobID string, err error) {
+	m.MemoryJobManager.FailJob(jobID, err)
+	m.persist(jobID)
+}
+
+// SetJobMetadata : 작업 메타데이터 값 설정
+func (m *PersistentJobManager) SetJobMetadata(jobID, key string, value interface{}) {
+	m.MemoryJobManager.SetJobMetadata(jobID, key, value)
+	m.persist(jobID)
+}
+
+// SetJobRecovery : 재연결 정보 설정
+func (m *PersistentJobManager) SetJobRecovery(jobID string, info RecoveryInfo) {
+	m.MemoryJobManager.SetJobRecovery(jobID, info)
+	m.persist(jobID)
+}
+
+// CancelJob : 작업 취소
+func (m *PersistentJobManager) CancelJob(jobID string) error {
+	if err := m.MemoryJobManager.CancelJob(jobID); err != nil {
+		return err
+	}
+	m.persist(jobID)
+	return nil
+}
+
+// DeleteJob : 작업 삭제
+func (m *PersistentJobManager) DeleteJob(jobID string) {
+	m.MemoryJobManager.DeleteJob(jobID)
+	m.deletePersisted(jobID, "Failed to delete persisted job")
+}
+
+// InterruptedJobs : 서버 재시작 전에 완료되지 않은 작업 목록
+func (m *PersistentJobManager) InterruptedJobs() []*JobInfo {
+	return m.interrupted
+}
+
+// PruneJobs : 보존 정책에 따라 작업 정리 (저장소에서도 삭제)
+func (m *PersistentJobManager) PruneJobs(policy RetentionPolicy) []string {
+	pruned := m.MemoryJobManager.PruneJobs(policy)
+	for _, jobID := range pruned {
+		m.deletePersisted(jobID, "Failed to delete pruned job")
+	}
+	return pruned
+}
+
+// RetryOperation : 재시도 로직이 포함된 작업 실행
+func (m *PersistentJobManager) RetryOperation(jobID, operationName string, maxAttempts int, operation func() error) error {
+	return m.RetryOperationWithDelay(jobID, operationName, maxAttempts, 5*time.Second, operation)
+}
+
+// RetryOperationWithDelay : 재시도 로직이 포함된 작업 실행 (지연 포함)
+func (m *PersistentJobManager) RetryOperationWithDelay(jobID, operationName string, maxAttempts int, delay time.Duration, operation func() error) error {
+	return retryOperation(m, jobID, operation, maxAttempts, delay, operationName)
+}
+
+// persist : 현재 작업 상태를 저장소에 기록
+// 스냅샷은 작업별 잠금 안에서 조회하므로 마지막으로 기록되는 파일은 항상 최신 상태
+func (m *PersistentJobManager) persist(jobID string) {
+	lock := m.persistLock(jobID)
+	lock.Lock()
+	defer lock.Unlock()
+
+	job, exists := m.Snapshot(jobID)
+	if !exists {
+		return
+	}
+
+	if err := m.store.Save(&JobRecord{Job: job, Recovery: job.Recovery}); err != nil {
+		logger.Warn("Failed to persist job",
+			logger.String("job_id", jobID),
+			logger.String("error", err.Error()),
+		)
+	}
+}
+
+// deletePersisted : 저장소에서 작업 기록 삭제 (진행 중인 저장이 끝난 뒤 삭제하고 잠금 정리)
+func (m *PersistentJobManager) deletePersisted(jobID, message string) {
+	lock := m.persistLock(jobID)
+	lock.Lock()
+	err := m.store.Delete(jobID)
+	lock.Unlock()
+
+	m.persistMutex.Lock()
+	delete(m.persistLocks, jobID)
+	m.persistMutex.Unlock()
+
+	if err != nil {
+		logger.Warn(message,
+			logger.String("job_id", jobID),
+			logger.String("error", err.Error()),
+		)
+	}
+}
+
+// persistLock : 작업별 저장 잠금 조회 (없으면 생성)
+func (m *PersistentJobManager) persistLock(jobID string) *sync.Mutex {
+	m.persistMutex.Lock()
+	defer m.persistMutex.Unlock()
+
+	lock, exists := m.persistLocks[jobID]
+	if !exists {
+		lock = &sync.Mutex{}
+		m.persistLocks[jobID] = lock
+	}
+	return lock
+}
@@ -0,0 +1,193 @@
+package job
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// JobRecord : 저장소에 기록되는 작업 정보
+type JobRecord struct {
+	Job      *JobInfo      `json:"job"`
+	Recovery *RecoveryInfo `json:"recovery,omitempty"`
+
+	// SealedRecovery : 암호화된 복구 정보 (kubeconfig 포함, Recovery 대신 기록)
+	SealedRecovery []byte `json:"sealedRecovery,omitempty"`
+}
+
+// Sealer : 복구 정보 암호화/복호화 인터페이스
+type Sealer interface {
+	Seal(plaintext []byte) ([]byte, error)
+	Open(data []byte) ([]byte, error)
+}
+
+// JobStore : 작업 영속화 저장소 인터페이스
+type JobStore interface {
+	Save(record *JobRecord) error
+	Delete(jobID string) error
+	LoadAll() ([]*JobRecord, error)
+}
+
+// jobIDPattern : 파일명으로 사용할 수 있는 작업 ID 패턴
+var jobIDPattern = regexp.MustCompile(`^[A-Za-z0-9._-]+$`)
+
+// FileJobStore : 디렉토리에 작업별 JSON 파일로 저장하는 저장소
+// 복구 정보의 kubeconfig는 sealer로 암호화하여 기록하고, sealer가 없으면 기록하지 않음
+// (디렉토리는 0700, 파일은 0600 권한으로 생성)
+type FileJobStore struct {
+	dir    string
+	sealer Sealer
+}
+
+// NewFileJobStore : 파일 기반 작업 저장소 생성 (sealer가 nil이면 kubeconfig를 저장하지 않음)
+func NewFileJobStore(dir string, sealer Sealer) (*FileJobStore, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("job store path is required")
+	}
+
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create job store directory %s: %w", dir, err)
+	}
+
+	return &FileJobStore{dir: dir, sealer: sealer}, nil
+}
+
+// Save : 작업 기록 저장 (임시 파일 작성 후 rename으로 원자적 교체)
+func (s *FileJobStore) Save(record *JobRecord) error {
+	path, err := s.path(record.Job.JobID)
+	if err != nil {
+		return err
+	}
+
+	stored, err := s.seal(record)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return fmt.Errorf("failed to encode job %s: %w", record.Job.JobID, err)
+	}
+
+	tmp, err := os.CreateTemp(s.dir, ".job-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file for job %s: %w", record.Job.JobID, err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck
+		return fmt.Errorf("failed to write job %s: %w", record.Job.JobID, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write job %s: %w", record.Job.JobID, err)
+	}
+
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("failed to save job %s: %w", record.Job.JobID, err)
+	}
+
+	return nil
+}
+
+// Delete : 작업 기록 삭제
+func (s *FileJobStore) Delete(jobID string) error {
+	path, err := s.path(jobID)
+	if err != nil {
+		return err
+	}
+
+	if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to delete job %s: %w", jobID, err)
+	}
+
+	return nil
+}
+
+// LoadAll : 저장된 모든 작업 기록 조회 (손상된 파일은 건너뜀)
+func (s *FileJobStore) LoadAll() ([]*JobRecord, error) {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read job store directory %s: %w", s.dir, err)
+	}
+
+	var records []*JobRecord
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+
+		var record JobRecord
+		if err := json.Unmarshal(data, &record); err != nil || record.Job == nil || record.Job.JobID == "" {
+			continue
+		}
+		s.open(&record)
+
+		records = append(records, &record)
+	}
+
+	return records, nil
+}
+
+// seal : 저장용 기록 생성 (kubeconfig가 있는 복구 정보는 암호화, sealer가 없으면 kubeconfig 제외)
+func (s *FileJobStore) seal(record *JobRecord) (*JobRecord, error) {
+	if record.Recovery == nil || record.Recovery.KubeConfig == "" {
+		return record, nil
+	}
+
+	stored := &JobRecord{Job: record.Job}
+	if s.sealer == nil {
+		recovery := *record.Recovery
+		recovery.KubeConfig = ""
+		stored.Recovery = &recovery
+		return stored, nil
+	}
+
+	plaintext, err := json.Marshal(record.Recovery)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode recovery info for job %s: %w", record.Job.JobID, err)
+	}
+	if stored.SealedRecovery, err = s.sealer.Seal(plaintext); err != nil {
+		return nil, fmt.Errorf("failed to encrypt recovery info for job %s: %w", record.Job.JobID, err)
+	}
+	return stored, nil
+}
+
+// open : 암호화된 복구 정보 복호화 (복호화할 수 없으면 복구 정보 없이 복원)
+func (s *FileJobStore) open(record *JobRecord) {
+	if len(record.SealedRecovery) == 0 {
+		return
+	}
+
+	sealed := record.SealedRecovery
+	record.SealedRecovery = nil
+	if s.sealer == nil {
+		return
+	}
+
+	plaintext, err := s.sealer.Open(sealed)
+	if err != nil {
+		return
+	}
+
+	var recovery RecoveryInfo
+	if err := json.Unmarshal(plaintext, &recovery); err == nil {
+		record.Recovery = &recovery
+	}
+}
+
+// path : 작업 ID에 해당하는 파일 경로
+func (s *FileJobStore) path(jobID string) (string, error) {
+	if !jobIDPattern.MatchString(jobID) {
+		return "", fmt.Errorf("invalid job id for file store: %s", jobID)
+	}
+	return filepath.Join(s.dir, jobID+".json"), nil
+}
@@ -1,6 +1,8 @@
 package job
 
 import (
+	"context"
+	"errors"
 	"time"
 )
 
@@ -12,8 +14,20 @@ const (
 	JobStatusProcessing JobStatus = "processing"
 	JobStatusCompleted  JobStatus = "completed"
 	JobStatusFailed     JobStatus = "failed"
+	JobStatusCancelled  JobStatus = "cancelled"
 )
 
+// 작업 취소 관련 에러
+var (
+	ErrJobNotFound   = errors.New("job not found")
+	ErrJobNotRunning = errors.New("job is already finished")
+)
+
+// IsFinished : 완료/실패/취소 등 더 이상 진행되지 않는 상태인지 확인
+func (s JobStatus) IsFinished() bool {
+	return s == JobStatusCompleted || s == JobStatusFailed || s == JobStatusCancelled
+}
+
 // JobInfo : 작업 정보
 type JobInfo struct {
 	JobID     string                 `json:"jobId"`
@@ -26,17 +40,46 @@ type JobInfo struct {
 	Error     string                 `json:"error,omitempty"`
 	Logs      []string               `json:"logs,omitempty"`
 	Metadata  map[string]interface{} `json:"metadata,omitempty"`
+
+	// Recovery : 서버 재시작 후 작업 재연결 정보 (API 응답에는 노출하지 않음)
+	Recovery *RecoveryInfo `json:"-"`
+}
+
+// RecoveryInfo : 서버 재시작 후 진행 중이던 작업에 다시 연결하기 위한 정보
+type RecoveryInfo struct {
+	Kind       string `json:"kind"`                 // 예: "velero-backup", "velero-restore"
+	Namespace  string `json:"namespace"`            // 대상 리소스 네임스페이스
+	Name       string `json:"name"`                 // 대상 리소스 이름
+	KubeConfig string `json:"kubeconfig,omitempty"` // 대상 클러스터 kubeconfig
+}
+
+// RetentionPolicy : 완료된 작업 보존 정책
+type RetentionPolicy struct {
+	MaxAge  time.Duration // 완료/실패 후 보존 기간 (0이면 기간 제한 없음)
+	MaxJobs int           // 보존할 최대 작업 수 (0이면 개수 제한 없음)
 }
 
 // JobManager : 작업 관리자 인터페이스
 type JobManager interface {
 	CreateJob(jobID string, metadata map[string]interface{}) *JobInfo
+	CreateJobIfAbsent(jobID string, metadata map[string]interface{}) (*JobInfo, bool)
 	UpdateJobStatus(jobID string, status JobStatus, progress int, message string)
+	TransitionJobStatus(jobID string, from, to JobStatus, message string) bool
 	AddJobLog(jobID string, log string)
+	SetJobMetadata(jobID, key string, value interface{})
 	CompleteJob(jobID string, result interface{})
 	FailJob(jobID string, err error)
 	GetJob(jobID string) (*JobInfo, bool)
+	Snapshot(jobID string) (*JobInfo, bool)
+	Subscribe(jobID string) (<-chan struct{}, func())
 	GetAllJobs() map[string]*JobInfo
+	DeleteJob(jobID string)
+	SetJobRecovery(jobID string, info RecoveryInfo)
+	InterruptedJobs() []*JobInfo
+	PruneJobs(policy RetentionPolicy) []string
+	JobContext(jobID string, timeout time.Duration) (context.Context, context.CancelFunc)
+	CancelJob(jobID string) error
 	RetryOperation(jobID, operationName string, maxAttempts int, operation func() error) error
 	RetryOperationWithDelay(jobID, operationName string, maxAttempts int, delay time.Duration, operation func() error) error
+	Close() error
 }
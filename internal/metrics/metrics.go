@@ -0,0 +1,59 @@
+package metrics
+
+// Default : 애플리케이션 기본 레지스트리 (GET /metrics로 노출)
+var Default = NewRegistry()
+
+// 히스토그램 버킷 (초)
+var (
+	httpDurationBuckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+	jobDurationBuckets  = []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600, 7200}
+)
+
+// HTTP 요청 메트릭 (route는 라우트 패턴, 매칭되지 않은 요청은 "unmatched")
+var (
+	HTTPRequests = Default.NewCounterVec("kubemigrate_http_requests_total",
+		"Total HTTP requests by method, route and status code.", "method", "route", "status")
+	HTTPRequestDuration = Default.NewHistogramVec("kubemigrate_http_request_duration_seconds",
+		"HTTP request latency by method and route.", httpDurationBuckets, "method", "route")
+	HTTPRequestsInFlight = Default.NewGaugeVec("kubemigrate_http_requests_in_flight",
+		"HTTP requests currently being served.")
+)
+
+// 작업 관리자 메트릭 (manager는 velero, helm, minio, migration 등, type은 작업 ID 접두사)
+var (
+	JobsCreated = Default.NewCounterVec("kubemigrate_jobs_created_total",
+		"Total jobs created by manager and type.", "manager", "type")
+	JobDuration = Default.NewHistogramVec("kubemigrate_job_duration_seconds",
+		"Duration from creation to completion, failure or cancellation of jobs by manager, type and final status.",
+		jobDurationBuckets, "manager", "type", "status")
+	Jobs = Default.NewGaugeFuncVec("kubemigrate_jobs",
+		"Jobs currently held by the job manager by manager, type and status.", "manager", "type", "status")
+)
+
+// 워커 풀 메트릭
+var (
+	WorkerPoolQueueDepth = Default.NewGaugeFuncVec("kubemigrate_worker_pool_queue_depth",
+		"Tasks waiting in the worker pool queue.", "pool")
+	WorkerPoolWorkers = Default.NewGaugeFuncVec("kubemigrate_worker_pool_workers",
+		"Number of workers in the worker pool.", "pool")
+)
+
+// LRU 캐시 메트릭 (cache는 client, velero-content 등)
+var (
+	CacheHits = Default.NewCounterFuncVec("kubemigrate_cache_hits_total",
+		"Total LRU cache hits.", "cache")
+	CacheMisses = Default.NewCounterFuncVec("kubemigrate_cache_misses_total",
+		"Total LRU cache misses (including expired entries).", "cache")
+	CacheEvictions = Default.NewCounterFuncVec("kubemigrate_cache_evictions_total",
+		"Total LRU cache evictions by reason (capacity, expired).", "cache", "reason")
+	CacheItems = Default.NewGaugeFuncVec("kubemigrate_cache_items",
+		"Items currently stored in the LRU cache.", "cache")
+)
+
+// Velero 메트릭 (목록/상세 조회 시 관찰한 단계 기준, cluster는 clusterId 또는 kubeconfig 지문)
+var (
+	VeleroBackups = Default.NewGaugeFuncVec("kubemigrate_velero_backups",
+		"Velero backups by cluster, namespace and last observed phase.", "cluster", "namespace", "phase")
+	VeleroRestores = Default.NewGaugeFuncVec("kubemigrate_velero_restores",
+		"Velero restores by cluster, namespace and last observed phase.", "cluster", "namespace", "phase")
+)
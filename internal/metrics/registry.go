@@ -0,0 +1,384 @@
+// Package metrics Prometheus 텍스트 형식(0.0.4)의 애플리케이션 메트릭을 제공합니다.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType : Prometheus 텍스트 형식 응답 Content-Type
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+// 메트릭 종류
+const (
+	kindCounter   = "counter"
+	kindGauge     = "gauge"
+	kindHistogram = "histogram"
+)
+
+// labelSeparator : 레이블 값 조합 키 구분자 (레이블 값에 나올 수 없는 바이트)
+const labelSeparator = "\xff"
+
+// Sample : 수집 함수가 반환하는 레이블 값과 측정값
+type Sample struct {
+	LabelValues []string
+	Value       float64
+}
+
+// collector : 레지스트리에 등록되는 메트릭
+type collector interface {
+	write(w *bufio.Writer)
+}
+
+// desc : 메트릭 이름, 설명, 종류, 레이블 이름
+type desc struct {
+	name       string
+	help       string
+	kind       string
+	labelNames []string
+}
+
+// writeHeader : HELP/TYPE 줄 출력
+func (d *desc) writeHeader(w *bufio.Writer) {
+	fmt.Fprintf(w, "# HELP %s %s\n", d.name, escapeHelp(d.help))
+	fmt.Fprintf(w, "# TYPE %s %s\n", d.name, d.kind)
+}
+
+// checkLabels : 레이블 값 개수 확인 (개수가 다르면 프로그래밍 오류)
+func (d *desc) checkLabels(labelValues []string) string {
+	if len(labelValues) != len(d.labelNames) {
+		panic(fmt.Sprintf("metrics: %s expects %d label values, got %d", d.name, len(d.labelNames), len(labelValues)))
+	}
+	return strings.Join(labelValues, labelSeparator)
+}
+
+// Registry : 메트릭 모음 (이름순으로 출력)
+type Registry struct {
+	mu         sync.RWMutex
+	collectors map[string]collector
+}
+
+// NewRegistry : 새로운 레지스트리 생성
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]collector)}
+}
+
+// register : 메트릭 등록 (같은 이름을 두 번 등록하면 프로그래밍 오류)
+func (r *Registry) register(name string, c collector) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, exists := r.collectors[name]; exists {
+		panic("metrics: duplicate metric " + name)
+	}
+	r.collectors[name] = c
+}
+
+// WriteText : 등록된 모든 메트릭을 Prometheus 텍스트 형식으로 출력
+func (r *Registry) WriteText(w io.Writer) error {
+	r.mu.RLock()
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+	collectors := make(map[string]collector, len(r.collectors))
+	for name, c := range r.collectors {
+		collectors[name] = c
+	}
+	r.mu.RUnlock()
+
+	sort.Strings(names)
+	bw := bufio.NewWriter(w)
+	for _, name := range names {
+		collectors[name].write(bw)
+	}
+	return bw.Flush()
+}
+
+// series : 레이블 값 조합별 측정값
+type series struct {
+	labelValues []string
+	value       float64
+}
+
+// valueVec : 카운터/게이지 공통 구현
+type valueVec struct {
+	desc
+	mu     sync.Mutex
+	values map[string]*series
+}
+
+// update : 레이블 값 조합의 값 변경
+func (v *valueVec) update(labelValues []string, fn func(float64) float64) {
+	key := v.checkLabels(labelValues)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	s, exists := v.values[key]
+	if !exists {
+		s = &series{labelValues: append([]string(nil), labelValues...)}
+		v.values[key] = s
+	}
+	s.value = fn(s.value)
+}
+
+// write : 레이블 값 순으로 출력
+func (v *valueVec) write(w *bufio.Writer) {
+	v.mu.Lock()
+	samples := make([]Sample, 0, len(v.values))
+	for _, s := range v.values {
+		samples = append(samples, Sample{LabelValues: s.labelValues, Value: s.value})
+	}
+	v.mu.Unlock()
+
+	v.writeHeader(w)
+	writeSamples(w, v.name, v.labelNames, samples)
+}
+
+// CounterVec : 레이블별 누적 카운터
+type CounterVec struct {
+	valueVec
+}
+
+// NewCounterVec : 카운터 등록
+func (r *Registry) NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	v := &CounterVec{valueVec{desc: desc{name, help, kindCounter, labelNames}, values: make(map[string]*series)}}
+	r.register(name, v)
+	return v
+}
+
+// Inc : 1 증가
+func (v *CounterVec) Inc(labelValues ...string) {
+	v.Add(1, labelValues...)
+}
+
+// Add : delta만큼 증가 (음수는 무시)
+func (v *CounterVec) Add(delta float64, labelValues ...string) {
+	if delta < 0 {
+		return
+	}
+	v.update(labelValues, func(current float64) float64 { return current + delta })
+}
+
+// GaugeVec : 레이블별 현재 값
+type GaugeVec struct {
+	valueVec
+}
+
+// NewGaugeVec : 게이지 등록
+func (r *Registry) NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	v := &GaugeVec{valueVec{desc: desc{name, help, kindGauge, labelNames}, values: make(map[string]*series)}}
+	r.register(name, v)
+	return v
+}
+
+// Set : 값 설정
+func (v *GaugeVec) Set(value float64, labelValues ...string) {
+	v.update(labelValues, func(float64) float64 { return value })
+}
+
+// Add : delta만큼 변경
+func (v *GaugeVec) Add(delta float64, labelValues ...string) {
+	v.update(labelValues, func(current float64) float64 { return current + delta })
+}
+
+// HistogramVec : 레이블별 분포 (누적 버킷, 합계, 개수)
+type HistogramVec struct {
+	desc
+	buckets []float64
+	mu      sync.Mutex
+	values  map[string]*histogramSeries
+}
+
+// histogramSeries : 레이블 값 조합별 버킷 카운트
+type histogramSeries struct {
+	labelValues []string
+	counts      []uint64 // 버킷별 카운트 (누적 아님)
+	sum         float64
+	count       uint64
+}
+
+// NewHistogramVec : 히스토그램 등록 (buckets는 상한값 오름차순, +Inf는 자동 추가)
+func (r *Registry) NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	sorted := append([]float64(nil), buckets...)
+	sort.Float64s(sorted)
+
+	v := &HistogramVec{
+		desc:    desc{name, help, kindHistogram, labelNames},
+		buckets: sorted,
+		values:  make(map[string]*histogramSeries),
+	}
+	r.register(name, v)
+	return v
+}
+
+// Observe : 값 기록
+func (v *HistogramVec) Observe(value float64, labelValues ...string) {
+	key := v.checkLabels(labelValues)
+
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	s, exists := v.values[key]
+	if !exists {
+		s = &histogramSeries{
+			labelValues: append([]string(nil), labelValues...),
+			counts:      make([]uint64, len(v.buckets)),
+		}
+		v.values[key] = s
+	}
+
+	if i := sort.SearchFloat64s(v.buckets, value); i < len(v.buckets) {
+		s.counts[i]++
+	}
+	s.sum += value
+	s.count++
+}
+
+// write : _bucket, _sum, _count 출력
+func (v *HistogramVec) write(w *bufio.Writer) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	keys := make([]string, 0, len(v.values))
+	for key := range v.values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	v.writeHeader(w)
+	for _, key := range keys {
+		s := v.values[key]
+
+		var cumulative uint64
+		for i, upper := range v.buckets {
+			cumulative += s.counts[i]
+			writeSample(w, v.name+"_bucket", v.labelNames, s.labelValues, "le", formatFloat(upper), float64(cumulative))
+		}
+		writeSample(w, v.name+"_bucket", v.labelNames, s.labelValues, "le", "+Inf", float64(s.count))
+		writeSample(w, v.name+"_sum", v.labelNames, s.labelValues, "", "", s.sum)
+		writeSample(w, v.name+"_count", v.labelNames, s.labelValues, "", "", float64(s.count))
+	}
+}
+
+// FuncVec : 수집 시점에 등록된 함수들로 값을 계산하는 메트릭
+// 작업 관리자, 워커 풀, 캐시 등 여러 인스턴스가 같은 메트릭에 각자 값을 제공
+type FuncVec struct {
+	desc
+	mu      sync.Mutex
+	sources map[string]func() []Sample
+}
+
+// NewGaugeFuncVec : 수집 함수 기반 게이지 등록
+func (r *Registry) NewGaugeFuncVec(name, help string, labelNames ...string) *FuncVec {
+	v := &FuncVec{desc: desc{name, help, kindGauge, labelNames}, sources: make(map[string]func() []Sample)}
+	r.register(name, v)
+	return v
+}
+
+// NewCounterFuncVec : 수집 함수 기반 카운터 등록 (이미 누적 중인 카운터 노출용)
+func (r *Registry) NewCounterFuncVec(name, help string, labelNames ...string) *FuncVec {
+	v := &FuncVec{desc: desc{name, help, kindCounter, labelNames}, sources: make(map[string]func() []Sample)}
+	r.register(name, v)
+	return v
+}
+
+// Register : 수집 함수 등록 (같은 source로 다시 등록하면 교체)
+func (v *FuncVec) Register(source string, collect func() []Sample) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	v.sources[source] = collect
+}
+
+// Unregister : 수집 함수 제거
+func (v *FuncVec) Unregister(source string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.sources, source)
+}
+
+// write : 모든 수집 함수의 값을 레이블 값 순으로 출력
+func (v *FuncVec) write(w *bufio.Writer) {
+	v.mu.Lock()
+	sources := make([]func() []Sample, 0, len(v.sources))
+	for _, collect := range v.sources {
+		sources = append(sources, collect)
+	}
+	v.mu.Unlock()
+
+	var samples []Sample
+	for _, collect := range sources {
+		for _, sample := range collect() {
+			v.checkLabels(sample.LabelValues)
+			samples = append(samples, sample)
+		}
+	}
+
+	v.writeHeader(w)
+	writeSamples(w, v.name, v.labelNames, samples)
+}
+
+// writeSamples : 레이블 값 순으로 정렬해 출력
+func writeSamples(w *bufio.Writer, name string, labelNames []string, samples []Sample) {
+	sort.Slice(samples, func(i, j int) bool {
+		return strings.Join(samples[i].LabelValues, labelSeparator) < strings.Join(samples[j].LabelValues, labelSeparator)
+	})
+	for _, sample := range samples {
+		writeSample(w, name, labelNames, sample.LabelValues, "", "", sample.Value)
+	}
+}
+
+// writeSample : 샘플 한 줄 출력 (extraName이 있으면 마지막 레이블로 추가, 예: le)
+func writeSample(w *bufio.Writer, name string, labelNames, labelValues []string, extraName, extraValue string, value float64) {
+	w.WriteString(name)
+	if len(labelNames) > 0 || extraName != "" {
+		w.WriteByte('{')
+		for i, labelName := range labelNames {
+			if i > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, `%s="%s"`, labelName, escapeLabelValue(labelValues[i]))
+		}
+		if extraName != "" {
+			if len(labelNames) > 0 {
+				w.WriteByte(',')
+			}
+			fmt.Fprintf(w, `%s="%s"`, extraName, extraValue)
+		}
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+// formatFloat : Prometheus 숫자 형식
+func formatFloat(value float64) string {
+	switch {
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	case math.IsNaN(value):
+		return "NaN"
+	default:
+		return strconv.FormatFloat(value, 'g', -1, 64)
+	}
+}
+
+// escapeHelp : HELP 문자열 이스케이프 (\, 줄바꿈)
+func escapeHelp(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`).Replace(s)
+}
+
+// escapeLabelValue : 레이블 값 이스케이프 (\, ", 줄바꿈)
+func escapeLabelValue(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(s)
+}
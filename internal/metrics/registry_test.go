@@ -0,0 +1,98 @@
+package metrics
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRegistry_WriteText(t *testing.T) {
+	r := NewRegistry()
+	requests := r.NewCounterVec("test_requests_total", "Total requests.", "method", "route")
+	inFlight := r.NewGaugeVec("test_in_flight", "Requests in flight.")
+	latency := r.NewHistogramVec("test_latency_seconds", "Latency.", []float64{1, 0.1}, "route")
+	items := r.NewGaugeFuncVec("test_items", "Items per cache.", "cache")
+
+	requests.Inc("GET", "/a")
+	requests.Add(2, "GET", "/a")
+	requests.Add(-1, "GET", "/a")
+	requests.Inc("POST", `/b"c`)
+	inFlight.Add(3)
+	inFlight.Add(-1)
+	latency.Observe(0.05, "/a")
+	latency.Observe(0.5, "/a")
+	latency.Observe(5, "/a")
+	items.Register("second", func() []Sample { return []Sample{{LabelValues: []string{"b"}, Value: 2}} })
+	items.Register("first", func() []Sample { return []Sample{{LabelValues: []string{"a"}, Value: 1}} })
+	items.Register("replaced", func() []Sample { return []Sample{{LabelValues: []string{"old"}, Value: 9}} })
+	items.Register("replaced", func() []Sample { return nil })
+
+	var buf bytes.Buffer
+	if err := r.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+
+	expected := `# HELP test_in_flight Requests in flight.
+# TYPE test_in_flight gauge
+test_in_flight 2
+# HELP test_items Items per cache.
+# TYPE test_items gauge
+test_items{cache="a"} 1
+test_items{cache="b"} 2
+# HELP test_latency_seconds Latency.
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{route="/a",le="0.1"} 1
+test_latency_seconds_bucket{route="/a",le="1"} 2
+test_latency_seconds_bucket{route="/a",le="+Inf"} 3
+test_latency_seconds_sum{route="/a"} 5.55
+test_latency_seconds_count{route="/a"} 3
+# HELP test_requests_total Total requests.
+# TYPE test_requests_total counter
+test_requests_total{method="GET",route="/a"} 3
+test_requests_total{method="POST",route="/b\"c"} 1
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected output:\n%s\nwant:\n%s", buf.String(), expected)
+	}
+}
+
+func TestRegistry_Misuse(t *testing.T) {
+	r := NewRegistry()
+	counter := r.NewCounterVec("test_total", "Test.", "label")
+
+	assertPanics(t, "duplicate metric", func() { r.NewGaugeVec("test_total", "Test.") })
+	assertPanics(t, "label count mismatch", func() { counter.Inc("a", "b") })
+}
+
+func TestDefaultMetrics(t *testing.T) {
+	var buf bytes.Buffer
+	if err := Default.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+
+	for _, name := range []string{
+		"kubemigrate_http_requests_total",
+		"kubemigrate_http_request_duration_seconds",
+		"kubemigrate_jobs",
+		"kubemigrate_job_duration_seconds",
+		"kubemigrate_cache_hits_total",
+		"kubemigrate_cache_evictions_total",
+		"kubemigrate_worker_pool_queue_depth",
+		"kubemigrate_velero_backups",
+		"kubemigrate_velero_restores",
+	} {
+		if !strings.Contains(buf.String(), "# TYPE "+name+" ") {
+			t.Errorf("Expected %s to be registered", name)
+		}
+	}
+}
+
+func assertPanics(t *testing.T, name string, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s: expected panic", name)
+		}
+	}()
+	fn()
+}
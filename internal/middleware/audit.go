@@ -0,0 +1,269 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/audit"
+	"github.com/taking/kubemigrate/internal/logger"
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// 감사 로그에 기록하는 요청/응답 본문 최대 크기
+const (
+	maxAuditRequestBody  = 64 * 1024
+	maxAuditResponseBody = 64 * 1024
+)
+
+// auditReadOnlySuffixes : POST이지만 상태를 변경하지 않는 경로 (연결 확인, 검증, 분석)
+var auditReadOnlySuffixes = []string{"/health", "/validate", "/analyze"}
+
+// NewAuditRecorder : 감사 로그 설정으로 기록기 생성 (비활성화 또는 초기화 실패 시 nil)
+func NewAuditRecorder(cfg config.AuditConfig) audit.Recorder {
+	recorder, err := audit.NewFromConfig(cfg)
+	if err != nil {
+		logger.Error("Failed to initialize audit log, mutating requests will not be audited",
+			logger.String("path", cfg.Path),
+			logger.String("error", err.Error()),
+		)
+		return nil
+	}
+	if recorder == nil {
+		logger.Warn("Audit log is disabled (AUDIT_ENABLED=false)")
+	}
+	return recorder
+}
+
+// Audit : 변경 요청(POST/PUT/PATCH/DELETE) 감사 로그 미들웨어
+// 주체, 클라이언트 IP, 라우트, 대상 클러스터 지문, 마스킹된 파라미터, 결과, 작업 ID를 기록
+func Audit(recorder audit.Recorder) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if recorder == nil || !isAuditedRequest(c) {
+				return next(c)
+			}
+
+			start := time.Now()
+			body, bodyNote := captureRequestBody(c.Request())
+
+			writer := &auditResponseWriter{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = writer
+
+			err := next(c)
+
+			c.Response().Writer = writer.ResponseWriter
+			entry := buildAuditEntry(c, start, body, bodyNote, writer, err)
+			if recordErr := recorder.Record(entry); recordErr != nil {
+				logger.Error("Failed to write audit entry",
+					logger.String("route", entry.Route),
+					logger.String("error", recordErr.Error()),
+				)
+			}
+
+			return err
+		}
+	}
+}
+
+// isAuditedRequest : 감사 대상 요청 여부
+func isAuditedRequest(c echo.Context) bool {
+	switch c.Request().Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete:
+	default:
+		return false
+	}
+
+	path := c.Request().URL.Path
+	if !strings.HasPrefix(path, "/api/v1/") {
+		return false
+	}
+	for _, suffix := range auditReadOnlySuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return false
+		}
+	}
+	return true
+}
+
+// captureRequestBody : JSON 요청 본문을 읽고 핸들러가 다시 읽을 수 있도록 복원
+// JSON이 아니거나 크기 제한을 넘는 본문(스트리밍 업로드 등)은 읽지 않고 사유만 반환
+func captureRequestBody(req *http.Request) (interface{}, string) {
+	if req.Body == nil || req.Body == http.NoBody || req.ContentLength == 0 {
+		return nil, ""
+	}
+
+	mediaType, _, _ := mime.ParseMediaType(req.Header.Get(echo.HeaderContentType))
+	if mediaType != echo.MIMEApplicationJSON {
+		if mediaType == "" {
+			mediaType = "unknown content type"
+		}
+		return nil, fmt.Sprintf("omitted (%s)", mediaType)
+	}
+	if req.ContentLength > maxAuditRequestBody {
+		return nil, fmt.Sprintf("omitted (larger than %d bytes)", maxAuditRequestBody)
+	}
+
+	original := req.Body
+	buf, err := io.ReadAll(io.LimitReader(original, maxAuditRequestBody+1))
+	req.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(buf), original), original}
+	if err != nil {
+		return nil, "omitted (read error)"
+	}
+	if len(buf) > maxAuditRequestBody {
+		return nil, fmt.Sprintf("omitted (larger than %d bytes)", maxAuditRequestBody)
+	}
+
+	var parsed interface{}
+	if err := json.Unmarshal(buf, &parsed); err != nil {
+		return nil, "omitted (invalid json)"
+	}
+	return parsed, ""
+}
+
+// buildAuditEntry : 요청/응답 정보로 감사 로그 항목 생성
+func buildAuditEntry(c echo.Context, start time.Time, body interface{}, bodyNote string,
+	writer *auditResponseWriter, handlerErr error) audit.Entry {
+
+	req := c.Request()
+	entry := audit.Entry{
+		ID:         audit.NewID(),
+		Timestamp:  start.UTC(),
+		RequestID:  firstNonEmpty(c.Response().Header().Get(echo.HeaderXRequestID), req.Header.Get(echo.HeaderXRequestID)),
+		ClientIP:   c.RealIP(),
+		Method:     req.Method,
+		Route:      c.Path(),
+		Path:       req.URL.Path,
+		Service:    auditService(req.URL.Path),
+		Targets:    audit.ClusterTargets(body, c.QueryParam("clusterId")),
+		DurationMs: time.Since(start).Milliseconds(),
+	}
+
+	if principal := GetPrincipal(c); principal != nil {
+		entry.Subject = principal.Subject
+		entry.Role = string(principal.Role)
+		entry.AuthMethod = principal.Method
+	}
+
+	// 파라미터 (비밀 값 마스킹)
+	params := map[string]interface{}{}
+	if names := c.ParamNames(); len(names) > 0 {
+		pathParams := map[string]interface{}{}
+		for i, name := range names {
+			if i < len(c.ParamValues()) {
+				pathParams[name] = c.ParamValues()[i]
+			}
+		}
+		params["path"] = pathParams
+	}
+	if query := req.URL.Query(); len(query) > 0 {
+		queryParams := map[string]interface{}{}
+		for key, values := range query {
+			if len(values) == 1 {
+				queryParams[key] = values[0]
+			} else {
+				queryParams[key] = values
+			}
+		}
+		params["query"] = audit.Redact(queryParams)
+	}
+	switch {
+	case body != nil:
+		params["body"] = audit.Redact(body)
+	case bodyNote != "":
+		params["body"] = bodyNote
+	}
+	if len(params) > 0 {
+		entry.Params = params
+	}
+
+	entry.StatusCode = responseStatus(c, handlerErr)
+
+	entry.Outcome = audit.OutcomeSuccess
+	if entry.StatusCode >= http.StatusBadRequest || handlerErr != nil {
+		entry.Outcome = audit.OutcomeFailure
+	}
+
+	// 응답 본문에서 작업 ID와 에러 메시지 추출
+	var payload struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+		Data    struct {
+			JobID       string `json:"jobId"`
+			MigrationID string `json:"migrationId"`
+		} `json:"data"`
+	}
+	if strings.HasPrefix(c.Response().Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON) {
+		_ = json.Unmarshal(writer.body.Bytes(), &payload)
+	}
+
+	entry.JobID = firstNonEmpty(c.Param("jobId"), c.Param("migrationId"), payload.Data.JobID, payload.Data.MigrationID)
+	if entry.Outcome == audit.OutcomeFailure {
+		switch {
+		case payload.Code != "":
+			entry.Error = payload.Code + ": " + payload.Message
+		case handlerErr != nil:
+			entry.Error = handlerErr.Error()
+		}
+	}
+
+	return entry
+}
+
+// auditService : 경로에서 서비스 이름 추출 (/api/v1/<service>/...)
+func auditService(path string) string {
+	rest := strings.TrimPrefix(path, "/api/v1/")
+	if i := strings.Index(rest, "/"); i >= 0 {
+		rest = rest[:i]
+	}
+	return rest
+}
+
+// firstNonEmpty : 비어 있지 않은 첫 번째 값 반환
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// auditResponseWriter : 응답 본문 앞부분을 함께 보관하는 ResponseWriter
+type auditResponseWriter struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+// Write : 응답을 전달하면서 최대 maxAuditResponseBody까지 보관
+func (w *auditResponseWriter) Write(b []byte) (int, error) {
+	if remaining := maxAuditResponseBody - w.body.Len(); remaining > 0 {
+		if len(b) > remaining {
+			w.body.Write(b[:remaining])
+		} else {
+			w.body.Write(b)
+		}
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+// Flush : 스트리밍 응답 지원
+func (w *auditResponseWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap : http.ResponseController가 원본 연결의 데드라인을 설정할 수 있도록 원본 반환
+func (w *auditResponseWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
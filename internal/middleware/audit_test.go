@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/audit"
+	"github.com/taking/kubemigrate/internal/auth"
+)
+
+// TestAuditMiddleware : 변경 요청 감사 로그 기록 테스트
+func TestAuditMiddleware(t *testing.T) {
+	recorder, err := audit.NewFileLog(filepath.Join(t.TempDir(), "audit.log"), 0, 1)
+	if err != nil {
+		t.Fatalf("NewFileLog() error = %v", err)
+	}
+	defer recorder.Close() //nolint:errcheck
+
+	authenticator, err := auth.NewTokenAuthenticator([]string{
+		"viewer:viewer:viewer-token",
+		"ci:operator:operator-token",
+	})
+	if err != nil {
+		t.Fatalf("NewTokenAuthenticator() error = %v", err)
+	}
+
+	e := echo.New()
+	e.Use(Audit(recorder))
+	e.Use(Authenticate(authenticator))
+	group := e.Group("/api/v1/velero")
+	group.Use(RequireRole(auth.RoleViewer))
+	group.GET("/backups", func(c echo.Context) error { return c.String(http.StatusOK, "OK") })
+	group.POST("/backups", func(c echo.Context) error {
+		var body map[string]interface{}
+		if err := c.Bind(&body); err != nil || body["kubeconfig"] == nil {
+			return c.JSON(http.StatusBadRequest, map[string]string{"code": "INVALID_REQUEST"})
+		}
+		return c.JSON(http.StatusAccepted, map[string]interface{}{
+			"status": "success",
+			"data":   map[string]string{"jobId": "backup-123"},
+		})
+	}, RequireRole(auth.RoleOperator))
+	group.POST("/health", func(c echo.Context) error { return c.String(http.StatusOK, "OK") })
+
+	send := func(method, path, token, body string) int {
+		req := httptest.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if token != "" {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec.Code
+	}
+
+	body := `{"kubeconfig":"apiVersion: v1\nkind: Config\nusers:\n- name: admin\n  user:\n    token: super-secret-token\n","minio":{"endpoint":"minio:9000","secretKey":"minioadmin123"}}`
+	if code := send(http.MethodPost, "/api/v1/velero/backups?namespace=velero", "operator-token", body); code != http.StatusAccepted {
+		t.Fatalf("Expected handler to still read the body, got status %d", code)
+	}
+	send(http.MethodPost, "/api/v1/velero/backups", "viewer-token", body)
+	send(http.MethodGet, "/api/v1/velero/backups", "viewer-token", "")
+	send(http.MethodPost, "/api/v1/velero/health", "viewer-token", body)
+
+	entries, err := recorder.Query(audit.Filter{})
+	if err != nil {
+		t.Fatalf("Query() error = %v", err)
+	}
+	if len(entries) != 2 {
+		t.Fatalf("Expected only 2 mutating requests to be audited, got %d", len(entries))
+	}
+
+	denied, created := entries[0], entries[1]
+	if denied.Outcome != audit.OutcomeFailure || denied.StatusCode != http.StatusForbidden || denied.Subject != "viewer" {
+		t.Errorf("Unexpected denied entry: %+v", denied)
+	}
+
+	if created.Outcome != audit.OutcomeSuccess || created.StatusCode != http.StatusAccepted {
+		t.Errorf("Unexpected created entry: %+v", created)
+	}
+	if created.Subject != "ci" || created.Role != string(auth.RoleOperator) || created.ClientIP == "" {
+		t.Errorf("Expected principal and client IP, got %+v", created)
+	}
+	if created.Route != "/api/v1/velero/backups" || created.Service != "velero" || created.JobID != "backup-123" {
+		t.Errorf("Expected route, service and job ID, got %+v", created)
+	}
+	if len(created.Targets) != 1 || len(created.Targets[0].Fingerprint) != 16 {
+		t.Errorf("Expected kubeconfig fingerprint target, got %+v", created.Targets)
+	}
+
+	recorded := strings.Join([]string{
+		created.Params["body"].(map[string]interface{})["kubeconfig"].(string),
+		created.Params["body"].(map[string]interface{})["minio"].(map[string]interface{})["secretKey"].(string),
+	}, " ")
+	if strings.Contains(recorded, "super-secret-token") || strings.Contains(recorded, "minioadmin123") {
+		t.Errorf("Secrets were not redacted: %s", recorded)
+	}
+	if created.Params["query"].(map[string]interface{})["namespace"] != "velero" {
+		t.Errorf("Expected query params to be recorded, got %+v", created.Params)
+	}
+}
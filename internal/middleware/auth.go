@@ -0,0 +1,105 @@
+package middleware
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/auth"
+	"github.com/taking/kubemigrate/internal/logger"
+	"github.com/taking/kubemigrate/internal/response"
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// principalContextKey : 인증된 주체를 보관하는 컨텍스트 키
+const principalContextKey = "auth.principal"
+
+// newAuthenticator : 인증 설정으로 인증기 생성 (설정 오류 시 모든 요청 거부)
+func newAuthenticator(cfg config.AuthConfig) auth.Authenticator {
+	authenticator, err := auth.NewAuthenticator(cfg)
+	if err != nil {
+		logger.Error("Invalid authentication configuration, rejecting all API requests",
+			logger.String("auth_mode", cfg.Mode),
+			logger.String("error", err.Error()),
+		)
+		return auth.DenyAll()
+	}
+
+	if !auth.Enabled(cfg) {
+		logger.Warn("API authentication is disabled (AUTH_MODE=none); all requests are treated as admin")
+	}
+	return authenticator
+}
+
+// Authenticate : 요청 인증 미들웨어 (공개 경로와 CORS preflight는 제외)
+// 인증된 주체는 컨텍스트에 저장되며 RequireRole에서 권한 확인에 사용
+func Authenticate(authenticator auth.Authenticator) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if isPublicRequest(c) {
+				return next(c)
+			}
+
+			principal, err := authenticator.Authenticate(c.Request())
+			if err != nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="kubemigrate"`)
+				message := "Authentication required"
+				if !errors.Is(err, auth.ErrNoCredentials) {
+					message = "Invalid credentials"
+				}
+				return response.RespondWithErrorModel(c, http.StatusUnauthorized, "UNAUTHORIZED", message, err.Error())
+			}
+
+			c.Set(principalContextKey, principal)
+			return next(c)
+		}
+	}
+}
+
+// RequireRole : 요구 역할 이상의 주체만 허용하는 미들웨어
+func RequireRole(role auth.Role) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			principal := GetPrincipal(c)
+			if principal == nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="kubemigrate"`)
+				return response.RespondWithErrorModel(c, http.StatusUnauthorized, "UNAUTHORIZED", "Authentication required", "")
+			}
+
+			if !principal.Role.Allows(role) {
+				return response.RespondWithErrorModel(c, http.StatusForbidden, "FORBIDDEN", "Insufficient role",
+					fmt.Sprintf("%s %s requires role %s (current: %s)", c.Request().Method, c.Path(), role, principal.Role))
+			}
+
+			return next(c)
+		}
+	}
+}
+
+// HasRole : 인증된 주체가 요구 역할 이상인지 여부 (주체가 없으면 false)
+func HasRole(c echo.Context, role auth.Role) bool {
+	principal := GetPrincipal(c)
+	return principal != nil && principal.Role.Allows(role)
+}
+
+// GetPrincipal : 컨텍스트에서 인증된 주체 조회
+func GetPrincipal(c echo.Context) *auth.Principal {
+	principal, _ := c.Get(principalContextKey).(*auth.Principal)
+	return principal
+}
+
+// isPublicRequest : 인증 없이 허용하는 요청 여부 (서버 정보, 헬스체크, API 문서, CORS preflight)
+func isPublicRequest(c echo.Context) bool {
+	if c.Request().Method == http.MethodOptions {
+		return true
+	}
+
+	path := c.Request().URL.Path
+	switch path {
+	case "/", "/api/v1/health", "/swagger.json":
+		return true
+	}
+	return path == "/docs" || strings.HasPrefix(path, "/docs/")
+}
@@ -0,0 +1,89 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/auth"
+)
+
+// TestAuthMiddleware : 인증 및 역할 기반 권한 미들웨어 테스트
+func TestAuthMiddleware(t *testing.T) {
+	authenticator, err := auth.NewTokenAuthenticator([]string{
+		"viewer:viewer:viewer-token",
+		"operator:operator:operator-token",
+		"admin:admin:admin-token",
+	})
+	if err != nil {
+		t.Fatalf("NewTokenAuthenticator() error = %v", err)
+	}
+
+	ok := func(c echo.Context) error { return c.String(http.StatusOK, "OK") }
+
+	e := echo.New()
+	e.Use(Authenticate(authenticator))
+	e.GET("/api/v1/health", ok)
+	group := e.Group("/api/v1/velero")
+	group.Use(RequireRole(auth.RoleViewer))
+	group.GET("/backups", ok)
+	group.POST("/backups", ok, RequireRole(auth.RoleOperator))
+	group.DELETE("/backups/:backupName", ok, RequireRole(auth.RoleAdmin))
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		token          string
+		expectedStatus int
+	}{
+		{"public health check", http.MethodGet, "/api/v1/health", "", http.StatusOK},
+		{"missing token", http.MethodGet, "/api/v1/velero/backups", "", http.StatusUnauthorized},
+		{"unknown token", http.MethodGet, "/api/v1/velero/backups", "nope", http.StatusUnauthorized},
+		{"viewer reads", http.MethodGet, "/api/v1/velero/backups", "viewer-token", http.StatusOK},
+		{"viewer creates", http.MethodPost, "/api/v1/velero/backups", "viewer-token", http.StatusForbidden},
+		{"operator creates", http.MethodPost, "/api/v1/velero/backups", "operator-token", http.StatusOK},
+		{"operator deletes", http.MethodDelete, "/api/v1/velero/backups/nightly", "operator-token", http.StatusForbidden},
+		{"admin deletes", http.MethodDelete, "/api/v1/velero/backups/nightly", "admin-token", http.StatusOK},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.token != "" {
+				req.Header.Set(echo.HeaderAuthorization, "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			e.ServeHTTP(rec, req)
+
+			if rec.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d (%s)", tt.expectedStatus, rec.Code, rec.Body.String())
+			}
+			if rec.Code == http.StatusUnauthorized && rec.Header().Get(echo.HeaderWWWAuthenticate) == "" {
+				t.Error("expected WWW-Authenticate header on 401 response")
+			}
+		})
+	}
+}
+
+// TestAuthMiddlewareDenyAll : 설정 오류 시 공개 경로 외 모든 요청 거부 테스트
+func TestAuthMiddlewareDenyAll(t *testing.T) {
+	e := echo.New()
+	e.Use(Authenticate(auth.DenyAll()))
+	e.GET("/api/v1/health", func(c echo.Context) error { return c.String(http.StatusOK, "OK") })
+	e.GET("/api/v1/minio/buckets", func(c echo.Context) error { return c.String(http.StatusOK, "OK") })
+
+	for path, expected := range map[string]int{
+		"/api/v1/health":        http.StatusOK,
+		"/api/v1/minio/buckets": http.StatusUnauthorized,
+	} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer anything")
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		if rec.Code != expected {
+			t.Errorf("%s: expected status %d, got %d", path, expected, rec.Code)
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package middleware
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/metrics"
+)
+
+// unmatchedRoute : 라우트에 매칭되지 않은 요청의 route 레이블 (경로별 레이블 폭증 방지)
+const unmatchedRoute = "unmatched"
+
+// Metrics : 라우트별 HTTP 요청 수, 상태 코드, 지연 시간 메트릭 미들웨어
+func Metrics() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			metrics.HTTPRequestsInFlight.Add(1)
+			defer metrics.HTTPRequestsInFlight.Add(-1)
+
+			err := next(c)
+
+			route := c.Path()
+			if route == "" || route == "/*" {
+				route = unmatchedRoute
+			}
+			method := c.Request().Method
+			status := strconv.Itoa(responseStatus(c, err))
+
+			metrics.HTTPRequests.Inc(method, route, status)
+			metrics.HTTPRequestDuration.Observe(time.Since(start).Seconds(), method, route)
+
+			return err
+		}
+	}
+}
+
+// responseStatus : 응답 상태 코드 (핸들러가 응답하지 않고 에러를 반환한 경우 에러 코드 사용)
+func responseStatus(c echo.Context, err error) int {
+	if c.Response().Committed {
+		return c.Response().Status
+	}
+	if err == nil {
+		return http.StatusOK
+	}
+
+	var httpErr *echo.HTTPError
+	if errors.As(err, &httpErr) {
+		return httpErr.Code
+	}
+	return http.StatusInternalServerError
+}
@@ -0,0 +1,52 @@
+package middleware
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/metrics"
+)
+
+// TestMetricsMiddleware : 라우트별 요청 수/상태 코드 메트릭 테스트
+func TestMetricsMiddleware(t *testing.T) {
+	e := echo.New()
+	e.Use(Metrics())
+	e.GET("/api/v1/metrics-test/backups/:backupName", func(c echo.Context) error {
+		return c.String(http.StatusOK, "OK")
+	})
+	e.POST("/api/v1/metrics-test/backups", func(c echo.Context) error {
+		return echo.NewHTTPError(http.StatusConflict, "already exists")
+	})
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/api/v1/metrics-test/backups/a", nil),
+		httptest.NewRequest(http.MethodGet, "/api/v1/metrics-test/backups/b", nil),
+		httptest.NewRequest(http.MethodPost, "/api/v1/metrics-test/backups", nil),
+		httptest.NewRequest(http.MethodGet, "/api/v1/metrics-test/unknown-path-1", nil),
+	} {
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	var buf bytes.Buffer
+	if err := metrics.Default.WriteText(&buf); err != nil {
+		t.Fatalf("WriteText() error = %v", err)
+	}
+	output := buf.String()
+
+	for _, line := range []string{
+		`kubemigrate_http_requests_total{method="GET",route="/api/v1/metrics-test/backups/:backupName",status="200"} 2`,
+		`kubemigrate_http_requests_total{method="POST",route="/api/v1/metrics-test/backups",status="409"} 1`,
+		`kubemigrate_http_request_duration_seconds_count{method="GET",route="/api/v1/metrics-test/backups/:backupName"} 2`,
+	} {
+		if !strings.Contains(output, line+"\n") {
+			t.Errorf("Expected metrics output to contain %q", line)
+		}
+	}
+	if strings.Contains(output, "unknown-path-1") {
+		t.Error("Unmatched request paths should not be used as route labels")
+	}
+}
@@ -3,16 +3,18 @@ package middleware
 
 import (
 	"runtime"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
+	"github.com/taking/kubemigrate/internal/audit"
 	"github.com/taking/kubemigrate/pkg/config"
 	"github.com/taking/kubemigrate/pkg/constants"
 )
 
 // SetupMiddleware : Echo 서버에 공통 미들웨어 설정
-// 로깅, 복구, CORS, 압축, 타임아웃, 레이트 제한 등을 구성
-func SetupMiddleware(e *echo.Echo, cfg *config.Config) {
+// 로깅, 메트릭, 복구, CORS, 압축, 타임아웃, 레이트 제한, 감사 로그, 인증 등을 구성
+func SetupMiddleware(e *echo.Echo, cfg *config.Config, auditLog audit.Recorder) {
 	// 기본 미들웨어 설정
 	e.Pre(middleware.RemoveTrailingSlash()) // 모든 요청에서 URL 뒤에 붙은 / 제거
 
@@ -24,6 +26,10 @@ func SetupMiddleware(e *echo.Echo, cfg *config.Config) {
 		Format: `{"time":"${time_rfc3339}","level":"info","message":"${method} ${uri}","status":${status},"latency":"${latency_human}","request_id":"${id}"}` + "\n",
 	}))
 
+	// 메트릭 미들웨어: 라우트별 요청 수, 상태 코드, 지연 시간 집계
+	// Recover 앞에 두어 panic으로 인한 500 응답도 집계
+	e.Use(Metrics())
+
 	// Recover 미들웨어: Panic 발생 시 서버 종료 방지 및 500 응답 반환
 	e.Use(middleware.Recover())
 
@@ -40,13 +46,18 @@ func SetupMiddleware(e *echo.Echo, cfg *config.Config) {
 	}))
 
 	// Gzip 압축 미들웨어: 응답 데이터 압축
-	e.Use(middleware.Gzip())
+	// SSE 스트리밍과 객체 업로드/다운로드는 즉시 전송되어야 하므로 제외
+	e.Use(middleware.GzipWithConfig(middleware.GzipConfig{
+		Skipper: isStreamingRequest,
+	}))
 
 	// GOMAXPROCS 설정: CPU 코어 수만큼 최대 프로세스 사용
 	runtime.GOMAXPROCS(runtime.NumCPU())
 
 	// 요청 타임아웃 미들웨어: cfg.Timeouts.Request 기준
+	// 응답을 버퍼링하므로 장시간 연결인 SSE 스트리밍과 객체 업로드/다운로드는 제외
 	e.Use(middleware.TimeoutWithConfig(middleware.TimeoutConfig{
+		Skipper: isStreamingRequest,
 		Timeout: cfg.Timeouts.Request,
 	}))
 
@@ -74,4 +85,19 @@ func SetupMiddleware(e *echo.Echo, cfg *config.Config) {
 			return context.JSON(429, map[string]string{"error": "rate limit exceeded"})
 		},
 	}))
+
+	// 감사 로그 미들웨어: 변경 요청의 주체, 대상, 결과 기록
+	// 인증 앞에 두어 인증/권한 실패한 변경 시도도 기록
+	e.Use(Audit(auditLog))
+
+	// 인증 미들웨어: 정적 토큰/JWT 검증 후 주체를 컨텍스트에 저장 (역할 검사는 라우트 그룹별 RequireRole)
+	// 레이트 제한 이후에 두어 토큰 대입 시도도 제한
+	e.Use(Authenticate(newAuthenticator(cfg.Auth)))
+}
+
+// isStreamingRequest : Server-Sent Events 또는 객체 스트리밍 업로드/다운로드 요청 여부 확인
+func isStreamingRequest(c echo.Context) bool {
+	return strings.HasSuffix(c.Path(), "/stream") ||
+		strings.HasSuffix(c.Path(), "/objects/*") ||
+		strings.Contains(c.Request().Header.Get(echo.HeaderAccept), "text/event-stream")
 }
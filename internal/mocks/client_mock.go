@@ -2,7 +2,9 @@ package mocks
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"strings"
 	"time"
 
 	miniosdk "github.com/minio/minio-go/v7"
@@ -16,7 +18,11 @@ import (
 	"helm.sh/helm/v3/pkg/release"
 	v1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
 )
 
 // MockClient : 테스트용 Mock 클라이언트
@@ -147,11 +153,15 @@ func (m *MockKubernetesClient) CreateSecret(ctx context.Context, namespace, name
 }
 
 func (m *MockKubernetesClient) GetStorageClasses(ctx context.Context, name string) (interface{}, error) {
-	return map[string]interface{}{
-		"items": []map[string]interface{}{
-			{"metadata": map[string]interface{}{"name": "test-storageclass"}},
-		},
-	}, nil
+	storageClass := storagev1.StorageClass{
+		ObjectMeta:  metav1.ObjectMeta{Name: "local-path"},
+		Provisioner: "rancher.io/local-path",
+	}
+	if name != "" {
+		storageClass.Name = name
+		return &storageClass, nil
+	}
+	return &storagev1.StorageClassList{Items: []storagev1.StorageClass{storageClass}}, nil
 }
 
 func (m *MockKubernetesClient) GetServices(ctx context.Context, namespace, labelSelector string) (interface{}, error) {
@@ -226,10 +236,42 @@ func (m *MockKubernetesClient) GetPersistentVolumeClaims(ctx context.Context, na
 	}, nil
 }
 
-func (m *MockKubernetesClient) GetNodes(ctx context.Context, labelSelector string) (interface{}, error) {
-	return map[string]interface{}{
-		"items": []map[string]interface{}{
-			{"metadata": map[string]interface{}{"name": "test-node"}},
+func (m *MockKubernetesClient) GetNodes(ctx context.Context, name string) (interface{}, error) {
+	node := v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-node"},
+		Status: v1.NodeStatus{
+			Allocatable: v1.ResourceList{
+				v1.ResourceCPU:    resource.MustParse("4"),
+				v1.ResourceMemory: resource.MustParse("8Gi"),
+			},
+			Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}},
+		},
+	}
+	if name != "" {
+		node.Name = name
+		return &node, nil
+	}
+	return &v1.NodeList{Items: []v1.Node{node}}, nil
+}
+
+func (m *MockKubernetesClient) GetCRDs(ctx context.Context, name string) (interface{}, error) {
+	if name != "" {
+		return &apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	}
+	return &apiextensionsv1.CustomResourceDefinitionList{}, nil
+}
+
+func (m *MockKubernetesClient) GetServerVersion(ctx context.Context) (*version.Info, error) {
+	return &version.Info{Major: "1", Minor: "30", GitVersion: "v1.30.0"}, nil
+}
+
+func (m *MockKubernetesClient) GetAPIResources(ctx context.Context, preferred bool) ([]*metav1.APIResourceList, error) {
+	return []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Namespaced: true, Kind: "Pod", Verbs: metav1.Verbs{"get", "list"}},
+			},
 		},
 	}, nil
 }
@@ -306,6 +348,42 @@ func (m *MockKubernetesClient) GetCSIDrivers(ctx context.Context) (interface{},
 	}, nil
 }
 
+func (m *MockKubernetesClient) GetResources(ctx context.Context, kind, namespace, name string, query kubernetes.ResourceQuery) (interface{}, error) {
+	if kind == "unsupported" {
+		return nil, fmt.Errorf("%w: %s", kubernetes.ErrUnsupportedResourceKind, kind)
+	}
+	if kind == "secrets" {
+		return &v1.Secret{
+			TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "test-secret",
+				Namespace:   namespace,
+				Annotations: map[string]string{"kubectl.kubernetes.io/last-applied-configuration": `{"data":{"password":"c2VjcmV0"}}`},
+			},
+			Data: map[string][]byte{"password": []byte("secret")},
+		}, nil
+	}
+
+	item := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "test-pod", "namespace": namespace},
+	}}
+	if name != "" {
+		item.SetName(name)
+		return &item, nil
+	}
+
+	list := &unstructured.UnstructuredList{
+		Object: map[string]interface{}{"apiVersion": "v1", "kind": "PodList"},
+		Items:  []unstructured.Unstructured{item},
+	}
+	if query.Limit > 0 && query.Continue == "" {
+		list.SetContinue("mock-continue-token")
+	}
+	return list, nil
+}
+
 func (m *MockKubernetesClient) HealthCheck(ctx context.Context) error {
 	return nil
 }
@@ -363,6 +441,10 @@ func (m *MockHelmClient) UpgradeChart(releaseName, chartURL, version, namespace
 	return nil
 }
 
+func (m *MockHelmClient) RollbackChart(ctx context.Context, releaseName, namespace string, revision int) error {
+	return nil
+}
+
 func (m *MockHelmClient) HealthCheck(ctx context.Context) error {
 	return nil
 }
@@ -396,6 +478,40 @@ func (m *MockMinioClient) BucketExists(ctx context.Context, bucketName string) (
 	return true, nil
 }
 
+func (m *MockMinioClient) GetBucketVersioning(ctx context.Context, bucketName string) (string, error) {
+	return "Enabled", nil
+}
+
+func (m *MockMinioClient) SetBucketVersioning(ctx context.Context, bucketName string, enabled bool) error {
+	return nil
+}
+
+func (m *MockMinioClient) GetBucketRetention(ctx context.Context, bucketName string) (*minio.BucketRetention, error) {
+	return &minio.BucketRetention{ObjectLockEnabled: true, Mode: "GOVERNANCE", Validity: 30, Unit: "DAYS"}, nil
+}
+
+func (m *MockMinioClient) SetBucketRetention(ctx context.Context, bucketName, mode string, validity uint, unit string) error {
+	return nil
+}
+
+func (m *MockMinioClient) GetBucketLifecycle(ctx context.Context, bucketName string) ([]minio.LifecycleRule, error) {
+	return []minio.LifecycleRule{
+		{ID: "expire-backups", Prefix: "backups/", Enabled: true, ExpirationDays: 30},
+	}, nil
+}
+
+func (m *MockMinioClient) SetBucketLifecycle(ctx context.Context, bucketName string, rules []minio.LifecycleRule) error {
+	return nil
+}
+
+func (m *MockMinioClient) GetBucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	return "", nil
+}
+
+func (m *MockMinioClient) SetBucketPolicy(ctx context.Context, bucketName, policy string) error {
+	return nil
+}
+
 func (m *MockMinioClient) ListObjects(ctx context.Context, bucketName string) (interface{}, error) {
 	return []miniosdk.ObjectInfo{
 		{Key: "test-object", Size: 1024},
@@ -418,6 +534,34 @@ func (m *MockMinioClient) PutObject(ctx context.Context, bucketName, objectName
 	}, nil
 }
 
+// mockObjectData : Mock 객체 스트리밍 다운로드 내용
+const mockObjectData = "test data"
+
+func (m *MockMinioClient) GetObjectStream(ctx context.Context, bucketName, objectName string, opts minio.GetObjectOptions) (io.ReadCloser, error) {
+	data := mockObjectData
+	if opts.Range != nil {
+		data = data[opts.Range.Start : opts.Range.End+1]
+	}
+	return io.NopCloser(strings.NewReader(data)), nil
+}
+
+func (m *MockMinioClient) PutObjectStream(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts minio.PutObjectOptions) (*minio.ObjectInfo, error) {
+	size, err := io.Copy(io.Discard, reader)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Progress != nil {
+		opts.Progress(size)
+	}
+	return &minio.ObjectInfo{
+		Bucket:      bucketName,
+		Key:         objectName,
+		Size:        size,
+		ETag:        "mock-etag",
+		ContentType: opts.ContentType,
+	}, nil
+}
+
 func (m *MockMinioClient) DeleteObject(ctx context.Context, bucketName, objectName string) error {
 	return nil
 }
@@ -429,6 +573,17 @@ func (m *MockMinioClient) StatObject(ctx context.Context, bucketName, objectName
 	}, nil
 }
 
+func (m *MockMinioClient) HeadObject(ctx context.Context, bucketName, objectName string) (*minio.ObjectInfo, error) {
+	return &minio.ObjectInfo{
+		Bucket:       bucketName,
+		Key:          objectName,
+		Size:         int64(len(mockObjectData)),
+		ETag:         "mock-etag",
+		ContentType:  "text/plain",
+		LastModified: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
 func (m *MockMinioClient) CopyObject(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string) (interface{}, error) {
 	return map[string]interface{}{
 		"srcBucket": srcBucket,
@@ -460,6 +615,12 @@ func (m *MockMinioClient) ListObjectsInFolder(ctx context.Context, bucketName, f
 	}, nil
 }
 
+func (m *MockMinioClient) ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) ([]minio.ObjectInfo, error) {
+	return []minio.ObjectInfo{
+		{Bucket: bucketName, Key: prefix + "test-object", Size: int64(len(mockObjectData)), ETag: "mock-etag"},
+	}, nil
+}
+
 // MockVeleroClient : Mock Velero 클라이언트
 type MockVeleroClient struct{}
 
@@ -503,6 +664,35 @@ func (m *MockVeleroClient) DeleteRestore(ctx context.Context, namespace, name st
 	return nil
 }
 
+func (m *MockVeleroClient) GetSchedules(ctx context.Context, namespace string) ([]velerov1.Schedule, error) {
+	return []velerov1.Schedule{
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-schedule", Namespace: namespace}},
+	}, nil
+}
+
+func (m *MockVeleroClient) GetSchedule(ctx context.Context, namespace, name string) (*velerov1.Schedule, error) {
+	return &velerov1.Schedule{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       velerov1.ScheduleSpec{Schedule: "0 2 * * *"},
+	}, nil
+}
+
+func (m *MockVeleroClient) CreateSchedule(ctx context.Context, namespace string, schedule *velerov1.Schedule) error {
+	return nil
+}
+
+func (m *MockVeleroClient) UpdateSchedule(ctx context.Context, namespace string, schedule *velerov1.Schedule) error {
+	return nil
+}
+
+func (m *MockVeleroClient) PauseSchedule(ctx context.Context, namespace, name string, paused bool) error {
+	return nil
+}
+
+func (m *MockVeleroClient) DeleteSchedule(ctx context.Context, namespace, name string) error {
+	return nil
+}
+
 func (m *MockVeleroClient) GetBackupRepositories(ctx context.Context, namespace string) ([]velerov1.BackupRepository, error) {
 	return []velerov1.BackupRepository{
 		{ObjectMeta: metav1.ObjectMeta{Name: "test-repo", Namespace: namespace}},
@@ -531,6 +721,10 @@ func (m *MockVeleroClient) CreateBackupStorageLocation(ctx context.Context, name
 	return nil
 }
 
+func (m *MockVeleroClient) UpdateBackupStorageLocation(ctx context.Context, namespace string, bsl *velerov1.BackupStorageLocation) error {
+	return nil
+}
+
 func (m *MockVeleroClient) DeleteBackupStorageLocation(ctx context.Context, namespace, name string) error {
 	return nil
 }
@@ -547,6 +741,18 @@ func (m *MockVeleroClient) GetVolumeSnapshotLocation(ctx context.Context, namesp
 	}, nil
 }
 
+func (m *MockVeleroClient) CreateVolumeSnapshotLocation(ctx context.Context, namespace string, vsl *velerov1.VolumeSnapshotLocation) error {
+	return nil
+}
+
+func (m *MockVeleroClient) UpdateVolumeSnapshotLocation(ctx context.Context, namespace string, vsl *velerov1.VolumeSnapshotLocation) error {
+	return nil
+}
+
+func (m *MockVeleroClient) DeleteVolumeSnapshotLocation(ctx context.Context, namespace, name string) error {
+	return nil
+}
+
 func (m *MockVeleroClient) GetPodVolumeRestores(ctx context.Context, namespace string) ([]velerov1.PodVolumeRestore, error) {
 	return []velerov1.PodVolumeRestore{
 		{ObjectMeta: metav1.ObjectMeta{Name: "test-pvr", Namespace: namespace}},
@@ -559,6 +765,24 @@ func (m *MockVeleroClient) GetPodVolumeRestore(ctx context.Context, namespace, n
 	}, nil
 }
 
+func (m *MockVeleroClient) CreateDownloadRequest(ctx context.Context, namespace string, request *velerov1.DownloadRequest) error {
+	return nil
+}
+
+func (m *MockVeleroClient) GetDownloadRequest(ctx context.Context, namespace, name string) (*velerov1.DownloadRequest, error) {
+	return &velerov1.DownloadRequest{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Status: velerov1.DownloadRequestStatus{
+			Phase:       velerov1.DownloadRequestPhaseProcessed,
+			DownloadURL: "http://localhost:9000/velero/mock-download",
+		},
+	}, nil
+}
+
+func (m *MockVeleroClient) DeleteDownloadRequest(ctx context.Context, namespace, name string) error {
+	return nil
+}
+
 func (m *MockVeleroClient) HealthCheck(ctx context.Context) error {
 	return nil
 }
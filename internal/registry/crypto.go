@@ -0,0 +1,152 @@
+package registry
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// envelopeVersion : 암호화 문서 형식 버전
+const envelopeVersion = 1
+
+// envelope : 저장소에 기록되는 암호화 문서 (AES-256-GCM)
+type envelope struct {
+	Version int    `json:"version"`
+	Nonce   []byte `json:"nonce"`
+	Data    []byte `json:"data"`
+}
+
+// ParseKey : 암호화 키 문자열을 32바이트 키로 변환
+// 32바이트로 디코딩되는 base64 값은 그대로 사용하고, 그 외에는 패스프레이즈로 간주해 SHA-256으로 유도
+func ParseKey(value string) ([]byte, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, fmt.Errorf("encryption key is empty")
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(value); err == nil && len(decoded) == 32 {
+		return decoded, nil
+	}
+
+	sum := sha256.Sum256([]byte(value))
+	return sum[:], nil
+}
+
+// LoadOrCreateKeyFile : 키 파일에서 암호화 키를 읽고, 없으면 새 키를 생성해 0600 권한으로 저장
+func LoadOrCreateKeyFile(path string) ([]byte, bool, error) {
+	data, err := os.ReadFile(path)
+	if err == nil {
+		key, err := ParseKey(string(data))
+		if err != nil {
+			return nil, false, fmt.Errorf("invalid registry key file %s: %w", path, err)
+		}
+		return key, false, nil
+	}
+	if !os.IsNotExist(err) {
+		return nil, false, fmt.Errorf("failed to read registry key file %s: %w", path, err)
+	}
+
+	key, err := NewRandomKey()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, false, fmt.Errorf("failed to create registry key directory: %w", err)
+	}
+	encoded := base64.StdEncoding.EncodeToString(key)
+	if err := os.WriteFile(path, []byte(encoded+"\n"), 0o600); err != nil {
+		return nil, false, fmt.Errorf("failed to write registry key file %s: %w", path, err)
+	}
+
+	return key, true, nil
+}
+
+// NewRandomKey : 임의의 32바이트 암호화 키 생성
+func NewRandomKey() ([]byte, error) {
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, fmt.Errorf("failed to generate registry key: %w", err)
+	}
+	return key, nil
+}
+
+// newAEAD : 32바이트 키로 AES-256-GCM 생성
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(key))
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal : 평문 문서를 암호화하여 envelope JSON으로 변환
+func seal(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, fmt.Errorf("failed to generate nonce: %w", err)
+	}
+
+	return json.Marshal(envelope{
+		Version: envelopeVersion,
+		Nonce:   nonce,
+		Data:    aead.Seal(nil, nonce, plaintext, nil),
+	})
+}
+
+// open : envelope JSON을 복호화하여 평문 문서 반환
+func open(aead cipher.AEAD, data []byte) ([]byte, error) {
+	var env envelope
+	if err := json.Unmarshal(data, &env); err != nil {
+		return nil, fmt.Errorf("invalid registry document: %w", err)
+	}
+	if env.Version != envelopeVersion {
+		return nil, fmt.Errorf("unsupported registry document version: %d", env.Version)
+	}
+	if len(env.Nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid registry document nonce")
+	}
+
+	plaintext, err := aead.Open(nil, env.Nonce, env.Data, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt registry document (wrong encryption key?): %w", err)
+	}
+	return plaintext, nil
+}
+
+// Cipher : 레지스트리와 같은 형식(AES-256-GCM envelope)으로 문서를 암호화/복호화
+// 작업 저장소의 복구 정보처럼 레지스트리 밖에 기록되는 자격 증명 보호에 사용
+type Cipher struct {
+	aead cipher.AEAD
+}
+
+// NewCipher : 32바이트 키로 Cipher 생성
+func NewCipher(key []byte) (*Cipher, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	return &Cipher{aead: aead}, nil
+}
+
+// Seal : 평문 암호화
+func (c *Cipher) Seal(plaintext []byte) ([]byte, error) {
+	return seal(c.aead, plaintext)
+}
+
+// Open : 암호문 복호화
+func (c *Cipher) Open(data []byte) ([]byte, error) {
+	return open(c.aead, data)
+}
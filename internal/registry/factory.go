@@ -0,0 +1,91 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// 레지스트리 저장소 타입
+const (
+	StoreTypeMemory = "memory"
+	StoreTypeFile   = "file"
+	StoreTypeSecret = "secret"
+)
+
+// Options : 레지스트리 생성 옵션
+type Options struct {
+	StoreType       string // "memory", "file" 또는 "secret"
+	StorePath       string // 파일 저장소 경로
+	SecretNamespace string // Secret 저장소 네임스페이스
+	SecretName      string // Secret 저장소 이름
+	EncryptionKey   string // 암호화 키 (base64 32바이트 또는 패스프레이즈)
+	KeyFile         string // 암호화 키가 없을 때 생성/사용할 키 파일 경로 (파일 저장소 디렉토리 밖)
+}
+
+// NewFromOptions : 옵션에 따라 레지스트리 생성
+// 반환값의 bool은 키 파일이 새로 생성되었는지 여부
+// 암호화 파일과 키가 함께 유출되지 않도록 파일 저장소 디렉토리 안의 키 파일은 허용하지 않음
+func NewFromOptions(ctx context.Context, opts Options) (*Registry, bool, error) {
+	var backend Backend
+	var err error
+
+	switch opts.StoreType {
+	case StoreTypeMemory:
+		backend = NewMemoryBackend()
+	case "", StoreTypeFile:
+		backend, err = NewFileBackend(opts.StorePath)
+	case StoreTypeSecret:
+		backend, err = NewSecretBackendFromEnvironment(opts.SecretNamespace, opts.SecretName)
+	default:
+		return nil, false, fmt.Errorf("unsupported registry store type: %s (valid: memory, file, secret)", opts.StoreType)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	var key []byte
+	generated := false
+	switch {
+	case opts.EncryptionKey != "":
+		key, err = ParseKey(opts.EncryptionKey)
+	case opts.StoreType == StoreTypeMemory:
+		// 메모리 저장소는 프로세스 종료 시 사라지므로 임시 키 사용
+		key, err = NewRandomKey()
+	case opts.KeyFile != "":
+		if (opts.StoreType == "" || opts.StoreType == StoreTypeFile) && isWithinDir(opts.KeyFile, filepath.Dir(opts.StorePath)) {
+			return nil, false, fmt.Errorf("%w: %s (store: %s)", ErrKeyInStoreDir, opts.KeyFile, opts.StorePath)
+		}
+		key, generated, err = LoadOrCreateKeyFile(opts.KeyFile)
+	default:
+		return nil, false, ErrKeyRequired
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	registry, err := New(ctx, backend, key)
+	if err != nil {
+		return nil, false, err
+	}
+	return registry, generated, nil
+}
+
+// isWithinDir : 경로가 디렉토리 또는 그 하위에 있는지 여부
+func isWithinDir(path, dir string) bool {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return false
+	}
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+
+	rel, err := filepath.Rel(absDir, filepath.Dir(absPath))
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
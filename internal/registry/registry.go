@@ -0,0 +1,400 @@
+// Package registry 이름으로 등록된 클러스터(kubeconfig)와 스토리지(MinIO) 설정을 암호화하여 관리합니다.
+package registry
+
+import (
+	"context"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// 레지스트리 에러
+var (
+	ErrNotFound      = errors.New("registry entry not found")
+	ErrAlreadyExists = errors.New("registry entry already exists")
+	ErrInvalidID     = errors.New("invalid registry id: must be lowercase alphanumeric or '-', up to 63 characters")
+	ErrKeyRequired   = errors.New("registry encryption key is required: set REGISTRY_ENCRYPTION_KEY or REGISTRY_KEY_FILE outside the store directory")
+	ErrKeyInStoreDir = errors.New("registry key file must not be in the store directory")
+)
+
+// maskedSecret : 응답에서 비밀 값을 대신하는 문자열
+const maskedSecret = "********"
+
+// idPattern : 등록 ID 패턴 (DNS label)
+var idPattern = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]{0,61}[a-z0-9])?$`)
+
+// Cluster : 등록된 클러스터
+type Cluster struct {
+	ID          string    `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	KubeConfig  string    `json:"kubeconfig,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+// Redacted : kubeconfig를 제외한 복사본 (API 응답용)
+func (c Cluster) Redacted() Cluster {
+	c.KubeConfig = ""
+	return c
+}
+
+// Storage : 등록된 스토리지 (MinIO)
+type Storage struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Minio       config.MinioConfig `json:"minio"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+// Redacted : secretKey를 마스킹한 복사본 (API 응답용)
+func (s Storage) Redacted() Storage {
+	if s.Minio.SecretKey != "" {
+		s.Minio.SecretKey = maskedSecret
+	}
+	return s
+}
+
+// document : 암호화 전 레지스트리 문서
+type document struct {
+	Clusters []Cluster `json:"clusters"`
+	Storages []Storage `json:"storages"`
+}
+
+// Registry : 클러스터/스토리지 레지스트리
+// 변경 시 전체 문서를 AES-256-GCM으로 암호화하여 저장소에 기록
+type Registry struct {
+	mu       sync.RWMutex
+	backend  Backend
+	aead     cipher.AEAD
+	clusters map[string]Cluster
+	storages map[string]Storage
+}
+
+// New : 저장소와 32바이트 암호화 키로 레지스트리 생성 (저장된 문서가 있으면 복호화하여 로드)
+func New(ctx context.Context, backend Backend, key []byte) (*Registry, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &Registry{
+		backend:  backend,
+		aead:     aead,
+		clusters: make(map[string]Cluster),
+		storages: make(map[string]Storage),
+	}
+
+	data, err := backend.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) == 0 {
+		return r, nil
+	}
+
+	plaintext, err := open(aead, data)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc document
+	if err := json.Unmarshal(plaintext, &doc); err != nil {
+		return nil, fmt.Errorf("invalid registry document: %w", err)
+	}
+	for _, cluster := range doc.Clusters {
+		r.clusters[cluster.ID] = cluster
+	}
+	for _, storage := range doc.Storages {
+		r.storages[storage.ID] = storage
+	}
+
+	return r, nil
+}
+
+// ===== 클러스터 관련 =====
+
+// ListClusters : 등록된 클러스터 목록 (ID 순, kubeconfig 제외)
+func (r *Registry) ListClusters() []Cluster {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	clusters := make([]Cluster, 0, len(r.clusters))
+	for _, cluster := range r.clusters {
+		clusters = append(clusters, cluster.Redacted())
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].ID < clusters[j].ID })
+	return clusters
+}
+
+// GetCluster : 클러스터 조회 (kubeconfig 포함)
+func (r *Registry) GetCluster(id string) (Cluster, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	cluster, ok := r.clusters[id]
+	if !ok {
+		return Cluster{}, fmt.Errorf("cluster %s: %w", id, ErrNotFound)
+	}
+	return cluster, nil
+}
+
+// CreateCluster : 클러스터 등록 (ID가 비어 있으면 생성)
+func (r *Registry) CreateCluster(ctx context.Context, cluster Cluster) (Cluster, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id, err := resolveID(cluster.ID)
+	if err != nil {
+		return Cluster{}, err
+	}
+	if _, exists := r.clusters[id]; exists {
+		return Cluster{}, fmt.Errorf("cluster %s: %w", id, ErrAlreadyExists)
+	}
+
+	now := time.Now()
+	cluster.ID = id
+	cluster.Name = defaultName(cluster.Name, id)
+	cluster.CreatedAt = now
+	cluster.UpdatedAt = now
+
+	r.clusters[id] = cluster
+	if err := r.persistLocked(ctx); err != nil {
+		delete(r.clusters, id)
+		return Cluster{}, err
+	}
+	return cluster, nil
+}
+
+// UpdateCluster : 클러스터 수정
+func (r *Registry) UpdateCluster(ctx context.Context, id string, update Cluster) (Cluster, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.clusters[id]
+	if !ok {
+		return Cluster{}, fmt.Errorf("cluster %s: %w", id, ErrNotFound)
+	}
+
+	cluster := ApplyClusterUpdate(previous, update)
+	cluster.UpdatedAt = time.Now()
+
+	r.clusters[id] = cluster
+	if err := r.persistLocked(ctx); err != nil {
+		r.clusters[id] = previous
+		return Cluster{}, err
+	}
+	return cluster, nil
+}
+
+// DeleteCluster : 클러스터 삭제
+func (r *Registry) DeleteCluster(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.clusters[id]
+	if !ok {
+		return fmt.Errorf("cluster %s: %w", id, ErrNotFound)
+	}
+
+	delete(r.clusters, id)
+	if err := r.persistLocked(ctx); err != nil {
+		r.clusters[id] = previous
+		return err
+	}
+	return nil
+}
+
+// ===== 스토리지 관련 =====
+
+// ListStorages : 등록된 스토리지 목록 (ID 순, secretKey 마스킹)
+func (r *Registry) ListStorages() []Storage {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	storages := make([]Storage, 0, len(r.storages))
+	for _, storage := range r.storages {
+		storages = append(storages, storage.Redacted())
+	}
+	sort.Slice(storages, func(i, j int) bool { return storages[i].ID < storages[j].ID })
+	return storages
+}
+
+// GetStorage : 스토리지 조회 (자격 증명 포함)
+func (r *Registry) GetStorage(id string) (Storage, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	storage, ok := r.storages[id]
+	if !ok {
+		return Storage{}, fmt.Errorf("storage %s: %w", id, ErrNotFound)
+	}
+	return storage, nil
+}
+
+// CreateStorage : 스토리지 등록 (ID가 비어 있으면 생성)
+func (r *Registry) CreateStorage(ctx context.Context, storage Storage) (Storage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id, err := resolveID(storage.ID)
+	if err != nil {
+		return Storage{}, err
+	}
+	if _, exists := r.storages[id]; exists {
+		return Storage{}, fmt.Errorf("storage %s: %w", id, ErrAlreadyExists)
+	}
+
+	now := time.Now()
+	storage.ID = id
+	storage.Name = defaultName(storage.Name, id)
+	storage.CreatedAt = now
+	storage.UpdatedAt = now
+
+	r.storages[id] = storage
+	if err := r.persistLocked(ctx); err != nil {
+		delete(r.storages, id)
+		return Storage{}, err
+	}
+	return storage, nil
+}
+
+// UpdateStorage : 스토리지 수정
+func (r *Registry) UpdateStorage(ctx context.Context, id string, update Storage) (Storage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.storages[id]
+	if !ok {
+		return Storage{}, fmt.Errorf("storage %s: %w", id, ErrNotFound)
+	}
+
+	storage := ApplyStorageUpdate(previous, update)
+	storage.UpdatedAt = time.Now()
+
+	r.storages[id] = storage
+	if err := r.persistLocked(ctx); err != nil {
+		r.storages[id] = previous
+		return Storage{}, err
+	}
+	return storage, nil
+}
+
+// DeleteStorage : 스토리지 삭제
+func (r *Registry) DeleteStorage(ctx context.Context, id string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	previous, ok := r.storages[id]
+	if !ok {
+		return fmt.Errorf("storage %s: %w", id, ErrNotFound)
+	}
+
+	delete(r.storages, id)
+	if err := r.persistLocked(ctx); err != nil {
+		r.storages[id] = previous
+		return err
+	}
+	return nil
+}
+
+// ApplyClusterUpdate : 수정 요청을 기존 클러스터에 적용 (비어 있는 필드는 기존 값 유지)
+func ApplyClusterUpdate(current, update Cluster) Cluster {
+	if update.Name != "" {
+		current.Name = update.Name
+	}
+	if update.Description != "" {
+		current.Description = update.Description
+	}
+	if update.KubeConfig != "" {
+		current.KubeConfig = update.KubeConfig
+	}
+	return current
+}
+
+// ApplyStorageUpdate : 수정 요청을 기존 스토리지에 적용
+// 비어 있는 필드와 마스킹된 secretKey는 기존 값을 유지하며, endpoint 지정 시 useSSL도 함께 교체
+func ApplyStorageUpdate(current, update Storage) Storage {
+	if update.Name != "" {
+		current.Name = update.Name
+	}
+	if update.Description != "" {
+		current.Description = update.Description
+	}
+	if update.Minio.Endpoint != "" {
+		current.Minio.Endpoint = update.Minio.Endpoint
+		current.Minio.UseSSL = update.Minio.UseSSL
+	}
+	if update.Minio.AccessKey != "" {
+		current.Minio.AccessKey = update.Minio.AccessKey
+	}
+	if update.Minio.SecretKey != "" && update.Minio.SecretKey != maskedSecret {
+		current.Minio.SecretKey = update.Minio.SecretKey
+	}
+	return current
+}
+
+// ===== 내부 함수 =====
+
+// persistLocked : 현재 상태를 암호화하여 저장 (r.mu 보유 상태에서 호출)
+func (r *Registry) persistLocked(ctx context.Context) error {
+	doc := document{
+		Clusters: make([]Cluster, 0, len(r.clusters)),
+		Storages: make([]Storage, 0, len(r.storages)),
+	}
+	for _, cluster := range r.clusters {
+		doc.Clusters = append(doc.Clusters, cluster)
+	}
+	for _, storage := range r.storages {
+		doc.Storages = append(doc.Storages, storage)
+	}
+	sort.Slice(doc.Clusters, func(i, j int) bool { return doc.Clusters[i].ID < doc.Clusters[j].ID })
+	sort.Slice(doc.Storages, func(i, j int) bool { return doc.Storages[i].ID < doc.Storages[j].ID })
+
+	plaintext, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to encode registry: %w", err)
+	}
+
+	data, err := seal(r.aead, plaintext)
+	if err != nil {
+		return err
+	}
+	return r.backend.Save(ctx, data)
+}
+
+// resolveID : 등록 ID 검증 (비어 있으면 임의의 8자리 16진수로 생성)
+func resolveID(id string) (string, error) {
+	id = strings.TrimSpace(id)
+	if id == "" {
+		buf := make([]byte, 4)
+		if _, err := rand.Read(buf); err != nil {
+			return "", fmt.Errorf("failed to generate registry id: %w", err)
+		}
+		return hex.EncodeToString(buf), nil
+	}
+	if !idPattern.MatchString(id) {
+		return "", ErrInvalidID
+	}
+	return id, nil
+}
+
+// defaultName : 이름이 비어 있으면 ID 사용
+func defaultName(name, id string) string {
+	if strings.TrimSpace(name) == "" {
+		return id
+	}
+	return name
+}
@@ -0,0 +1,161 @@
+package registry
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/taking/kubemigrate/pkg/config"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// TestRegistry_EncryptedAtRest : 파일 저장소 암호화 저장 및 재로드 테스트
+func TestRegistry_EncryptedAtRest(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	opts := Options{
+		StoreType: StoreTypeFile,
+		StorePath: filepath.Join(dir, "data", "registry.enc"),
+		KeyFile:   filepath.Join(dir, "keys", "registry.key"),
+	}
+
+	reg, generated, err := NewFromOptions(ctx, opts)
+	if err != nil {
+		t.Fatalf("NewFromOptions() error = %v", err)
+	}
+	if !generated {
+		t.Errorf("Expected key file to be generated")
+	}
+
+	if _, err := reg.CreateCluster(ctx, Cluster{ID: "prod-a", KubeConfig: "secret-kubeconfig"}); err != nil {
+		t.Fatalf("CreateCluster() error = %v", err)
+	}
+	if _, err := reg.CreateStorage(ctx, Storage{Minio: config.MinioConfig{Endpoint: "minio:9000", SecretKey: "secret-key"}}); err != nil {
+		t.Fatalf("CreateStorage() error = %v", err)
+	}
+	if _, err := reg.CreateCluster(ctx, Cluster{ID: "prod-a"}); !errors.Is(err, ErrAlreadyExists) {
+		t.Errorf("Expected ErrAlreadyExists, got %v", err)
+	}
+	if _, err := reg.CreateCluster(ctx, Cluster{ID: "Prod_A"}); !errors.Is(err, ErrInvalidID) {
+		t.Errorf("Expected ErrInvalidID, got %v", err)
+	}
+
+	data, err := os.ReadFile(opts.StorePath)
+	if err != nil {
+		t.Fatalf("Failed to read registry file: %v", err)
+	}
+	if bytes.Contains(data, []byte("secret-kubeconfig")) || bytes.Contains(data, []byte("secret-key")) {
+		t.Errorf("Expected credentials to be encrypted at rest")
+	}
+	if info, _ := os.Stat(opts.StorePath); info.Mode().Perm() != 0o600 {
+		t.Errorf("Expected registry file mode 0600, got %v", info.Mode().Perm())
+	}
+
+	// 같은 키로 재로드
+	reloaded, generated, err := NewFromOptions(ctx, opts)
+	if err != nil {
+		t.Fatalf("NewFromOptions() reload error = %v", err)
+	}
+	if generated {
+		t.Errorf("Expected existing key file to be reused")
+	}
+	cluster, err := reloaded.GetCluster("prod-a")
+	if err != nil || cluster.KubeConfig != "secret-kubeconfig" {
+		t.Errorf("Expected cluster to be reloaded, got %+v (%v)", cluster, err)
+	}
+	storages := reloaded.ListStorages()
+	if len(storages) != 1 || storages[0].Minio.SecretKey != maskedSecret {
+		t.Errorf("Expected one storage with masked secret key, got %+v", storages)
+	}
+
+	// 다른 키로는 복호화 실패
+	opts.KeyFile = ""
+	opts.EncryptionKey = "another passphrase"
+	if _, _, err := NewFromOptions(ctx, opts); err == nil {
+		t.Errorf("Expected decryption to fail with a different key")
+	}
+}
+
+// TestNewFromOptions_KeyLocation : 암호화 키 미설정 및 저장소 디렉토리 안의 키 파일 거부 테스트
+func TestNewFromOptions_KeyLocation(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	storePath := filepath.Join(dir, "registry.enc")
+
+	tests := []struct {
+		name    string
+		keyFile string
+		wantErr error
+	}{
+		{name: "no key", keyFile: "", wantErr: ErrKeyRequired},
+		{name: "key file next to store", keyFile: filepath.Join(dir, "registry.key"), wantErr: ErrKeyInStoreDir},
+		{name: "key file under store directory", keyFile: filepath.Join(dir, "keys", "registry.key"), wantErr: ErrKeyInStoreDir},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, _, err := NewFromOptions(ctx, Options{StoreType: StoreTypeFile, StorePath: storePath, KeyFile: tt.keyFile})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Expected %v, got %v", tt.wantErr, err)
+			}
+			if tt.keyFile != "" {
+				if _, err := os.Stat(tt.keyFile); !os.IsNotExist(err) {
+					t.Errorf("Expected key file not to be generated, stat error = %v", err)
+				}
+			}
+		})
+	}
+}
+
+// TestRegistry_UpdateAndSecretBackend : 부분 수정 및 Secret 저장소 테스트
+func TestRegistry_UpdateAndSecretBackend(t *testing.T) {
+	ctx := context.Background()
+	clientset := fake.NewSimpleClientset()
+	backend, err := NewSecretBackend(clientset, "kubemigrate", "kubemigrate-registry")
+	if err != nil {
+		t.Fatalf("NewSecretBackend() error = %v", err)
+	}
+
+	key, err := ParseKey("passphrase")
+	if err != nil {
+		t.Fatalf("ParseKey() error = %v", err)
+	}
+	reg, err := New(ctx, backend, key)
+	if err != nil {
+		t.Fatalf("New() error = %v", err)
+	}
+
+	if _, err := reg.CreateStorage(ctx, Storage{ID: "backup", Minio: config.MinioConfig{
+		Endpoint: "minio:9000", AccessKey: "access", SecretKey: "secret", UseSSL: true,
+	}}); err != nil {
+		t.Fatalf("CreateStorage() error = %v", err)
+	}
+
+	// 마스킹된 secretKey와 비어 있는 필드는 기존 값 유지
+	updated, err := reg.UpdateStorage(ctx, "backup", Storage{Name: "Backup", Minio: config.MinioConfig{SecretKey: maskedSecret}})
+	if err != nil {
+		t.Fatalf("UpdateStorage() error = %v", err)
+	}
+	if updated.Name != "Backup" || updated.Minio.SecretKey != "secret" || !updated.Minio.UseSSL {
+		t.Errorf("Unexpected updated storage: %+v", updated)
+	}
+
+	reloaded, err := New(ctx, backend, key)
+	if err != nil {
+		t.Fatalf("New() reload error = %v", err)
+	}
+	storage, err := reloaded.GetStorage("backup")
+	if err != nil || storage.Name != "Backup" {
+		t.Errorf("Expected storage to be reloaded from secret, got %+v (%v)", storage, err)
+	}
+
+	if err := reloaded.DeleteStorage(ctx, "backup"); err != nil {
+		t.Fatalf("DeleteStorage() error = %v", err)
+	}
+	if _, err := reloaded.GetStorage("backup"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Expected ErrNotFound after delete, got %v", err)
+	}
+}
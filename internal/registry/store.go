@@ -0,0 +1,185 @@
+package registry
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+// secretDataKey : Secret 저장소에서 암호화 문서를 담는 키
+const secretDataKey = "registry"
+
+// Backend : 암호화된 레지스트리 문서 저장소 인터페이스
+// 저장된 문서가 없으면 Load는 nil을 반환
+type Backend interface {
+	Load(ctx context.Context) ([]byte, error)
+	Save(ctx context.Context, data []byte) error
+}
+
+// MemoryBackend : 메모리에 보관하는 저장소 (테스트/Mock용)
+type MemoryBackend struct {
+	mu   sync.Mutex
+	data []byte
+}
+
+// NewMemoryBackend : 메모리 저장소 생성
+func NewMemoryBackend() *MemoryBackend {
+	return &MemoryBackend{}
+}
+
+// Load : 저장된 문서 조회
+func (b *MemoryBackend) Load(_ context.Context) ([]byte, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]byte(nil), b.data...), nil
+}
+
+// Save : 문서 저장
+func (b *MemoryBackend) Save(_ context.Context, data []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.data = append([]byte(nil), data...)
+	return nil
+}
+
+// FileBackend : 로컬 파일에 저장하는 저장소
+// 디렉토리는 0700, 파일은 0600 권한으로 생성
+type FileBackend struct {
+	path string
+}
+
+// NewFileBackend : 파일 저장소 생성
+func NewFileBackend(path string) (*FileBackend, error) {
+	if path == "" {
+		return nil, fmt.Errorf("registry store path is required")
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create registry directory %s: %w", filepath.Dir(path), err)
+	}
+
+	return &FileBackend{path: path}, nil
+}
+
+// Load : 파일에서 문서 조회
+func (b *FileBackend) Load(_ context.Context) ([]byte, error) {
+	data, err := os.ReadFile(b.path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read registry file %s: %w", b.path, err)
+	}
+	return data, nil
+}
+
+// Save : 문서 저장 (임시 파일 작성 후 rename으로 원자적 교체)
+func (b *FileBackend) Save(_ context.Context, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(b.path), ".registry-*.tmp")
+	if err != nil {
+		return fmt.Errorf("failed to create temp file for registry: %w", err)
+	}
+	defer os.Remove(tmp.Name()) //nolint:errcheck
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close() //nolint:errcheck
+		return fmt.Errorf("failed to write registry: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write registry: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), b.path); err != nil {
+		return fmt.Errorf("failed to save registry: %w", err)
+	}
+
+	return nil
+}
+
+// SecretBackend : Kubernetes Secret에 저장하는 저장소
+type SecretBackend struct {
+	clientset kubernetes.Interface
+	namespace string
+	name      string
+}
+
+// NewSecretBackend : Secret 저장소 생성
+func NewSecretBackend(clientset kubernetes.Interface, namespace, name string) (*SecretBackend, error) {
+	if namespace == "" || name == "" {
+		return nil, fmt.Errorf("registry secret namespace and name are required")
+	}
+	return &SecretBackend{clientset: clientset, namespace: namespace, name: name}, nil
+}
+
+// NewSecretBackendFromEnvironment : in-cluster 설정(없으면 로컬 kubeconfig)으로 Secret 저장소 생성
+func NewSecretBackendFromEnvironment(namespace, name string) (*SecretBackend, error) {
+	restConfig, err := rest.InClusterConfig()
+	if err != nil {
+		restConfig, err = clientcmd.BuildConfigFromFlags("", clientcmd.RecommendedHomeFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load kubernetes config for registry secret: %w", err)
+		}
+	}
+
+	clientset, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubernetes client for registry secret: %w", err)
+	}
+
+	return NewSecretBackend(clientset, namespace, name)
+}
+
+// Load : Secret에서 문서 조회
+func (b *SecretBackend) Load(ctx context.Context) ([]byte, error) {
+	secret, err := b.clientset.CoreV1().Secrets(b.namespace).Get(ctx, b.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registry secret %s/%s: %w", b.namespace, b.name, err)
+	}
+	return secret.Data[secretDataKey], nil
+}
+
+// Save : Secret에 문서 저장 (없으면 생성)
+func (b *SecretBackend) Save(ctx context.Context, data []byte) error {
+	secrets := b.clientset.CoreV1().Secrets(b.namespace)
+
+	secret, err := secrets.Get(ctx, b.name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		_, err = secrets.Create(ctx, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      b.name,
+				Namespace: b.namespace,
+				Labels:    map[string]string{"app.kubernetes.io/managed-by": "kubemigrate"},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{secretDataKey: data},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("failed to create registry secret %s/%s: %w", b.namespace, b.name, err)
+		}
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get registry secret %s/%s: %w", b.namespace, b.name, err)
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	secret.Data[secretDataKey] = data
+	if _, err := secrets.Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update registry secret %s/%s: %w", b.namespace, b.name, err)
+	}
+	return nil
+}
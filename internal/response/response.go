@@ -1,11 +1,13 @@
 package response
 
 import (
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/taking/kubemigrate/internal/logger"
+	"sigs.k8s.io/yaml"
 )
 
 // ErrorResponse : 표준 에러 응답 구조체
@@ -129,6 +131,21 @@ func RespondWithData(ctx echo.Context, statusCode int, data interface{}) error {
 	return ctx.JSON(statusCode, response)
 }
 
+// RespondWithYAML : 데이터를 YAML로 응답 (kubectl -o yaml과 같이 응답 래퍼 없이 전송)
+func RespondWithYAML(ctx echo.Context, statusCode int, data interface{}) error {
+	body, err := yaml.Marshal(data)
+	if err != nil {
+		return RespondWithErrorModel(ctx, http.StatusInternalServerError, "YAML_ENCODING_FAILED", "Failed to encode response as YAML", err.Error())
+	}
+
+	logger.Info("YAML 응답 전송",
+		logger.Int("status_code", statusCode),
+		logger.String("request_id", ctx.Response().Header().Get(echo.HeaderXRequestID)),
+	)
+
+	return ctx.Blob(statusCode, "application/yaml", body)
+}
+
 // RespondWithMessage : 메시지만 포함하는 간단한 성공 응답
 func RespondWithMessage(ctx echo.Context, statusCode int, message string) error {
 	response := &SuccessResponse{
@@ -212,14 +229,30 @@ func HandleValidationError(c echo.Context, serviceName, operation string, err er
 	}, err)
 }
 
+// connectionStatusError : 유형별 상태 코드를 가진 연결 에러 (client.ConnectionError)
+type connectionStatusError interface {
+	error
+	StatusCode() int
+	ErrorCode() string
+}
+
 // HandleConnectionError : 공통 연결 에러 처리 함수
+// 분류된 연결 에러는 유형별 상태 코드로 응답 (설정 오류 400, 인증 실패 401, 권한 부족 403, TLS 오류/연결 불가 502, 시간 초과 504)
 func HandleConnectionError(c echo.Context, serviceName, operation string, err error) error {
-	return HandleError(c, ErrorHandlerConfig{
+	config := ErrorHandlerConfig{
 		ServiceName: serviceName,
 		Operation:   operation,
 		ErrorCode:   "CONNECTION_FAILED",
 		StatusCode:  500,
-	}, err)
+	}
+
+	var statusErr connectionStatusError
+	if errors.As(err, &statusErr) {
+		config.ErrorCode = statusErr.ErrorCode()
+		config.StatusCode = statusErr.StatusCode()
+	}
+
+	return HandleError(c, config, err)
 }
 
 // HandleInternalError : 공통 내부 에러 처리 함수
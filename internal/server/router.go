@@ -3,10 +3,14 @@ package server
 
 import (
 	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/api/audit"
 	"github.com/taking/kubemigrate/internal/api/helm"
 	"github.com/taking/kubemigrate/internal/api/kubernetes"
+	"github.com/taking/kubemigrate/internal/api/migration"
 	"github.com/taking/kubemigrate/internal/api/minio"
+	"github.com/taking/kubemigrate/internal/api/registry"
 	"github.com/taking/kubemigrate/internal/api/velero"
+	auditlog "github.com/taking/kubemigrate/internal/audit"
 	"github.com/taking/kubemigrate/internal/handler"
 	"github.com/taking/kubemigrate/internal/job"
 	appMiddleware "github.com/taking/kubemigrate/internal/middleware"
@@ -19,11 +23,15 @@ import (
 func NewRouter(cfg *config.Config) *echo.Echo {
 	e := echo.New()
 
+	// 감사 로그 초기화 (미들웨어와 조회 API에서 공유)
+	auditLog := appMiddleware.NewAuditRecorder(cfg.Audit)
+
 	// 미들웨어 설정
-	appMiddleware.SetupMiddleware(e, cfg)
+	appMiddleware.SetupMiddleware(e, cfg, auditLog)
 
 	// 공통 컴포넌트 초기화
 	workerPool := job.NewWorkerPool(constants.DefaultWorkerPoolSize)
+	workerPool.RegisterMetrics("shared")
 
 	// BaseHandler 생성
 	baseHandler := handler.NewBaseHandler(workerPool)
@@ -32,7 +40,7 @@ func NewRouter(cfg *config.Config) *echo.Echo {
 	StartBackgroundTasks(baseHandler)
 
 	// 핸들러 생성
-	handlers := createHandlers(baseHandler)
+	handlers := createHandlers(baseHandler, auditLog)
 
 	// 라우트 설정
 	setupRoutes(e, handlers)
@@ -41,12 +49,15 @@ func NewRouter(cfg *config.Config) *echo.Echo {
 }
 
 // createHandlers 모든 핸들러를 생성합니다.
-func createHandlers(baseHandler *handler.BaseHandler) *Handlers {
+func createHandlers(baseHandler *handler.BaseHandler, auditLog auditlog.Recorder) *Handlers {
 	return &Handlers{
 		Velero:     velero.NewHandler(baseHandler),
 		Helm:       helm.NewHandler(baseHandler),
 		Kubernetes: kubernetes.NewHandler(baseHandler),
 		Minio:      minio.NewHandler(baseHandler),
+		Migration:  migration.NewHandler(baseHandler),
+		Registry:   registry.NewHandler(baseHandler),
+		Audit:      audit.NewHandler(baseHandler, auditLog),
 		Base:       baseHandler,
 	}
 }
@@ -67,7 +78,11 @@ func setupRoutes(e *echo.Echo, handlers *Handlers) {
 	routes.SetupHelmRoutes(e, handlers.Helm)
 	routes.SetupKubernetesRoutes(e, handlers.Kubernetes)
 	routes.SetupMinioRoutes(e, handlers.Minio)
+	routes.SetupMigrationRoutes(e, handlers.Migration)
+	routes.SetupRegistryRoutes(e, handlers.Registry)
+	routes.SetupAuditRoutes(e, handlers.Audit)
 	routes.SetupHealthRoutes(e, handlers.Base)
+	routes.SetupMetricsRoutes(e)
 }
 
 // Handlers 모든 핸들러를 포함하는 구조체입니다.
@@ -76,5 +91,8 @@ type Handlers struct {
 	Helm       *helm.Handler
 	Kubernetes *kubernetes.Handler
 	Minio      *minio.Handler
+	Migration  *migration.Handler
+	Registry   *registry.Handler
+	Audit      *audit.Handler
 	Base       *handler.BaseHandler
 }
@@ -0,0 +1,17 @@
+// Package routes 감사 로그 관련 라우트를 관리합니다.
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/api/audit"
+)
+
+// SetupAuditRoutes 감사 로그 라우트를 설정합니다.
+func SetupAuditRoutes(e *echo.Echo, auditHandler *audit.Handler) {
+	api := e.Group("/api/v1")
+
+	// 감사 로그 (admin 전용)
+	auditGroup := api.Group("/audit")
+	auditGroup.Use(requireAdmin)
+	auditGroup.GET("", auditHandler.GetAuditLogs) // 감사 로그 조회
+}
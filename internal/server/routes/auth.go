@@ -0,0 +1,15 @@
+// Package routes 라우트 권한 미들웨어를 관리합니다.
+package routes
+
+import (
+	"github.com/taking/kubemigrate/internal/auth"
+	appMiddleware "github.com/taking/kubemigrate/internal/middleware"
+)
+
+// 역할별 권한 미들웨어
+// 라우트 그룹에는 requireViewer를 적용하고, 변경 작업은 requireOperator, 삭제 등 파괴적 작업과 자격 증명 관리는 requireAdmin을 라우트별로 추가
+var (
+	requireViewer   = appMiddleware.RequireRole(auth.RoleViewer)
+	requireOperator = appMiddleware.RequireRole(auth.RoleOperator)
+	requireAdmin    = appMiddleware.RequireRole(auth.RoleAdmin)
+)
@@ -24,6 +24,7 @@ func SetupHealthRoutes(e *echo.Echo, baseHandler *handler.BaseHandler) {
 
 	// 캐시 관리 라우트
 	cacheGroup := api.Group("/cache")
+	cacheGroup.Use(requireViewer)
 	cacheGroup.GET("/stats", func(c echo.Context) error {
 		stats := baseHandler.GetCacheStats()
 		return c.JSON(200, map[string]interface{}{
@@ -44,7 +45,7 @@ func SetupHealthRoutes(e *echo.Echo, baseHandler *handler.BaseHandler) {
 			"status":  "success",
 			"message": "Cache cleanup completed",
 		})
-	})
+	}, requireAdmin)
 	cacheGroup.DELETE("/clean/:cache_key", func(c echo.Context) error {
 		cacheKey := c.Param("cache_key")
 		if cacheKey == "" {
@@ -74,10 +75,11 @@ func SetupHealthRoutes(e *echo.Echo, baseHandler *handler.BaseHandler) {
 				"removed":   false,
 			},
 		})
-	})
+	}, requireAdmin)
 
 	// 메모리 관리 라우트 (구현 예정)
 	memoryGroup := api.Group("/memory")
+	memoryGroup.Use(requireViewer)
 	memoryGroup.GET("/stats", func(c echo.Context) error {
 		return c.JSON(200, map[string]interface{}{
 			"status": "success",
@@ -89,7 +91,7 @@ func SetupHealthRoutes(e *echo.Echo, baseHandler *handler.BaseHandler) {
 			"status":  "success",
 			"message": "Memory optimization not implemented yet",
 		})
-	})
+	}, requireOperator)
 	memoryGroup.GET("/usage", func(c echo.Context) error {
 		return c.JSON(200, map[string]interface{}{
 			"status": "success",
@@ -10,22 +10,35 @@ import (
 func SetupHelmRoutes(e *echo.Echo, helmHandler *helm.Handler) {
 	api := e.Group("/api/v1")
 	helmGroup := api.Group("/helm")
+	helmGroup.Use(requireViewer)
 
 	// 헬스체크
 	helmGroup.POST("/health", helmHandler.HealthCheck)
 
 	// 차트 관리 라우트 (RESTful)
-	helmGroup.POST("/charts", helmHandler.InstallChart)                 // 차트 설치
-	helmGroup.GET("/charts", helmHandler.GetCharts)                     // 차트 목록 조회
-	helmGroup.GET("/charts/:name", helmHandler.GetChart)                // 차트 상세 조회
-	helmGroup.GET("/charts/:name/status", helmHandler.GetChartStatus)   // 차트 상태 조회
-	helmGroup.PUT("/charts/:name", helmHandler.UpgradeChart)            // 차트 업그레이드
-	helmGroup.GET("/charts/:name/history", helmHandler.GetChartHistory) // 차트 히스토리 조회
-	helmGroup.GET("/charts/:name/values", helmHandler.GetChartValues)   // 차트 값 조회
-	helmGroup.DELETE("/charts/:name", helmHandler.UninstallChart)       // 차트 제거
+	helmGroup.POST("/charts", helmHandler.InstallChart, requireOperator)                 // 차트 설치
+	helmGroup.GET("/charts", helmHandler.GetCharts)                                      // 차트 목록 조회
+	helmGroup.GET("/charts/:name", helmHandler.GetChart)                                 // 차트 상세 조회
+	helmGroup.GET("/charts/:name/status", helmHandler.GetChartStatus)                    // 차트 상태 조회
+	helmGroup.PUT("/charts/:name", helmHandler.UpgradeChart, requireOperator)            // 차트 업그레이드
+	helmGroup.GET("/charts/:name/history", helmHandler.GetChartHistory)                  // 차트 히스토리 조회
+	helmGroup.GET("/charts/:name/values", helmHandler.GetChartValues)                    // 차트 값 조회
+	helmGroup.POST("/charts/:name/rollback", helmHandler.RollbackChart, requireOperator) // 차트 롤백
+	helmGroup.DELETE("/charts/:name", helmHandler.UninstallChart, requireAdmin)          // 차트 제거
+
+	// 차트 저장소 / OCI 레지스트리 관리 라우트 (클러스터 무관)
+	helmGroup.GET("/repositories", helmHandler.GetRepositories)                             // 저장소 목록 조회
+	helmGroup.POST("/repositories", helmHandler.AddRepository, requireOperator)             // 저장소 등록
+	helmGroup.POST("/repositories/update", helmHandler.UpdateRepositories, requireOperator) // 저장소 인덱스 갱신
+	helmGroup.DELETE("/repositories/:name", helmHandler.RemoveRepository, requireAdmin)     // 저장소 등록 해제
+	helmGroup.GET("/search", helmHandler.SearchCharts)                                      // 차트 검색
+	helmGroup.POST("/registries/login", helmHandler.LoginRegistry, requireOperator)         // OCI 레지스트리 로그인
+	helmGroup.POST("/registries/logout", helmHandler.LogoutRegistry, requireOperator)       // OCI 레지스트리 로그아웃
 
 	// 비동기 작업 관리 라우트
-	helmGroup.GET("/charts/status/:jobId", helmHandler.GetJobStatus) // 작업 상태 조회
-	helmGroup.GET("/charts/logs/:jobId", helmHandler.GetJobLogs)     // 작업 로그 조회
-	helmGroup.GET("/charts/jobs", helmHandler.GetAllJobs)            // 모든 작업 조회
+	helmGroup.GET("/charts/status/:jobId", helmHandler.GetJobStatus)              // 작업 상태 조회
+	helmGroup.GET("/charts/logs/:jobId", helmHandler.GetJobLogs)                  // 작업 로그 조회
+	helmGroup.GET("/charts/jobs", helmHandler.GetAllJobs)                         // 모든 작업 조회
+	helmGroup.POST("/jobs/:jobId/cancel", helmHandler.CancelJob, requireOperator) // 작업 취소
+	helmGroup.GET("/jobs/:jobId/stream", helmHandler.StreamJob)                   // 작업 진행 스트리밍 (SSE)
 }
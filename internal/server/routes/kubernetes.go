@@ -10,6 +10,7 @@ import (
 func SetupKubernetesRoutes(e *echo.Echo, kubernetesHandler *kubernetes.Handler) {
 	api := e.Group("/api/v1")
 	k8sGroup := api.Group("/kubernetes")
+	k8sGroup.Use(requireViewer)
 
 	// 헬스체크
 	k8sGroup.POST("/health", kubernetesHandler.HealthCheck)
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/metrics"
+)
+
+// SetupMetricsRoutes Prometheus 메트릭 라우트를 설정합니다.
+func SetupMetricsRoutes(e *echo.Echo) {
+	e.GET("/metrics", func(c echo.Context) error {
+		c.Response().Header().Set(echo.HeaderContentType, metrics.ContentType)
+		c.Response().WriteHeader(http.StatusOK)
+		return metrics.Default.WriteText(c.Response())
+	}, requireViewer)
+}
@@ -0,0 +1,22 @@
+// Package routes 마이그레이션 관련 라우트를 관리합니다.
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/api/migration"
+)
+
+// SetupMigrationRoutes 마이그레이션 관련 라우트를 설정합니다.
+func SetupMigrationRoutes(e *echo.Echo, migrationHandler *migration.Handler) {
+	api := e.Group("/api/v1")
+	migrationGroup := api.Group("/migrations")
+	migrationGroup.Use(requireViewer)
+
+	migrationGroup.POST("", migrationHandler.StartMigration, requireOperator)                      // 마이그레이션 시작
+	migrationGroup.GET("", migrationHandler.GetMigrations)                                         // 마이그레이션 목록 조회
+	migrationGroup.POST("/analyze", migrationHandler.AnalyzeCompatibility)                         // 사전 호환성 분석
+	migrationGroup.GET("/:migrationId", migrationHandler.GetMigration)                             // 마이그레이션 상태 조회
+	migrationGroup.GET("/:migrationId/stream", migrationHandler.StreamMigration)                   // 진행 스트리밍 (SSE)
+	migrationGroup.POST("/:migrationId/cancel", migrationHandler.CancelMigration, requireOperator) // 마이그레이션 취소
+	migrationGroup.POST("/:migrationId/resume", migrationHandler.ResumeMigration, requireOperator) // 실패한 마이그레이션 재개
+}
@@ -10,29 +10,52 @@ import (
 func SetupMinioRoutes(e *echo.Echo, minioHandler *minio.Handler) {
 	api := e.Group("/api/v1")
 	minioGroup := api.Group("/minio")
+	minioGroup.Use(requireViewer)
 
 	// 헬스체크
 	minioGroup.POST("/health", minioHandler.HealthCheck)
 
 	// 버킷 관리 라우트 (RESTful)
-	minioGroup.GET("/buckets", minioHandler.GetBuckets)                // 버킷 목록 조회
-	minioGroup.GET("/buckets/:bucket", minioHandler.CheckBucketExists) // 버킷 존재 확인
-	minioGroup.POST("/buckets/:bucket", minioHandler.CreateBucket)     // 버킷 생성
-	minioGroup.DELETE("/buckets/:bucket", minioHandler.DeleteBucket)   // 버킷 삭제
+	minioGroup.GET("/buckets", minioHandler.GetBuckets)                             // 버킷 목록 조회
+	minioGroup.GET("/buckets/:bucket", minioHandler.CheckBucketExists)              // 버킷 존재 확인
+	minioGroup.POST("/buckets/:bucket", minioHandler.CreateBucket, requireOperator) // 버킷 생성
+	minioGroup.DELETE("/buckets/:bucket", minioHandler.DeleteBucket, requireAdmin)  // 버킷 삭제
+
+	// 버킷 설정 라우트 (버전 관리, Object Lock 보존, 수명 주기, 정책)
+	minioGroup.GET("/buckets/:bucket/versioning", minioHandler.GetBucketVersioning)                   // 버전 관리 상태 조회
+	minioGroup.PUT("/buckets/:bucket/versioning", minioHandler.SetBucketVersioning, requireOperator)  // 버전 관리 활성화/일시 중지
+	minioGroup.GET("/buckets/:bucket/retention", minioHandler.GetBucketRetention)                     // Object Lock 보존 설정 조회
+	minioGroup.PUT("/buckets/:bucket/retention", minioHandler.SetBucketRetention, requireAdmin)       // Object Lock 기본 보존 설정
+	minioGroup.GET("/buckets/:bucket/lifecycle", minioHandler.GetBucketLifecycle)                     // 수명 주기 규칙 조회
+	minioGroup.PUT("/buckets/:bucket/lifecycle", minioHandler.SetBucketLifecycle, requireOperator)    // 수명 주기 규칙 교체
+	minioGroup.DELETE("/buckets/:bucket/lifecycle", minioHandler.DeleteBucketLifecycle, requireAdmin) // 수명 주기 설정 삭제
+	minioGroup.GET("/buckets/:bucket/policy", minioHandler.GetBucketPolicy)                           // 버킷 정책 조회
+	minioGroup.PUT("/buckets/:bucket/policy", minioHandler.SetBucketPolicy, requireAdmin)             // 버킷 정책 교체
+	minioGroup.DELETE("/buckets/:bucket/policy", minioHandler.DeleteBucketPolicy, requireAdmin)       // 버킷 정책 삭제
 
 	// 객체 관리 라우트 (RESTful)
-	minioGroup.GET("/buckets/:bucket/objects", minioHandler.GetObjects)                                           // 객체 목록 조회
-	minioGroup.POST("/buckets/:bucket/objects/*", minioHandler.PutObject)                                         // 객체 업로드
-	minioGroup.GET("/buckets/:bucket/objects/*", minioHandler.GetObject)                                          // 객체 다운로드
-	minioGroup.GET("/buckets/:bucket/objects/*", minioHandler.StatObject)                                         // 객체 정보 조회
-	minioGroup.POST("/buckets/:srcBucket/objects/:srcObject/copy/:dstBucket/:dstObject", minioHandler.CopyObject) // 객체 복사
-	minioGroup.DELETE("/buckets/:bucket/objects/*", minioHandler.DeleteObject)                                    // 객체 삭제
+	minioGroup.GET("/buckets/:bucket/objects", minioHandler.GetObjects)                                                            // 객체 목록 조회
+	minioGroup.POST("/buckets/:bucket/objects/*", minioHandler.PutObject, requireOperator)                                         // 객체 업로드
+	minioGroup.PUT("/buckets/:bucket/objects/*", minioHandler.UploadObject, requireOperator)                                       // 객체 스트리밍 업로드 (원본 본문)
+	minioGroup.GET("/buckets/:bucket/objects/*", minioHandler.GetObject, requireOperator)                                          // 객체 스트리밍 다운로드 (Range 지원, 백업 tarball의 Secret 포함)
+	minioGroup.HEAD("/buckets/:bucket/objects/*", minioHandler.GetObject)                                                          // 객체 메타데이터 헤더 조회
+	minioGroup.GET("/buckets/:bucket/stat/*", minioHandler.StatObject)                                                             // 객체 정보 조회
+	minioGroup.POST("/buckets/:srcBucket/objects/:srcObject/copy/:dstBucket/:dstObject", minioHandler.CopyObject, requireOperator) // 객체 복사
+	minioGroup.DELETE("/buckets/:bucket/objects/*", minioHandler.DeleteObject, requireAdmin)                                       // 객체 삭제
 
 	// Presigned URL 라우트
-	minioGroup.GET("/buckets/:bucket/objects/:object/presigned-get", minioHandler.PresignedGetObject) // Presigned GET URL 생성
-	minioGroup.PUT("/buckets/:bucket/objects/:object/presigned-put", minioHandler.PresignedPutObject) // Presigned PUT URL 생성
+	minioGroup.GET("/buckets/:bucket/objects/:object/presigned-get", minioHandler.PresignedGetObject, requireOperator) // Presigned GET URL 생성
+	minioGroup.PUT("/buckets/:bucket/objects/:object/presigned-put", minioHandler.PresignedPutObject, requireOperator) // Presigned PUT URL 생성
 
 	// 폴더 관리 라우트
-	minioGroup.GET("/buckets/:bucket/folders/*", minioHandler.ListObjectsInFolder) // 폴더 내 객체 목록 조회
-	minioGroup.DELETE("/buckets/:bucket/folders/*", minioHandler.DeleteFolder)     // 폴더 삭제
+	minioGroup.GET("/buckets/:bucket/folders/*", minioHandler.ListObjectsInFolder)           // 폴더 내 객체 목록 조회
+	minioGroup.DELETE("/buckets/:bucket/folders/*", minioHandler.DeleteFolder, requireAdmin) // 폴더 삭제
+
+	// 복제 라우트
+	minioGroup.POST("/replications", minioHandler.StartReplication, requireOperator) // 버킷 간 객체 복제 시작
+
+	// 업로드/복제 작업 관리 라우트
+	minioGroup.GET("/status/:jobId", minioHandler.GetJobStatus)                     // 업로드/복제 진행 상황 조회
+	minioGroup.POST("/jobs/:jobId/cancel", minioHandler.CancelJob, requireOperator) // 업로드/복제 취소
+	minioGroup.GET("/jobs/:jobId/stream", minioHandler.StreamJob)                   // 업로드/복제 진행 스트리밍 (SSE)
 }
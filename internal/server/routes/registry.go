@@ -0,0 +1,30 @@
+// Package routes 클러스터/스토리지 레지스트리 관련 라우트를 관리합니다.
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/taking/kubemigrate/internal/api/registry"
+)
+
+// SetupRegistryRoutes 클러스터/스토리지 레지스트리 라우트를 설정합니다.
+func SetupRegistryRoutes(e *echo.Echo, registryHandler *registry.Handler) {
+	api := e.Group("/api/v1")
+
+	// 등록된 클러스터 (kubeconfig)
+	clusterGroup := api.Group("/clusters")
+	clusterGroup.Use(requireViewer)
+	clusterGroup.GET("", registryHandler.ListClusters)                              // 클러스터 목록 조회
+	clusterGroup.POST("", registryHandler.CreateCluster, requireAdmin)              // 클러스터 등록
+	clusterGroup.GET("/:clusterId", registryHandler.GetCluster)                     // 클러스터 조회
+	clusterGroup.PUT("/:clusterId", registryHandler.UpdateCluster, requireAdmin)    // 클러스터 수정
+	clusterGroup.DELETE("/:clusterId", registryHandler.DeleteCluster, requireAdmin) // 클러스터 삭제
+
+	// 등록된 스토리지 (MinIO)
+	storageGroup := api.Group("/storages")
+	storageGroup.Use(requireViewer)
+	storageGroup.GET("", registryHandler.ListStorages)                              // 스토리지 목록 조회
+	storageGroup.POST("", registryHandler.CreateStorage, requireAdmin)              // 스토리지 등록
+	storageGroup.GET("/:storageId", registryHandler.GetStorage)                     // 스토리지 조회
+	storageGroup.PUT("/:storageId", registryHandler.UpdateStorage, requireAdmin)    // 스토리지 수정
+	storageGroup.DELETE("/:storageId", registryHandler.DeleteStorage, requireAdmin) // 스토리지 삭제
+}
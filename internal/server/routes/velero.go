@@ -10,35 +10,68 @@ import (
 func SetupVeleroRoutes(e *echo.Echo, veleroHandler *velero.Handler) {
 	api := e.Group("/api/v1")
 	veleroGroup := api.Group("/velero")
+	veleroGroup.Use(requireViewer)
 
 	// 헬스체크
 	veleroGroup.POST("/health", veleroHandler.HealthCheck)
 
 	// 설치 및 설정 라우트
-	veleroGroup.POST("/install", veleroHandler.InstallVeleroWithMinIO)
+	veleroGroup.POST("/install", veleroHandler.InstallVeleroWithMinIO, requireOperator)
+	veleroGroup.DELETE("/install", veleroHandler.UninstallVelero, requireAdmin)
+	veleroGroup.GET("/install/status", veleroHandler.GetInstallStatus)
+	veleroGroup.POST("/cleanup", veleroHandler.CleanupVelero, requireAdmin)
 
 	// 백업 관련 라우트
 	veleroGroup.GET("/backups", veleroHandler.GetBackups)
 	veleroGroup.GET("/backups/:backupName", veleroHandler.GetBackup)
-	veleroGroup.POST("/backups", veleroHandler.CreateBackup)
-	veleroGroup.DELETE("/backups/:backupName", veleroHandler.DeleteBackup)
+	veleroGroup.POST("/backups", veleroHandler.CreateBackup, requireOperator)
+	veleroGroup.DELETE("/backups/:backupName", veleroHandler.DeleteBackup, requireAdmin)
 	veleroGroup.POST("/backups/:backupName/validate", veleroHandler.ValidateBackup)
+	veleroGroup.GET("/backups/:backupName/contents", veleroHandler.GetBackupContents)
+	veleroGroup.GET("/backups/:backupName/contents/:resource/:name", veleroHandler.GetBackupContentManifest)
+	veleroGroup.GET("/backups/:backupName/diff/:targetBackup", veleroHandler.DiffBackups)
+	veleroGroup.GET("/backups/:backupName/logs", veleroHandler.GetBackupLogs)
+
+	// 스케줄 관련 라우트
+	veleroGroup.GET("/schedules", veleroHandler.GetSchedules)
+	veleroGroup.GET("/schedules/:scheduleName", veleroHandler.GetSchedule)
+	veleroGroup.POST("/schedules", veleroHandler.CreateSchedule, requireOperator)
+	veleroGroup.PUT("/schedules/:scheduleName", veleroHandler.UpdateSchedule, requireOperator)
+	veleroGroup.DELETE("/schedules/:scheduleName", veleroHandler.DeleteSchedule, requireAdmin)
+	veleroGroup.POST("/schedules/:scheduleName/pause", veleroHandler.PauseSchedule, requireOperator)
+	veleroGroup.POST("/schedules/:scheduleName/unpause", veleroHandler.UnpauseSchedule, requireOperator)
 
 	// 복구 관련 라우트
 	veleroGroup.GET("/restores", veleroHandler.GetRestores)
 	veleroGroup.GET("/restores/:restoreName", veleroHandler.GetRestore)
-	veleroGroup.POST("/restores", veleroHandler.CreateRestore)
-	veleroGroup.DELETE("/restores/:restoreName", veleroHandler.DeleteRestore)
+	veleroGroup.GET("/restores/:restoreName/logs", veleroHandler.GetRestoreLogs)
+	veleroGroup.GET("/restores/:restoreName/results", veleroHandler.GetRestoreResults)
+	veleroGroup.POST("/restores", veleroHandler.CreateRestore, requireOperator)
+	veleroGroup.DELETE("/restores/:restoreName", veleroHandler.DeleteRestore, requireAdmin)
 	veleroGroup.POST("/restores/:restoreName/validate", veleroHandler.ValidateRestore)
 
 	// Velero 리소스 조회 라우트
 	veleroGroup.GET("/repositories", veleroHandler.GetBackupRepositories)
+	veleroGroup.GET("/pod-volume-restores", veleroHandler.GetPodVolumeRestores)
+
+	// 스토리지 위치 관련 라우트
 	veleroGroup.GET("/storage-locations", veleroHandler.GetBackupStorageLocations)
+	veleroGroup.GET("/storage-locations/:name", veleroHandler.GetBackupStorageLocation)
+	veleroGroup.POST("/storage-locations", veleroHandler.CreateBackupStorageLocation, requireOperator)
+	veleroGroup.PUT("/storage-locations/:name", veleroHandler.UpdateBackupStorageLocation, requireOperator)
+	veleroGroup.DELETE("/storage-locations/:name", veleroHandler.DeleteBackupStorageLocation, requireAdmin)
+	veleroGroup.POST("/storage-locations/:name/default", veleroHandler.SetDefaultBackupStorageLocation, requireOperator)
+	veleroGroup.POST("/storage-locations/:name/access-mode", veleroHandler.SetBackupStorageLocationAccessMode, requireOperator)
 	veleroGroup.GET("/volume-snapshot-locations", veleroHandler.GetVolumeSnapshotLocations)
-	veleroGroup.GET("/pod-volume-restores", veleroHandler.GetPodVolumeRestores)
+	veleroGroup.GET("/volume-snapshot-locations/:name", veleroHandler.GetVolumeSnapshotLocation)
+	veleroGroup.POST("/volume-snapshot-locations", veleroHandler.CreateVolumeSnapshotLocation, requireOperator)
+	veleroGroup.PUT("/volume-snapshot-locations/:name", veleroHandler.UpdateVolumeSnapshotLocation, requireOperator)
+	veleroGroup.DELETE("/volume-snapshot-locations/:name", veleroHandler.DeleteVolumeSnapshotLocation, requireAdmin)
 
 	// 비동기 작업 관리 라우트
-	veleroGroup.GET("/status/:jobId", veleroHandler.GetJobStatus) // 작업 상태 조회
-	veleroGroup.GET("/logs/:jobId", veleroHandler.GetJobLogs)     // 작업 로그 조회
-	veleroGroup.GET("/jobs", veleroHandler.GetAllJobs)            // 모든 작업 조회
+	veleroGroup.GET("/status/:jobId", veleroHandler.GetJobStatus)                     // 작업 상태 조회
+	veleroGroup.GET("/logs/:jobId", veleroHandler.GetJobLogs)                         // 작업 로그 조회
+	veleroGroup.GET("/jobs", veleroHandler.GetAllJobs)                                // 모든 작업 조회
+	veleroGroup.POST("/jobs/:jobId/cancel", veleroHandler.CancelJob, requireOperator) // 작업 취소
+	veleroGroup.GET("/jobs/:jobId/stream", veleroHandler.StreamJob)                   // 작업 진행 스트리밍 (SSE)
 }
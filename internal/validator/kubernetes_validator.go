@@ -1,7 +1,9 @@
 package validator
 
 import (
+	"crypto/sha256"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"regexp"
 	"strings"
@@ -58,6 +60,14 @@ func DecodeIfBase64(s string) (string, error) {
 	return string(decoded), nil
 }
 
+// KubeConfigFingerprint : kubeconfig를 식별하는 SHA-256 앞 16자리 (base64 여부와 무관하게 같은 값)
+// 감사 로그와 메트릭에서 원문 대신 클러스터를 구분하는 데 사용
+func KubeConfigFingerprint(kubeConfig string) string {
+	decoded, _ := DecodeIfBase64(kubeConfig)
+	sum := sha256.Sum256([]byte(decoded))
+	return hex.EncodeToString(sum[:])[:16]
+}
+
 // isValidNamespace : 네임스페이스 검증
 func (v *KubernetesValidator) isValidNamespace(namespace string) bool {
 	if len(namespace) == 0 || len(namespace) > 63 {
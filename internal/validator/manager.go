@@ -1,17 +1,24 @@
 package validator
 
 import (
+	"encoding/pem"
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/labstack/echo/v4"
 	"github.com/taking/kubemigrate/internal/response"
 	"github.com/taking/kubemigrate/pkg/config"
 )
 
+// bucketNamePattern : S3 버킷 이름 규칙
+var bucketNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$`)
+
 // ValidationManager : 통합 검증 관리자
 type ValidationManager struct {
 	kubernetesValidator *KubernetesValidator
 	minioValidator      *MinioValidator
+	scheduleValidator   *ScheduleValidator
 }
 
 // NewValidationManager : 새로운 검증 관리자 생성
@@ -19,6 +26,7 @@ func NewValidationManager() *ValidationManager {
 	return &ValidationManager{
 		kubernetesValidator: NewKubernetesValidator(),
 		minioValidator:      NewMinioValidator(),
+		scheduleValidator:   NewScheduleValidator(),
 	}
 }
 
@@ -32,6 +40,11 @@ func (vm *ValidationManager) GetMinioValidator() *MinioValidator {
 	return vm.minioValidator
 }
 
+// GetScheduleValidator : Schedule 검증자 반환
+func (vm *ValidationManager) GetScheduleValidator() *ScheduleValidator {
+	return vm.scheduleValidator
+}
+
 // ValidateKubeConfig : Kubernetes 설정 검증
 func (vm *ValidationManager) ValidateKubeConfig(kubeConfig *config.KubeConfig) error {
 	_, err := vm.kubernetesValidator.ValidateKubernetesConfig(kubeConfig)
@@ -43,6 +56,11 @@ func (vm *ValidationManager) ValidateMinioConfig(minioConfig *config.MinioConfig
 	return vm.minioValidator.ValidateMinioConfig(minioConfig)
 }
 
+// ValidateCronExpression : Velero Schedule cron 표현식 검증
+func (vm *ValidationManager) ValidateCronExpression(expr string) error {
+	return vm.scheduleValidator.ValidateCronExpression(expr)
+}
+
 // ValidateVeleroConfig : Velero 설정 검증
 func (vm *ValidationManager) ValidateVeleroConfig(veleroConfig *config.VeleroConfig) error {
 	// MinIO 설정 검증
@@ -92,6 +110,23 @@ func (vm *ValidationManager) ValidateUpgradeChartConfig(upgradeConfig *config.Up
 	return nil
 }
 
+// ValidateRollbackChartConfig : Helm 차트 롤백 설정 검증
+func (vm *ValidationManager) ValidateRollbackChartConfig(rollbackConfig *config.RollbackChartConfig) error {
+	if rollbackConfig.ReleaseName == "" {
+		return fmt.Errorf("release name is required")
+	}
+
+	if rollbackConfig.Namespace == "" {
+		return fmt.Errorf("namespace is required")
+	}
+
+	if rollbackConfig.Revision < 0 {
+		return fmt.Errorf("revision must be 0 (previous revision) or a positive number, got %d", rollbackConfig.Revision)
+	}
+
+	return nil
+}
+
 // ValidateAll : 모든 설정 검증
 func (vm *ValidationManager) ValidateAll(configs map[string]interface{}) error {
 	var errors []error
@@ -188,9 +223,53 @@ func (vm *ValidationManager) ValidateVeleroConfigInternal(c echo.Context, servic
 		return veleroConfig, vm.HandleValidationError(c, serviceName, "kubernetes config validation", err)
 	}
 
+	// 설치 프로필 검증
+	if veleroConfig.Install != nil {
+		if err := vm.ValidateVeleroInstallOptions(veleroConfig.Install); err != nil {
+			return veleroConfig, vm.HandleValidationError(c, serviceName, "install options validation", err)
+		}
+	}
+
 	return veleroConfig, nil
 }
 
+// ValidateVeleroInstallOptions : Velero 설치 프로필 검증
+func (vm *ValidationManager) ValidateVeleroInstallOptions(opts *config.VeleroInstallOptions) error {
+	if opts.Bucket != "" && !bucketNamePattern.MatchString(opts.Bucket) {
+		return fmt.Errorf("invalid bucket name %q: must be 3-63 lowercase letters, numbers, dots or hyphens", opts.Bucket)
+	}
+
+	if strings.HasPrefix(opts.Prefix, "/") || strings.HasSuffix(opts.Prefix, "/") {
+		return fmt.Errorf("invalid prefix %q: must not start or end with '/'", opts.Prefix)
+	}
+
+	for field, value := range map[string]string{
+		"chartVersion":   opts.ChartVersion,
+		"image":          opts.Image,
+		"imageTag":       opts.ImageTag,
+		"awsPluginImage": opts.AWSPluginImage,
+		"kubectlImage":   opts.KubectlImage,
+		"region":         opts.Region,
+	} {
+		if strings.ContainsAny(value, " \t\n") {
+			return fmt.Errorf("invalid %s %q: must not contain whitespace", field, value)
+		}
+	}
+
+	if opts.CACert != "" {
+		block, _ := pem.Decode([]byte(opts.CACert))
+		if block == nil || block.Type != "CERTIFICATE" {
+			return fmt.Errorf("caCert must be a PEM encoded certificate bundle")
+		}
+	}
+
+	if opts.NodeAgent != nil && !*opts.NodeAgent && opts.DefaultVolumesToFsBackup != nil && *opts.DefaultVolumesToFsBackup {
+		return fmt.Errorf("defaultVolumesToFsBackup requires nodeAgent to be enabled")
+	}
+
+	return nil
+}
+
 // HandleValidationError : 검증 에러 처리
 func (vm *ValidationManager) HandleValidationError(c echo.Context, serviceName, operation string, err error) error {
 	return response.RespondWithErrorModel(c, 400, "VALIDATION_FAILED",
@@ -0,0 +1,54 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// TestValidationManager_ValidateVeleroInstallOptions - Velero 설치 프로필 검증 테스트
+func TestValidationManager_ValidateVeleroInstallOptions(t *testing.T) {
+	vm := NewValidationManager()
+	disabled := false
+	enabled := true
+
+	tests := []struct {
+		name    string
+		opts    config.VeleroInstallOptions
+		wantErr bool
+	}{
+		{name: "빈 프로필 (기본값 사용)", opts: config.VeleroInstallOptions{}, wantErr: false},
+		{
+			name: "에어갭 프로필",
+			opts: config.VeleroInstallOptions{
+				ChartURL:       "oci://registry.local/charts/velero",
+				Image:          "registry.local/velero/velero",
+				ImageTag:       "v1.17.0",
+				AWSPluginImage: "registry.local/velero/velero-plugin-for-aws:v1.13.0",
+				Bucket:         "cluster-backups",
+				Prefix:         "cluster-a/daily",
+				CACert:         "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n",
+			},
+			wantErr: false,
+		},
+		{name: "대문자 버킷 이름", opts: config.VeleroInstallOptions{Bucket: "Backups"}, wantErr: true},
+		{name: "짧은 버킷 이름", opts: config.VeleroInstallOptions{Bucket: "ab"}, wantErr: true},
+		{name: "슬래시로 시작하는 접두사", opts: config.VeleroInstallOptions{Prefix: "/cluster-a"}, wantErr: true},
+		{name: "공백이 포함된 이미지 태그", opts: config.VeleroInstallOptions{ImageTag: "v1.17 .0"}, wantErr: true},
+		{name: "PEM이 아닌 CA 번들", opts: config.VeleroInstallOptions{CACert: "not a certificate"}, wantErr: true},
+		{
+			name:    "node-agent 없이 파일 시스템 백업",
+			opts:    config.VeleroInstallOptions{NodeAgent: &disabled, DefaultVolumesToFsBackup: &enabled},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := vm.ValidateVeleroInstallOptions(&tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateVeleroInstallOptions() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/robfig/cron/v3"
+)
+
+// ScheduleValidator : Velero Schedule cron 표현식 검증자
+// Velero 스케줄 컨트롤러와 같은 robfig/cron 표준 파서(cron.ParseStandard)를 사용하므로
+// 5필드 표현식, @descriptor, @every, CRON_TZ/TZ 접두사 허용 범위가 Velero와 일치합니다.
+type ScheduleValidator struct{}
+
+// NewScheduleValidator : ScheduleValidator 초기화
+func NewScheduleValidator() *ScheduleValidator {
+	return &ScheduleValidator{}
+}
+
+// ValidateCronExpression : cron 표현식 검증
+func (v *ScheduleValidator) ValidateCronExpression(expr string) (err error) {
+	expr = strings.TrimSpace(expr)
+	if expr == "" {
+		return fmt.Errorf("schedule is required")
+	}
+
+	// robfig/cron v3.0.1은 "CRON_TZ=UTC"처럼 표현식 없이 타임존만 있으면 panic이 발생하므로 에러로 변환
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("invalid schedule %q: %v", expr, r)
+		}
+	}()
+
+	if _, err := cron.ParseStandard(expr); err != nil {
+		return fmt.Errorf("invalid schedule %q: %w", expr, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package validator
+
+import "testing"
+
+// TestScheduleValidator_ValidateCronExpression - cron 표현식 검증 테스트
+// Velero Schedule에서 허용하는 표준 표현식, descriptor, 타임존 접두사 검증
+func TestScheduleValidator_ValidateCronExpression(t *testing.T) {
+	v := NewScheduleValidator()
+
+	tests := []struct {
+		name    string
+		expr    string
+		wantErr bool
+	}{
+		{name: "매일 새벽 2시", expr: "0 2 * * *", wantErr: false},
+		{name: "15분 간격", expr: "*/15 * * * *", wantErr: false},
+		{name: "범위와 목록", expr: "0 9-18/3 1,15 * MON-FRI", wantErr: false},
+		{name: "월 이름", expr: "30 1 1 jan,jul ?", wantErr: false},
+		{name: "descriptor", expr: "@daily", wantErr: false},
+		{name: "@every 간격", expr: "@every 6h", wantErr: false},
+		{name: "타임존 접두사", expr: "CRON_TZ=UTC 0 3 * * *", wantErr: false},
+		{name: "1초 미만 @every 간격", expr: "@every 500ms", wantErr: false},
+		{name: "빈 표현식", expr: "", wantErr: true},
+		{name: "필드 개수 부족", expr: "0 2 * *", wantErr: true},
+		{name: "초 단위 필드 포함", expr: "0 0 2 * * *", wantErr: true},
+		{name: "분 범위 초과", expr: "60 * * * *", wantErr: true},
+		{name: "요일 범위 초과", expr: "0 0 * * 7", wantErr: true},
+		{name: "역순 범위", expr: "0 18-9 * * *", wantErr: true},
+		{name: "잘못된 간격", expr: "*/0 * * * *", wantErr: true},
+		{name: "알 수 없는 descriptor", expr: "@sometimes", wantErr: true},
+		{name: "잘못된 @every 간격", expr: "@every soon", wantErr: true},
+		{name: "잘못된 타임존", expr: "CRON_TZ=Nowhere/City 0 3 * * *", wantErr: true},
+		{name: "타임존 뒤 표현식 누락", expr: "CRON_TZ=UTC", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := v.ValidateCronExpression(tt.expr)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateCronExpression(%q) error = %v, wantErr %v", tt.expr, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -76,46 +76,54 @@ func (c *client) Minio() minio.Client {
 	return c.minio
 }
 
-// createClientWithRetry 설정에 따라 클라이언트를 생성하고 실패 시 fallback 사용
+// createClientWithRetry : 설정에 따라 클라이언트 생성
+// 설정이 주어지면 해당 설정으로만 생성하며, 실패 시 기본 환경(in-cluster 등)으로 대체하지 않고 ConnectionError 반환
+// 설정이 nil이면 기본 환경으로 생성을 시도하고, 기본 환경도 없으면 해당 서비스 클라이언트는 nil (요청에서 사용하지 않는 서비스)
 func createClientWithRetry[T any, R any](
+	service string,
 	config interface{},
 	creator func(T) (R, error),
 	fallbackCreator func() (R, error),
 ) (R, error) {
-	if config == nil {
-		// config가 nil이면 fallback 클라이언트 생성 시도
+	var zero R
+
+	var typedConfig T
+	switch cfg := config.(type) {
+	case nil:
 		if fallback, err := fallbackCreator(); err == nil {
 			return fallback, nil
 		}
-		// fallback도 실패하면 에러 반환
-		var zero R
-		return zero, fmt.Errorf("failed to create client: both config and fallback creation failed")
-	}
-
-	// 포인터 타입 처리
-	if ptr, ok := config.(*T); ok {
-		if client, err := creator(*ptr); err == nil {
-			return client, nil
+		return zero, nil
+	case *T:
+		if cfg == nil {
+			if fallback, err := fallbackCreator(); err == nil {
+				return fallback, nil
+			}
+			return zero, nil
 		}
-	} else if typedConfig, ok := config.(T); ok {
-		if client, err := creator(typedConfig); err == nil {
-			return client, nil
+		typedConfig = *cfg
+	case T:
+		typedConfig = cfg
+	default:
+		return zero, &ConnectionError{
+			Service: service,
+			Kind:    ConnectionInvalidConfig,
+			Err:     fmt.Errorf("unsupported configuration type %T", config),
 		}
 	}
 
-	// 설정된 클라이언트 생성 실패 시 fallback 클라이언트 생성 시도
-	if fallback, err := fallbackCreator(); err == nil {
-		return fallback, nil
+	client, err := creator(typedConfig)
+	if err != nil {
+		return zero, newConfigError(service, err)
 	}
-
-	// fallback도 실패하면 에러 반환
-	var zero R
-	return zero, fmt.Errorf("failed to create client: both config and fallback creation failed")
+	return client, nil
 }
 
 // NewClientWithConfig : 설정을 사용하여 새로운 통합 클라이언트를 생성합니다
+// 설정 오류는 *ConnectionError로 반환하며, nil로 전달한 서비스는 기본 환경이 없으면 nil 클라이언트가 됩니다
 func NewClientWithConfig(kubeConfig, helmConfig, veleroConfig, minioConfig interface{}) (Client, error) {
 	kubeClient, err := createClientWithRetry[config.KubeConfig, kubernetes.Client]( //nolint:typecheck
+		"kubernetes",
 		kubeConfig,
 		kubernetes.NewClientWithConfig,
 		kubernetes.NewClient,
@@ -125,6 +133,7 @@ func NewClientWithConfig(kubeConfig, helmConfig, veleroConfig, minioConfig inter
 	}
 
 	helmClient, err := createClientWithRetry[config.KubeConfig, helm.Client]( //nolint:typecheck
+		"helm",
 		helmConfig,
 		helm.NewClientWithConfig,
 		helm.NewClient,
@@ -134,6 +143,7 @@ func NewClientWithConfig(kubeConfig, helmConfig, veleroConfig, minioConfig inter
 	}
 
 	veleroClient, err := createClientWithRetry[config.VeleroConfig, velero.Client]( //nolint:typecheck
+		"velero",
 		veleroConfig,
 		velero.NewClientWithConfig,
 		velero.NewClient,
@@ -143,6 +153,7 @@ func NewClientWithConfig(kubeConfig, helmConfig, veleroConfig, minioConfig inter
 	}
 
 	minioClient, err := createClientWithRetry[config.MinioConfig, minio.Client]( //nolint:typecheck
+		"minio",
 		minioConfig,
 		minio.NewClientWithConfig,
 		minio.NewClient,
@@ -0,0 +1,150 @@
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+
+	"github.com/minio/minio-go/v7"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// ConnectionErrorKind : 연결 에러 유형
+type ConnectionErrorKind string
+
+// 연결 에러 유형 목록
+const (
+	ConnectionInvalidConfig ConnectionErrorKind = "INVALID_CONFIG" // kubeconfig/MinIO 설정 오류
+	ConnectionAuthFailed    ConnectionErrorKind = "AUTH_FAILED"    // 자격 증명 거부
+	ConnectionForbidden     ConnectionErrorKind = "FORBIDDEN"      // 권한 부족
+	ConnectionTLSError      ConnectionErrorKind = "TLS_ERROR"      // 인증서 검증 실패
+	ConnectionUnreachable   ConnectionErrorKind = "UNREACHABLE"    // 연결 거부, DNS 조회 실패 등
+	ConnectionTimeout       ConnectionErrorKind = "TIMEOUT"        // 응답 시간 초과
+)
+
+// ConnectionError : 클러스터/스토리지 연결 실패 에러
+type ConnectionError struct {
+	Service string // kubernetes, helm, velero, minio
+	Kind    ConnectionErrorKind
+	Err     error
+}
+
+// Error : 에러 메시지
+func (e *ConnectionError) Error() string {
+	return fmt.Sprintf("%s connection failed (%s): %v", e.Service, e.Kind, e.Err)
+}
+
+// Unwrap : 원본 에러 반환
+func (e *ConnectionError) Unwrap() error {
+	return e.Err
+}
+
+// StatusCode : 에러 유형별 HTTP 상태 코드
+func (e *ConnectionError) StatusCode() int {
+	switch e.Kind {
+	case ConnectionInvalidConfig:
+		return http.StatusBadRequest
+	case ConnectionAuthFailed:
+		return http.StatusUnauthorized
+	case ConnectionForbidden:
+		return http.StatusForbidden
+	case ConnectionTimeout:
+		return http.StatusGatewayTimeout
+	case ConnectionTLSError, ConnectionUnreachable:
+		return http.StatusBadGateway
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// ErrorCode : 에러 유형별 응답 코드 (예: CONNECTION_AUTH_FAILED)
+func (e *ConnectionError) ErrorCode() string {
+	return "CONNECTION_" + string(e.Kind)
+}
+
+// newConfigError : 클라이언트 생성 실패를 설정 오류로 래핑 (이미 분류된 에러는 유지)
+func newConfigError(service string, err error) error {
+	if classified := ClassifyConnectionError(service, err); classified != err {
+		return classified
+	}
+	return &ConnectionError{Service: service, Kind: ConnectionInvalidConfig, Err: err}
+}
+
+// ClassifyConnectionError : 인증 실패, 권한 부족, TLS 오류, 연결 불가, 시간 초과 에러를 ConnectionError로 분류
+// 연결 문제가 아닌 에러(리소스 없음 등)는 그대로 반환
+func ClassifyConnectionError(service string, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var connErr *ConnectionError
+	if errors.As(err, &connErr) {
+		return err
+	}
+
+	if kind, ok := classify(err); ok {
+		return &ConnectionError{Service: service, Kind: kind, Err: err}
+	}
+	return err
+}
+
+// classify : 에러 유형 판별
+func classify(err error) (ConnectionErrorKind, bool) {
+	switch {
+	case apierrors.IsUnauthorized(err):
+		return ConnectionAuthFailed, true
+	case apierrors.IsForbidden(err):
+		return ConnectionForbidden, true
+	}
+
+	switch minio.ToErrorResponse(err).Code {
+	case "InvalidAccessKeyId", "SignatureDoesNotMatch", "InvalidToken", "ExpiredToken":
+		return ConnectionAuthFailed, true
+	case "AccessDenied":
+		return ConnectionForbidden, true
+	}
+
+	var unknownAuthority x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certInvalid x509.CertificateInvalidError
+	var recordHeaderErr tls.RecordHeaderError
+	if errors.As(err, &unknownAuthority) || errors.As(err, &hostnameErr) ||
+		errors.As(err, &certInvalid) || errors.As(err, &recordHeaderErr) {
+		return ConnectionTLSError, true
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return ConnectionTimeout, true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return ConnectionTimeout, true
+	}
+
+	var dnsErr *net.DNSError
+	var opErr *net.OpError
+	if errors.As(err, &dnsErr) || errors.As(err, &opErr) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EHOSTUNREACH) {
+		return ConnectionUnreachable, true
+	}
+
+	// 일부 클라이언트는 원본 에러를 문자열로만 전달하므로 메시지로 보완
+	message := strings.ToLower(err.Error())
+	switch {
+	case strings.Contains(message, "x509:") || strings.Contains(message, "tls:"):
+		return ConnectionTLSError, true
+	case strings.Contains(message, "connection refused") || strings.Contains(message, "no such host") ||
+		strings.Contains(message, "no route to host"):
+		return ConnectionUnreachable, true
+	case strings.Contains(message, "i/o timeout") || strings.Contains(message, "deadline exceeded"):
+		return ConnectionTimeout, true
+	}
+
+	return "", false
+}
@@ -18,6 +18,8 @@ import (
 	"helm.sh/helm/v3/pkg/chart"
 	"helm.sh/helm/v3/pkg/chart/loader"
 	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/downloader"
+	"helm.sh/helm/v3/pkg/getter"
 	"helm.sh/helm/v3/pkg/release"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/rest"
@@ -36,22 +38,50 @@ type Client interface {
 	InstallChart(releaseName, chartURL, version, namespace string, values map[string]interface{}) error
 	UninstallChart(releaseName, namespace string, dryRun bool) error
 	UpgradeChart(releaseName, chartURL, version, namespace string, values map[string]interface{}) error
+	RollbackChart(ctx context.Context, releaseName, namespace string, revision int) error
 
 	// HealthCheck : Helm 연결 확인
 	HealthCheck(ctx context.Context) error
 }
 
+// defaultRollbackTimeout : 컨텍스트에 기한이 없을 때 롤백 리소스 Ready 대기 시간
+const defaultRollbackTimeout = 5 * time.Minute
+
 // helmClient : Helm 클라이언트
+// 릴리스 저장소(Secret/메모리 드라이버)는 네임스페이스 단위이므로 기본 네임스페이스 외 작업은 호출마다 설정을 생성
+// 캐시되어 여러 요청에서 공유되므로 생성 후 필드를 변경하지 않음
 type helmClient struct {
-	cfg       *action.Configuration
+	cfg       *action.Configuration // 기본 네임스페이스 설정 (전체 네임스페이스 조회 포함)
+	restCfg   *rest.Config
+	driver    string
 	namespace string
 }
 
+// newActionConfig : 지정한 네임스페이스의 릴리스 저장소를 사용하는 Helm action.Configuration 생성
+func newActionConfig(restCfg *rest.Config, namespace, driver string) (*action.Configuration, error) {
+	flags := genericclioptions.NewConfigFlags(false)
+	flags.Namespace = &namespace
+	flags.WrapConfigFn = func(_ *rest.Config) *rest.Config {
+		return restCfg
+	}
+
+	actionCfg := new(action.Configuration)
+	if err := actionCfg.Init(flags, namespace, driver, log.Printf); err != nil {
+		return nil, fmt.Errorf("failed to initialize helm client: %w", err)
+	}
+	return actionCfg, nil
+}
+
+// actionConfig : 네임스페이스에 맞는 Helm 설정 반환 (비어 있거나 기본 네임스페이스면 공유 설정 사용)
+func (h *helmClient) actionConfig(namespace string) (*action.Configuration, error) {
+	if namespace == "" || namespace == h.namespace {
+		return h.cfg, nil
+	}
+	return newActionConfig(h.restCfg, namespace, h.driver)
+}
+
 // NewClient : 새로운 Helm 클라이언트를 생성합니다 (기본 설정)
 func NewClient() (Client, error) {
-	// 기본 설정으로 클라이언트 생성
-	actionConfig := new(action.Configuration)
-
 	// 기본 네임스페이스 설정
 	namespace := "default"
 
@@ -62,19 +92,16 @@ func NewClient() (Client, error) {
 		restConfig = &rest.Config{}
 	}
 
-	flags := genericclioptions.NewConfigFlags(false)
-	flags.Namespace = &namespace
-	flags.WrapConfigFn = func(_ *rest.Config) *rest.Config {
-		return restConfig
-	}
-
 	// Helm action.Configuration 초기화
-	if err := actionConfig.Init(flags, namespace, "memory", log.Printf); err != nil {
-		return nil, fmt.Errorf("failed to initialize helm client: %w", err)
+	actionConfig, err := newActionConfig(restConfig, namespace, "memory")
+	if err != nil {
+		return nil, err
 	}
 
 	return &helmClient{
 		cfg:       actionConfig,
+		restCfg:   restConfig,
+		driver:    "memory",
 		namespace: namespace,
 	}, nil
 }
@@ -105,21 +132,16 @@ func NewClientWithConfig(cfg config.KubeConfig) (Client, error) {
 
 	ns := getNamespaceWithDefault(cfg.Namespace, "default")
 
-	// genericclioptions.ConfigFlags 생성
-	flags := genericclioptions.NewConfigFlags(false)
-	flags.Namespace = &ns
-	flags.WrapConfigFn = func(_ *rest.Config) *rest.Config {
-		return restCfg
-	}
-
 	// Helm action.Configuration 초기화
-	actionCfg := new(action.Configuration)
-	if err := actionCfg.Init(flags, ns, "secret", log.Printf); err != nil {
-		return nil, fmt.Errorf("failed to initialize helm client: %w", err)
+	actionCfg, err := newActionConfig(restCfg, ns, "secret")
+	if err != nil {
+		return nil, err
 	}
 
 	return &helmClient{
 		cfg:       actionCfg,
+		restCfg:   restCfg,
+		driver:    "secret",
 		namespace: ns,
 	}, nil
 }
@@ -147,9 +169,12 @@ func (h *helmClient) GetCharts(ctx context.Context, namespace string) ([]*releas
 
 // GetChart : Helm 차트 조회
 func (h *helmClient) GetChart(ctx context.Context, releaseName, namespace string, releaseVersion int) (*release.Release, error) {
-	h.namespace = namespace
+	cfg, err := h.actionConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	get := action.NewGet(h.cfg)
+	get := action.NewGet(cfg)
 	if releaseVersion != 0 {
 		get.Version = releaseVersion
 	}
@@ -163,9 +188,12 @@ func (h *helmClient) GetChart(ctx context.Context, releaseName, namespace string
 
 // GetValues : Helm 차트의 현재 values 조회
 func (h *helmClient) GetValues(ctx context.Context, releaseName, namespace string) (map[string]interface{}, error) {
-	h.namespace = namespace
+	cfg, err := h.actionConfig(namespace)
+	if err != nil {
+		return nil, err
+	}
 
-	getValues := action.NewGetValues(h.cfg)
+	getValues := action.NewGetValues(cfg)
 	getValues.AllValues = true // 모든 values 포함 (기본값 + 사용자 설정값)
 
 	values, err := getValues.Run(releaseName)
@@ -197,12 +225,17 @@ func (h *helmClient) IsChartInstalled(releaseName string) (bool, *release.Releas
 
 // InstallChart : Helm 차트를 URL에서 설치 (버전 지원, OCI 지원)
 func (h *helmClient) InstallChart(releaseName, chartURL, version, namespace string, values map[string]interface{}) error {
-	// chartURL이 URL인지 확인 (HTTP/HTTPS/OCI 지원)
-	if !strings.HasPrefix(chartURL, "http://") && !strings.HasPrefix(chartURL, "https://") && !strings.HasPrefix(chartURL, "oci://") {
-		return fmt.Errorf("chartURL must be a valid HTTP/HTTPS/OCI URL, got: %s", chartURL)
+	// chartURL 확인 (HTTP/HTTPS/OCI URL 또는 "repo/chart" 저장소 참조)
+	if err := validateChartRef(chartURL); err != nil {
+		return err
+	}
+
+	cfg, err := h.actionConfig(namespace)
+	if err != nil {
+		return err
 	}
 
-	install := action.NewInstall(h.cfg)
+	install := action.NewInstall(cfg)
 	install.ReleaseName = releaseName
 	install.Namespace = namespace
 	install.Version = version
@@ -238,13 +271,27 @@ func (h *helmClient) InstallChart(releaseName, chartURL, version, namespace stri
 	return nil
 }
 
-// loadChartFromURL : URL에서 차트를 다운로드하고 로드 (OCI 지원)
+// validateChartRef : 차트 참조 형식 확인
+func validateChartRef(chartURL string) error {
+	if strings.HasPrefix(chartURL, "http://") || strings.HasPrefix(chartURL, "https://") ||
+		strings.HasPrefix(chartURL, "oci://") || isRepositoryReference(chartURL) {
+		return nil
+	}
+	return fmt.Errorf("chartURL must be a valid HTTP/HTTPS/OCI URL or a repo/chart reference, got: %s", chartURL)
+}
+
+// loadChartFromURL : URL에서 차트를 다운로드하고 로드 (OCI, 저장소 참조 지원)
 func (h *helmClient) loadChartFromURL(chartURL, version string) (*chart.Chart, error) {
 	// OCI URL인 경우 별도 처리
 	if strings.HasPrefix(chartURL, "oci://") {
 		return h.loadChartFromOCI(chartURL, version)
 	}
 
+	// 등록된 저장소의 차트 참조 ("repo/chart")
+	if isRepositoryReference(chartURL) {
+		return h.loadChartFromRepository(chartURL, version)
+	}
+
 	// HTTP/HTTPS URL 처리
 	// 임시 디렉토리 생성
 	tmpDir, err := os.MkdirTemp("", "helm-chart-*")
@@ -280,8 +327,15 @@ func (h *helmClient) loadChartFromOCI(chartURL, version string) (*chart.Chart, e
 	// 환경 설정 생성
 	settings := cli.New()
 
-	// OCI 차트 Pull 액션 생성 (WithConfig 옵션 사용)
+	// 레지스트리 로그인 정보를 사용하는 클라이언트 설정
+	registryClient, err := newRegistryClient(settings, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// OCI 차트 Pull 액션 생성 (WithConfig 옵션 사용, version은 semver 제약 조건 지원)
 	pull := action.NewPullWithOpts(action.WithConfig(h.cfg))
+	pull.SetRegistryClient(registryClient)
 	pull.DestDir = tmpDir
 	pull.Version = version
 	pull.Settings = settings
@@ -322,6 +376,47 @@ func (h *helmClient) loadChartFromOCI(chartURL, version string) (*chart.Chart, e
 	return chart, nil
 }
 
+// loadChartFromRepository : 등록된 저장소에서 차트를 로드 (version은 semver 제약 조건 지원, 비어있으면 최신 정식 버전)
+func (h *helmClient) loadChartFromRepository(chartRef, version string) (*chart.Chart, error) {
+	// 임시 디렉토리 생성
+	tmpDir, err := os.MkdirTemp("", "helm-repo-chart-*")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(tmpDir) }()
+
+	// 환경 설정 생성 (저장소 목록/인덱스 캐시 경로)
+	settings := cli.New()
+
+	registryClient, err := newRegistryClient(settings, false)
+	if err != nil {
+		return nil, err
+	}
+
+	dl := downloader.ChartDownloader{
+		Out:              io.Discard,
+		Verify:           downloader.VerifyNever,
+		Getters:          getter.All(settings),
+		RegistryClient:   registryClient,
+		RepositoryConfig: settings.RepositoryConfig,
+		RepositoryCache:  settings.RepositoryCache,
+	}
+
+	// 저장소 인덱스에서 버전을 결정하고 차트 다운로드
+	chartPath, _, err := dl.DownloadTo(chartRef, version, tmpDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download chart %s (version: %q): %w", chartRef, version, err)
+	}
+
+	// 차트 로드
+	chart, err := loader.Load(chartPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart from %s: %w", chartPath, err)
+	}
+
+	return chart, nil
+}
+
 // downloadChart : 차트를 다운로드
 func (h *helmClient) downloadChart(chartURL, version, destDir string) (string, error) {
 	// HTTP 클라이언트 생성
@@ -373,8 +468,10 @@ func (h *helmClient) downloadChart(chartURL, version, destDir string) (string, e
 
 // UninstallChart : Helm 차트 삭제
 func (h *helmClient) UninstallChart(releaseName, namespace string, dryRun bool) error {
-	// 네임스페이스 설정
-	h.namespace = namespace
+	cfg, err := h.actionConfig(namespace)
+	if err != nil {
+		return err
+	}
 
 	// 설치 여부 확인
 	installed, _, err := h.IsChartInstalled(releaseName)
@@ -385,7 +482,7 @@ func (h *helmClient) UninstallChart(releaseName, namespace string, dryRun bool)
 		return fmt.Errorf("chart '%s' is not installed (namespace: %s)", releaseName, namespace)
 	}
 
-	uninstall := action.NewUninstall(h.cfg)
+	uninstall := action.NewUninstall(cfg)
 	uninstall.DryRun = dryRun
 
 	_, err = uninstall.Run(releaseName)
@@ -407,12 +504,17 @@ func (h *helmClient) UninstallChart(releaseName, namespace string, dryRun bool)
 
 // UpgradeChart : Helm 차트 업그레이드 (OCI 지원)
 func (h *helmClient) UpgradeChart(releaseName, chartURL, version, namespace string, values map[string]interface{}) error {
-	// chartURL이 URL인지 확인 (HTTP/HTTPS/OCI 지원)
-	if !strings.HasPrefix(chartURL, "http://") && !strings.HasPrefix(chartURL, "https://") && !strings.HasPrefix(chartURL, "oci://") {
-		return fmt.Errorf("chartURL must be a valid HTTP/HTTPS/OCI URL, got: %s", chartURL)
+	// chartURL 확인 (HTTP/HTTPS/OCI URL 또는 "repo/chart" 저장소 참조)
+	if err := validateChartRef(chartURL); err != nil {
+		return err
 	}
 
-	upgrade := action.NewUpgrade(h.cfg)
+	cfg, err := h.actionConfig(namespace)
+	if err != nil {
+		return err
+	}
+
+	upgrade := action.NewUpgrade(cfg)
 	upgrade.Namespace = namespace
 
 	// URL에서 차트 다운로드 및 로드 (OCI 지원)
@@ -433,6 +535,45 @@ func (h *helmClient) UpgradeChart(releaseName, chartURL, version, namespace stri
 	return nil
 }
 
+// RollbackChart : Helm 릴리스를 지정한 리비전으로 롤백 (0이면 직전 리비전)
+// 리소스 Ready 대기 시간은 컨텍스트 기한을 따르며, 컨텍스트가 취소되면 대기를 중단하고 반환
+// (Helm 롤백 액션은 컨텍스트를 받지 않으므로 진행 중인 대기는 Timeout까지 백그라운드에서 끝남)
+func (h *helmClient) RollbackChart(ctx context.Context, releaseName, namespace string, revision int) error {
+	cfg, err := h.actionConfig(namespace)
+	if err != nil {
+		return err
+	}
+
+	rollback := action.NewRollback(cfg)
+	rollback.Version = revision
+	rollback.Wait = true // 롤백된 리소스가 Ready 상태가 될 때까지 대기
+	rollback.Timeout = defaultRollbackTimeout
+	if deadline, ok := ctx.Deadline(); ok {
+		rollback.Timeout = time.Until(deadline)
+	}
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("rollback of release '%s' not started (namespace: %s): %w", releaseName, namespace, err)
+	}
+	if rollback.Timeout <= 0 {
+		return fmt.Errorf("rollback of release '%s' not started (namespace: %s): %w", releaseName, namespace, context.DeadlineExceeded)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- rollback.Run(releaseName)
+	}()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return fmt.Errorf("failed to rollback release '%s' to revision %d (namespace: %s): %w", releaseName, revision, namespace, err)
+		}
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("rollback of release '%s' interrupted (namespace: %s): %w", releaseName, namespace, ctx.Err())
+	}
+}
+
 // getNamespaceWithDefault : 네임스페이스가 설정되어 있으면 사용, 없으면 기본값 반환
 func getNamespaceWithDefault(namespace, defaultNS string) string {
 	if namespace != "" {
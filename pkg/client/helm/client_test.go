@@ -0,0 +1,56 @@
+package helm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"k8s.io/client-go/rest"
+)
+
+// newTestClient : 연결하지 않는 API 서버 주소로 메모리 드라이버 클라이언트 생성
+func newTestClient(t *testing.T) *helmClient {
+	t.Helper()
+	restCfg := &rest.Config{Host: "https://127.0.0.1:1"}
+	cfg, err := newActionConfig(restCfg, "default", "memory")
+	if err != nil {
+		t.Fatalf("newActionConfig() error = %v", err)
+	}
+	return &helmClient{cfg: cfg, restCfg: restCfg, driver: "memory", namespace: "default"}
+}
+
+// TestHelmClient_ActionConfig : 기본 네임스페이스는 공유 설정, 그 외 네임스페이스는 별도 설정 사용 테스트
+func TestHelmClient_ActionConfig(t *testing.T) {
+	h := newTestClient(t)
+
+	for _, namespace := range []string{"", "default"} {
+		cfg, err := h.actionConfig(namespace)
+		if err != nil || cfg != h.cfg {
+			t.Errorf("actionConfig(%q) should reuse the shared configuration (err: %v)", namespace, err)
+		}
+	}
+
+	cfg, err := h.actionConfig("velero")
+	if err != nil {
+		t.Fatalf("actionConfig() error = %v", err)
+	}
+	if cfg == h.cfg || cfg.Releases == h.cfg.Releases {
+		t.Error("actionConfig() should build a separate release store for another namespace")
+	}
+	if h.namespace != "default" {
+		t.Errorf("Shared client namespace should not change, got %s", h.namespace)
+	}
+}
+
+// TestHelmClient_RollbackChartCancelled : 취소된 컨텍스트로 롤백 시 즉시 반환 테스트
+func TestHelmClient_RollbackChartCancelled(t *testing.T) {
+	h := newTestClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := h.RollbackChart(ctx, "velero", "velero", 1)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
@@ -0,0 +1,432 @@
+package helm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/Masterminds/semver/v3"
+	"helm.sh/helm/v3/pkg/cli"
+	"helm.sh/helm/v3/pkg/getter"
+	"helm.sh/helm/v3/pkg/helmpath"
+	"helm.sh/helm/v3/pkg/registry"
+	"helm.sh/helm/v3/pkg/repo"
+)
+
+// ErrRepositoryNotFound : 등록되지 않은 저장소
+var ErrRepositoryNotFound = errors.New("repository not found")
+
+// RepositoryManager : Helm 차트 저장소 및 OCI 레지스트리 인증 관리 인터페이스
+// 저장소 목록, 인덱스 캐시, 레지스트리 인증 정보는 Helm CLI와 같은 경로에 저장됩니다
+// (HELM_REPOSITORY_CONFIG, HELM_REPOSITORY_CACHE, HELM_REGISTRY_CONFIG 환경변수로 변경 가능)
+type RepositoryManager interface {
+	// 저장소 관리
+	AddRepository(entry RepositoryEntry) (*RepositoryInfo, error)
+	UpdateRepositories(names ...string) ([]RepositoryInfo, error)
+	ListRepositories() ([]RepositoryInfo, error)
+	RemoveRepository(name string) error
+
+	// SearchCharts : 캐시된 인덱스에서 차트 검색 (keyword가 비어있으면 전체, versionConstraint는 semver 제약 조건)
+	SearchCharts(keyword, versionConstraint string, allVersions bool) ([]ChartSearchResult, error)
+
+	// OCI 레지스트리 인증
+	LoginRegistry(host, username, password string, insecure bool) error
+	LogoutRegistry(host string) error
+}
+
+// RepositoryEntry : 차트 저장소 등록 정보
+type RepositoryEntry struct {
+	Name                  string `json:"name" example:"bitnami"`
+	URL                   string `json:"url" example:"https://charts.bitnami.com/bitnami"`
+	Username              string `json:"username,omitempty"`
+	Password              string `json:"password,omitempty"`
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify,omitempty"`
+	PassCredentialsAll    bool   `json:"passCredentialsAll,omitempty"`
+}
+
+// RepositoryInfo : 등록된 차트 저장소 정보 (인증 정보 제외)
+type RepositoryInfo struct {
+	Name           string    `json:"name"`
+	URL            string    `json:"url"`
+	HasCredentials bool      `json:"hasCredentials"`
+	ChartCount     int       `json:"chartCount"`
+	IndexUpdatedAt time.Time `json:"indexUpdatedAt,omitempty"`
+	Error          string    `json:"error,omitempty"` // 인덱스 캐시 조회/갱신 실패 사유
+}
+
+// ChartSearchResult : 차트 검색 결과
+type ChartSearchResult struct {
+	Name        string `json:"name"` // "repo/chart" (설치 시 chartURL로 사용)
+	Repository  string `json:"repository"`
+	Chart       string `json:"chart"`
+	Version     string `json:"version"`
+	AppVersion  string `json:"appVersion,omitempty"`
+	Description string `json:"description,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
+}
+
+// repositoryManager : Helm 차트 저장소 관리자
+type repositoryManager struct {
+	settings *cli.EnvSettings
+	mutex    sync.Mutex // repositories.yaml 동시 수정 방지
+}
+
+// NewRepositoryManager : 새로운 차트 저장소 관리자를 생성합니다
+func NewRepositoryManager() RepositoryManager {
+	return &repositoryManager{settings: cli.New()}
+}
+
+// AddRepository : 저장소 인덱스를 내려받아 검증한 후 등록 (같은 이름이면 갱신)
+func (m *repositoryManager) AddRepository(entry RepositoryEntry) (*RepositoryInfo, error) {
+	if err := validateRepositoryName(entry.Name); err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(entry.URL, "http://") && !strings.HasPrefix(entry.URL, "https://") {
+		return nil, fmt.Errorf("repository URL must be a valid HTTP/HTTPS URL, got: %s", entry.URL)
+	}
+
+	repoEntry := &repo.Entry{
+		Name:                  entry.Name,
+		URL:                   strings.TrimSuffix(entry.URL, "/"),
+		Username:              entry.Username,
+		Password:              entry.Password,
+		InsecureSkipTLSverify: entry.InsecureSkipTLSVerify,
+		PassCredentialsAll:    entry.PassCredentialsAll,
+	}
+
+	// 인덱스를 먼저 내려받아 유효한 저장소인지 확인
+	if err := m.downloadIndex(repoEntry); err != nil {
+		return nil, fmt.Errorf("failed to add repository '%s': %w", entry.Name, err)
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	file, err := m.loadFile()
+	if err != nil {
+		return nil, err
+	}
+	file.Update(repoEntry)
+	if err := m.writeFile(file); err != nil {
+		return nil, err
+	}
+
+	info := m.repositoryInfo(repoEntry)
+	return &info, nil
+}
+
+// UpdateRepositories : 저장소 인덱스 캐시 갱신 (names가 비어있으면 전체)
+// 개별 저장소 갱신 실패는 결과의 Error 필드로 반환합니다
+func (m *repositoryManager) UpdateRepositories(names ...string) ([]RepositoryInfo, error) {
+	m.mutex.Lock()
+	file, err := m.loadFile()
+	m.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	entries := file.Repositories
+	if len(names) > 0 {
+		entries = make([]*repo.Entry, 0, len(names))
+		for _, name := range names {
+			entry := file.Get(name)
+			if entry == nil {
+				return nil, fmt.Errorf("%w: %s", ErrRepositoryNotFound, name)
+			}
+			entries = append(entries, entry)
+		}
+	}
+
+	results := make([]RepositoryInfo, len(entries))
+	var wg sync.WaitGroup
+	for i, entry := range entries {
+		wg.Add(1)
+		go func(i int, entry *repo.Entry) {
+			defer wg.Done()
+			if err := m.downloadIndex(entry); err != nil {
+				results[i] = RepositoryInfo{Name: entry.Name, URL: entry.URL, HasCredentials: entry.Username != "", Error: err.Error()}
+				return
+			}
+			results[i] = m.repositoryInfo(entry)
+		}(i, entry)
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+// ListRepositories : 등록된 저장소 목록 조회 (캐시된 인덱스 기준 차트 수 포함)
+func (m *repositoryManager) ListRepositories() ([]RepositoryInfo, error) {
+	m.mutex.Lock()
+	file, err := m.loadFile()
+	m.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]RepositoryInfo, 0, len(file.Repositories))
+	for _, entry := range file.Repositories {
+		infos = append(infos, m.repositoryInfo(entry))
+	}
+	return infos, nil
+}
+
+// RemoveRepository : 저장소 등록 해제 및 인덱스 캐시 삭제
+func (m *repositoryManager) RemoveRepository(name string) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	file, err := m.loadFile()
+	if err != nil {
+		return err
+	}
+	if !file.Remove(name) {
+		return fmt.Errorf("%w: %s", ErrRepositoryNotFound, name)
+	}
+	if err := m.writeFile(file); err != nil {
+		return err
+	}
+
+	// 캐시 파일 삭제 (없으면 무시)
+	for _, cacheFile := range []string{helmpath.CacheIndexFile(name), helmpath.CacheChartsFile(name)} {
+		if err := os.Remove(filepath.Join(m.settings.RepositoryCache, cacheFile)); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("failed to remove repository cache: %w", err)
+		}
+	}
+	return nil
+}
+
+// SearchCharts : 캐시된 모든 저장소 인덱스에서 차트 검색
+// keyword는 "repo/chart" 이름, 설명, 키워드에서 대소문자 구분 없이 검색합니다
+// allVersions가 false이면 차트별로 조건을 만족하는 최신 버전만 반환합니다
+func (m *repositoryManager) SearchCharts(keyword, versionConstraint string, allVersions bool) ([]ChartSearchResult, error) {
+	var constraint *semver.Constraints
+	if versionConstraint != "" {
+		var err error
+		constraint, err = semver.NewConstraint(versionConstraint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version constraint '%s': %w", versionConstraint, err)
+		}
+	}
+
+	m.mutex.Lock()
+	file, err := m.loadFile()
+	m.mutex.Unlock()
+	if err != nil {
+		return nil, err
+	}
+
+	keyword = strings.ToLower(keyword)
+	results := make([]ChartSearchResult, 0)
+	for _, entry := range file.Repositories {
+		index, err := repo.LoadIndexFile(m.indexPath(entry.Name))
+		if err != nil {
+			// 캐시가 없는 저장소는 건너뜀 (update로 갱신 가능)
+			continue
+		}
+
+		for chartName, versions := range index.Entries {
+			for _, chartVersion := range versions {
+				if !matchesVersion(chartVersion, constraint) {
+					continue
+				}
+				if !matchesKeyword(entry.Name+"/"+chartName, chartVersion, keyword) {
+					continue
+				}
+
+				results = append(results, ChartSearchResult{
+					Name:        entry.Name + "/" + chartName,
+					Repository:  entry.Name,
+					Chart:       chartName,
+					Version:     chartVersion.Version,
+					AppVersion:  chartVersion.AppVersion,
+					Description: chartVersion.Description,
+					Deprecated:  chartVersion.Deprecated,
+				})
+				if !allVersions {
+					// 인덱스는 버전 내림차순으로 정렬되어 있음
+					break
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Name < results[j].Name
+	})
+	return results, nil
+}
+
+// LoginRegistry : OCI 레지스트리 로그인 (인증 정보는 레지스트리 설정 파일에 저장)
+func (m *repositoryManager) LoginRegistry(host, username, password string, insecure bool) error {
+	if host == "" {
+		return fmt.Errorf("registry host is required")
+	}
+	if username == "" || password == "" {
+		return fmt.Errorf("registry username and password are required")
+	}
+
+	client, err := newRegistryClient(m.settings, insecure)
+	if err != nil {
+		return err
+	}
+
+	host = strings.TrimPrefix(host, registry.OCIScheme+"://")
+	if err := client.Login(host, registry.LoginOptBasicAuth(username, password), registry.LoginOptInsecure(insecure)); err != nil {
+		return fmt.Errorf("failed to login to registry '%s': %w", host, err)
+	}
+	return nil
+}
+
+// LogoutRegistry : OCI 레지스트리 로그아웃 (저장된 인증 정보 삭제)
+func (m *repositoryManager) LogoutRegistry(host string) error {
+	if host == "" {
+		return fmt.Errorf("registry host is required")
+	}
+
+	client, err := newRegistryClient(m.settings, false)
+	if err != nil {
+		return err
+	}
+
+	host = strings.TrimPrefix(host, registry.OCIScheme+"://")
+	if err := client.Logout(host); err != nil {
+		return fmt.Errorf("failed to logout from registry '%s': %w", host, err)
+	}
+	return nil
+}
+
+// downloadIndex : 저장소 인덱스를 내려받아 캐시 디렉토리에 저장
+func (m *repositoryManager) downloadIndex(entry *repo.Entry) error {
+	chartRepo, err := repo.NewChartRepository(entry, getter.All(m.settings))
+	if err != nil {
+		return err
+	}
+	chartRepo.CachePath = m.settings.RepositoryCache
+
+	if _, err := chartRepo.DownloadIndexFile(); err != nil {
+		return fmt.Errorf("failed to download index from %s: %w", entry.URL, err)
+	}
+	return nil
+}
+
+// repositoryInfo : 저장소 정보 구성 (캐시된 인덱스 기준)
+func (m *repositoryManager) repositoryInfo(entry *repo.Entry) RepositoryInfo {
+	info := RepositoryInfo{
+		Name:           entry.Name,
+		URL:            entry.URL,
+		HasCredentials: entry.Username != "",
+	}
+
+	indexPath := m.indexPath(entry.Name)
+	stat, err := os.Stat(indexPath)
+	if err != nil {
+		info.Error = "index not cached, run update"
+		return info
+	}
+	info.IndexUpdatedAt = stat.ModTime()
+
+	index, err := repo.LoadIndexFile(indexPath)
+	if err != nil {
+		info.Error = fmt.Sprintf("failed to load cached index: %v", err)
+		return info
+	}
+	info.ChartCount = len(index.Entries)
+	return info
+}
+
+// indexPath : 캐시된 저장소 인덱스 경로
+func (m *repositoryManager) indexPath(name string) string {
+	return filepath.Join(m.settings.RepositoryCache, helmpath.CacheIndexFile(name))
+}
+
+// loadFile : 저장소 목록 파일 로드 (없으면 빈 목록)
+func (m *repositoryManager) loadFile() (*repo.File, error) {
+	file, err := repo.LoadFile(m.settings.RepositoryConfig)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return repo.NewFile(), nil
+		}
+		return nil, fmt.Errorf("failed to load repository config: %w", err)
+	}
+	return file, nil
+}
+
+// writeFile : 저장소 목록 파일 저장 (인증 정보가 포함되므로 0600)
+func (m *repositoryManager) writeFile(file *repo.File) error {
+	if err := os.MkdirAll(filepath.Dir(m.settings.RepositoryConfig), 0755); err != nil {
+		return fmt.Errorf("failed to create repository config directory: %w", err)
+	}
+	if err := file.WriteFile(m.settings.RepositoryConfig, 0600); err != nil {
+		return fmt.Errorf("failed to write repository config: %w", err)
+	}
+	return nil
+}
+
+// newRegistryClient : 레지스트리 설정 파일의 인증 정보를 사용하는 OCI 레지스트리 클라이언트 생성
+func newRegistryClient(settings *cli.EnvSettings, plainHTTP bool) (*registry.Client, error) {
+	opts := []registry.ClientOption{
+		registry.ClientOptEnableCache(true),
+		registry.ClientOptCredentialsFile(settings.RegistryConfig),
+	}
+	if plainHTTP {
+		opts = append(opts, registry.ClientOptPlainHTTP())
+	}
+
+	client, err := registry.NewClient(opts...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create registry client: %w", err)
+	}
+	return client, nil
+}
+
+// validateRepositoryName : 저장소 이름 검증 ("repo/chart" 참조와 캐시 파일명에 사용)
+func validateRepositoryName(name string) error {
+	if name == "" {
+		return fmt.Errorf("repository name is required")
+	}
+	if strings.ContainsAny(name, `/\:`) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid repository name '%s'", name)
+	}
+	return nil
+}
+
+// isRepositoryReference : "repo/chart" 형식의 저장소 차트 참조인지 확인
+func isRepositoryReference(ref string) bool {
+	if strings.Contains(ref, "://") || strings.HasPrefix(ref, "/") || strings.HasPrefix(ref, ".") {
+		return false
+	}
+	parts := strings.Split(ref, "/")
+	return len(parts) == 2 && parts[0] != "" && parts[1] != ""
+}
+
+// matchesVersion : 차트 버전이 semver 제약 조건을 만족하는지 확인 (제약 없으면 정식 버전만)
+func matchesVersion(chartVersion *repo.ChartVersion, constraint *semver.Constraints) bool {
+	version, err := semver.NewVersion(chartVersion.Version)
+	if err != nil {
+		return false
+	}
+	if constraint == nil {
+		return version.Prerelease() == ""
+	}
+	return constraint.Check(version)
+}
+
+// matchesKeyword : 차트 이름/설명/키워드 검색
+func matchesKeyword(name string, chartVersion *repo.ChartVersion, keyword string) bool {
+	if keyword == "" || strings.Contains(strings.ToLower(name), keyword) ||
+		strings.Contains(strings.ToLower(chartVersion.Description), keyword) {
+		return true
+	}
+	for _, k := range chartVersion.Keywords {
+		if strings.Contains(strings.ToLower(k), keyword) {
+			return true
+		}
+	}
+	return false
+}
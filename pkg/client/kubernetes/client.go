@@ -31,6 +31,9 @@
 // Type Assertion Guide:
 // - When name is empty: expect *v1.PodList, *v1.ConfigMapList, *v1.SecretList, *storagev1.StorageClassList
 // - When name is provided: expect *v1.Pod, *v1.ConfigMap, *v1.Secret, *storagev1.StorageClass
+// - GetNodes: expect *v1.NodeList or *v1.Node; GetCRDs: expect *apiextensionsv1.CustomResourceDefinitionList or *apiextensionsv1.CustomResourceDefinition
+// - GetResources (any kind via discovery): built-in kinds return typed objects (e.g. *v1.PodList, *v1.Pod)
+// - GetResources for kinds not in the client-go scheme (CRDs): expect *unstructured.UnstructuredList or *unstructured.Unstructured
 package kubernetes
 
 import (
@@ -43,9 +46,14 @@ import (
 	"github.com/taking/kubemigrate/pkg/config"
 	v1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -117,14 +125,40 @@ type Client interface {
 	// Secret 생성
 	CreateSecret(ctx context.Context, namespace, name string, data map[string]string) (*v1.Secret, error)
 
+	// GetNodes returns:
+	// - (*v1.NodeList, error) when name is empty (list all nodes)
+	// - (*v1.Node, error) when name is provided (single node)
+	GetNodes(ctx context.Context, name string) (interface{}, error)
+
+	// GetCRDs returns:
+	// - (*apiextensionsv1.CustomResourceDefinitionList, error) when name is empty (list all CRDs)
+	// - (*apiextensionsv1.CustomResourceDefinition, error) when name is provided (single CRD, e.g. "backups.velero.io")
+	GetCRDs(ctx context.Context, name string) (interface{}, error)
+
+	// GetServerVersion returns the Kubernetes version of the API server
+	GetServerVersion(ctx context.Context) (*version.Info, error)
+
+	// GetAPIResources returns the resources served by the API server.
+	// preferred=true returns only the preferred version of each group, otherwise every served version.
+	// Groups that fail discovery (e.g. unavailable aggregated APIs) are skipped.
+	GetAPIResources(ctx context.Context, preferred bool) ([]*metav1.APIResourceList, error)
+
+	// GetResources returns any resource kind (including CRDs) resolved through discovery:
+	// - (typed list such as *v1.PodList, or *unstructured.UnstructuredList for CRDs, error) when name is empty (paginated by query.Continue)
+	// - (typed object such as *v1.Pod, or *unstructured.Unstructured for CRDs, error) when name is provided
+	// kind accepts plural, singular, Kind, short names and "resource.group" (e.g. "deploy", "certificates.cert-manager.io").
+	GetResources(ctx context.Context, kind, namespace, name string, query ResourceQuery) (interface{}, error)
+
 	// HealthCheck : Kubernetes 연결 확인
 	HealthCheck(ctx context.Context) error
 }
 
 // client Kubernetes 클라이언트 구현체
 type client struct {
-	clientset    *kubernetes.Clientset
-	crdClientset *apiextensionsclientset.Clientset
+	clientset     *kubernetes.Clientset
+	crdClientset  *apiextensionsclientset.Clientset
+	dynamicClient dynamic.Interface
+	discovery     discovery.CachedDiscoveryInterface // 리소스 종류 해석용 (클라이언트 캐시와 수명 공유)
 }
 
 // NewClient : 새로운 Kubernetes 클라이언트를 생성합니다 (기본 설정)
@@ -143,20 +177,7 @@ func NewClient() (Client, error) {
 	restConfig.QPS = 50
 	restConfig.Burst = 100
 
-	clientset, err := kubernetes.NewForConfig(restConfig)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
-	}
-
-	crdClientset, err := apiextensionsclientset.NewForConfig(restConfig)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create crd client: %w", err)
-	}
-
-	return &client{
-		clientset:    clientset,
-		crdClientset: crdClientset,
-	}, nil
+	return newClientForConfig(restConfig)
 }
 
 // NewClientWithConfig : 설정을 받아서 Kubernetes 클라이언트를 생성합니다
@@ -187,9 +208,14 @@ func NewClientWithConfig(cfg config.KubeConfig) (Client, error) {
 	restConfig.QPS = 50
 	restConfig.Burst = 100
 
+	return newClientForConfig(restConfig)
+}
+
+// newClientForConfig : REST 설정으로 typed/CRD/dynamic 클라이언트 생성
+func newClientForConfig(restConfig *rest.Config) (Client, error) {
 	clientset, err := kubernetes.NewForConfig(restConfig)
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("failed to create kubernetes client: %w", err)
 	}
 
 	crdClientset, err := apiextensionsclientset.NewForConfig(restConfig)
@@ -197,9 +223,16 @@ func NewClientWithConfig(cfg config.KubeConfig) (Client, error) {
 		return nil, fmt.Errorf("failed to create crd client: %w", err)
 	}
 
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+
 	return &client{
-		clientset:    clientset,
-		crdClientset: crdClientset,
+		clientset:     clientset,
+		crdClientset:  crdClientset,
+		dynamicClient: dynamicClient,
+		discovery:     memory.NewMemCacheClient(clientset.Discovery()),
 	}, nil
 }
 
@@ -230,6 +263,18 @@ func stripManagedFieldsFromList(items interface{}) {
 		for i := range list.Items {
 			list.Items[i].SetManagedFields(nil)
 		}
+	case *storagev1.CSIDriverList:
+		for i := range list.Items {
+			list.Items[i].SetManagedFields(nil)
+		}
+	case *v1.NodeList:
+		for i := range list.Items {
+			list.Items[i].SetManagedFields(nil)
+		}
+	case *apiextensionsv1.CustomResourceDefinitionList:
+		for i := range list.Items {
+			list.Items[i].SetManagedFields(nil)
+		}
 	}
 }
 
@@ -248,6 +293,10 @@ func stripManagedFieldsFromSingle(obj interface{}) {
 		resource.SetManagedFields(nil)
 	case *storagev1.StorageClass:
 		resource.SetManagedFields(nil)
+	case *v1.Node:
+		resource.SetManagedFields(nil)
+	case *apiextensionsv1.CustomResourceDefinition:
+		resource.SetManagedFields(nil)
 	}
 }
 
@@ -564,6 +613,58 @@ func (c *client) GetCSIDrivers(ctx context.Context) (interface{}, error) {
 	return drivers, nil
 }
 
+// GetNodes : Node를 조회합니다
+// name이 비어있으면 목록 조회, 있으면 단일 조회
+func (c *client) GetNodes(ctx context.Context, name string) (interface{}, error) {
+	if name == "" {
+		nodes, err := c.clientset.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list nodes: %w", err)
+		}
+
+		// managedFields 제거
+		stripManagedFieldsFromList(nodes)
+
+		return nodes, nil
+	}
+
+	node, err := c.clientset.CoreV1().Nodes().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// managedFields 제거
+	stripManagedFieldsFromSingle(node)
+
+	return node, nil
+}
+
+// GetCRDs : Custom Resource Definition을 조회합니다
+// name이 비어있으면 목록 조회, 있으면 단일 조회
+func (c *client) GetCRDs(ctx context.Context, name string) (interface{}, error) {
+	if name == "" {
+		crds, err := c.crdClientset.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to list CRDs: %w", err)
+		}
+
+		// managedFields 제거
+		stripManagedFieldsFromList(crds)
+
+		return crds, nil
+	}
+
+	crd, err := c.crdClientset.ApiextensionsV1().CustomResourceDefinitions().Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	// managedFields 제거
+	stripManagedFieldsFromSingle(crd)
+
+	return crd, nil
+}
+
 // HealthCheck : Kubernetes 연결 확인
 func (c *client) HealthCheck(ctx context.Context) error {
 	// 간단한 API 호출로 연결 상태 확인
@@ -0,0 +1,238 @@
+package kubernetes
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+// ErrUnsupportedResourceKind : discovery로 해석할 수 없는 리소스 종류
+var ErrUnsupportedResourceKind = errors.New("unsupported resource kind")
+
+// ResourceQuery : 동적 리소스 목록 조회 옵션
+type ResourceQuery struct {
+	LabelSelector string // 예: "app=nginx,tier!=cache"
+	FieldSelector string // 예: "status.phase=Running"
+	Limit         int64  // 페이지 크기 (0이면 전체)
+	Continue      string // 이전 응답의 metadata.continue 토큰
+}
+
+// resolvedResource : discovery로 해석된 리소스 정보
+type resolvedResource struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+}
+
+// GetResources : discovery와 dynamic 클라이언트로 임의의 리소스를 조회합니다
+// namespace가 빈 문자열("")이거나 클러스터 범위 리소스이면 네임스페이스 구분 없이 조회합니다
+// 기본 제공 리소스는 typed 객체(*v1.PodList 등)로, CRD는 unstructured 객체로 반환합니다
+// Returns:
+// - (*v1.PodList 등 typed 목록 또는 *unstructured.UnstructuredList, error) when name is empty
+// - (*v1.Pod 등 typed 객체 또는 *unstructured.Unstructured, error) when name is provided
+func (c *client) GetResources(ctx context.Context, kind, namespace, name string, query ResourceQuery) (interface{}, error) {
+	resource, err := c.resolveResource(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceClient := c.dynamicClient.Resource(resource.gvr)
+	if resource.namespaced && namespace != "" {
+		if name != "" {
+			obj, err := resourceClient.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+			if err != nil {
+				return nil, err
+			}
+			return typedObject(obj), nil
+		}
+
+		list, err := resourceClient.Namespace(namespace).List(ctx, query.listOptions())
+		if err != nil {
+			return nil, err
+		}
+		return typedList(list, query), nil
+	}
+
+	if name != "" {
+		if resource.namespaced {
+			return nil, fmt.Errorf("namespace is required to get %s '%s'", resource.gvr.Resource, name)
+		}
+		obj, err := resourceClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return nil, err
+		}
+		return typedObject(obj), nil
+	}
+
+	list, err := resourceClient.List(ctx, query.listOptions())
+	if err != nil {
+		return nil, err
+	}
+	return typedList(list, query), nil
+}
+
+// typedObject : managedFields 제거 후 기본 제공 리소스이면 typed 객체로 변환
+func typedObject(obj *unstructured.Unstructured) interface{} {
+	obj.SetManagedFields(nil)
+	return toTyped(obj)
+}
+
+// typedList : managedFields 제거 후 기본 제공 리소스이면 typed 목록으로 변환
+// 페이지 조회가 아니면 기존 typed 조회와 같이 최신 생성 순으로 정렬
+func typedList(list *unstructured.UnstructuredList, query ResourceQuery) interface{} {
+	for i := range list.Items {
+		list.Items[i].SetManagedFields(nil)
+	}
+	if query.Limit == 0 && query.Continue == "" {
+		sort.SliceStable(list.Items, func(i, j int) bool {
+			ti, tj := list.Items[i].GetCreationTimestamp(), list.Items[j].GetCreationTimestamp()
+			return tj.Before(&ti)
+		})
+	}
+	return toTyped(list)
+}
+
+// toTyped : client-go scheme에 등록된 종류(pods, configmaps, secrets, storageclasses 등)는 typed 객체로 변환
+// 기존 typed 조회와 같은 응답 형식을 유지하며, CRD 등 등록되지 않은 종류는 unstructured 그대로 반환
+func toTyped(obj runtime.Unstructured) interface{} {
+	gvk := obj.GetObjectKind().GroupVersionKind()
+	if !scheme.Scheme.Recognizes(gvk) {
+		return obj
+	}
+
+	typed, err := scheme.Scheme.New(gvk)
+	if err != nil {
+		return obj
+	}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), typed); err != nil {
+		return obj
+	}
+	return typed
+}
+
+// GetServerVersion : API 서버의 Kubernetes 버전을 조회합니다
+func (c *client) GetServerVersion(ctx context.Context) (*version.Info, error) {
+	info, err := c.discovery.ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get server version: %w", err)
+	}
+	return info, nil
+}
+
+// GetAPIResources : API 서버가 제공하는 리소스 목록을 조회합니다
+// preferred가 true이면 그룹별 선호 버전만, false이면 제공되는 모든 버전을 반환합니다
+func (c *client) GetAPIResources(ctx context.Context, preferred bool) ([]*metav1.APIResourceList, error) {
+	var (
+		lists []*metav1.APIResourceList
+		err   error
+	)
+	if preferred {
+		lists, err = c.discovery.ServerPreferredResources()
+	} else {
+		_, lists, err = c.discovery.ServerGroupsAndResources()
+	}
+
+	// 일부 API 그룹 조회 실패(aggregated API 장애 등)는 무시하고 나머지를 반환
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, fmt.Errorf("failed to discover server resources: %w", err)
+	}
+	return lists, nil
+}
+
+// listOptions : 조회 옵션을 ListOptions로 변환
+func (q ResourceQuery) listOptions() metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: q.LabelSelector,
+		FieldSelector: q.FieldSelector,
+		Limit:         q.Limit,
+		Continue:      q.Continue,
+	}
+}
+
+// resolveResource : 리소스 종류를 GroupVersionResource로 해석
+// 찾지 못하면 discovery 캐시를 무효화하고 한 번 더 시도합니다 (새로 설치된 CRD 대응)
+func (c *client) resolveResource(kind string) (resolvedResource, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		if attempt > 0 {
+			c.discovery.Invalidate()
+		}
+
+		lists, err := c.discovery.ServerPreferredResources()
+		if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+			// 일부 API 그룹 조회 실패는 무시하고 나머지로 해석
+			return resolvedResource{}, fmt.Errorf("failed to discover server resources: %w", err)
+		}
+
+		if resource, ok := findResource(lists, kind); ok {
+			return resource, nil
+		}
+	}
+
+	return resolvedResource{}, fmt.Errorf("%w: %s", ErrUnsupportedResourceKind, kind)
+}
+
+// findResource : discovery 결과에서 리소스 검색
+// 복수형, 단수형, Kind, 축약어를 대소문자 구분 없이 비교하며 "resource.group" 형식으로 그룹을 지정할 수 있습니다.
+// 하이픈은 무시합니다 (예: "storage-classes" → "storageclasses").
+func findResource(lists []*metav1.APIResourceList, kind string) (resolvedResource, bool) {
+	name, group, hasGroup := strings.Cut(strings.ToLower(kind), ".")
+	name = normalizeResourceName(name)
+	if name == "" {
+		return resolvedResource{}, false
+	}
+
+	for _, list := range lists {
+		if list == nil {
+			continue
+		}
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			continue
+		}
+		if hasGroup && gv.Group != group {
+			continue
+		}
+
+		for _, resource := range list.APIResources {
+			// 하위 리소스(pods/log 등) 제외
+			if strings.Contains(resource.Name, "/") || !matchesResource(resource, name) {
+				continue
+			}
+			return resolvedResource{
+				gvr:        gv.WithResource(resource.Name),
+				namespaced: resource.Namespaced,
+			}, true
+		}
+	}
+
+	return resolvedResource{}, false
+}
+
+// matchesResource : 리소스 이름/단수형/Kind/축약어 비교
+func matchesResource(resource metav1.APIResource, name string) bool {
+	if normalizeResourceName(resource.Name) == name ||
+		normalizeResourceName(resource.SingularName) == name ||
+		strings.ToLower(resource.Kind) == name {
+		return true
+	}
+	for _, shortName := range resource.ShortNames {
+		if normalizeResourceName(shortName) == name {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeResourceName : 비교용 리소스 이름 정규화 (소문자, 하이픈 제거)
+func normalizeResourceName(name string) string {
+	return strings.ToLower(strings.ReplaceAll(name, "-", ""))
+}
@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// TestTypedList : 기본 제공 리소스는 기존 typed 응답 형식으로, CRD는 unstructured로 반환되는지 테스트
+func TestTypedList(t *testing.T) {
+	older := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	newer := metav1.NewTime(older.Add(time.Hour))
+
+	pod := func(name string, created metav1.Time) unstructured.Unstructured {
+		item := unstructured.Unstructured{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Pod"}}
+		item.SetName(name)
+		item.SetCreationTimestamp(created)
+		item.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "kubectl"}})
+		return item
+	}
+
+	list := &unstructured.UnstructuredList{
+		Object: map[string]interface{}{"apiVersion": "v1", "kind": "PodList"},
+		Items:  []unstructured.Unstructured{pod("old", older), pod("new", newer)},
+	}
+
+	pods, ok := typedList(list, ResourceQuery{}).(*v1.PodList)
+	if !ok {
+		t.Fatalf("Expected *v1.PodList, got %T", typedList(list, ResourceQuery{}))
+	}
+	if len(pods.Items) != 2 || pods.Items[0].Name != "new" {
+		t.Errorf("Expected pods sorted newest first, got %+v", pods.Items)
+	}
+	if pods.Items[0].ManagedFields != nil {
+		t.Errorf("Expected managedFields to be stripped, got %+v", pods.Items[0].ManagedFields)
+	}
+
+	crd := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "cert-manager.io/v1",
+		"kind":       "Certificate",
+		"metadata":   map[string]interface{}{"name": "web"},
+	}}
+	if _, ok := typedObject(crd).(*unstructured.Unstructured); !ok {
+		t.Errorf("Expected CRD objects to stay unstructured, got %T", typedObject(crd))
+	}
+}
@@ -8,12 +8,76 @@ import (
 
 	"github.com/minio/minio-go/v7"
 	"github.com/minio/minio-go/v7/pkg/credentials"
+	"github.com/minio/minio-go/v7/pkg/lifecycle"
 	"github.com/taking/kubemigrate/pkg/config"
 )
 
 // MakeBucketOptions 버킷 생성 옵션
 type MakeBucketOptions struct {
-	Region string
+	Region        string
+	ObjectLocking bool // Object Lock(WORM) 활성화 (버킷 생성 시에만 설정 가능, 버전 관리 자동 활성화)
+}
+
+// BucketRetention 버킷 Object Lock 기본 보존 설정
+type BucketRetention struct {
+	ObjectLockEnabled bool   `json:"objectLockEnabled"`
+	Mode              string `json:"mode,omitempty"`     // GOVERNANCE, COMPLIANCE (비어 있으면 기본 보존 없음)
+	Validity          uint   `json:"validity,omitempty"` // 보존 기간
+	Unit              string `json:"unit,omitempty"`     // DAYS, YEARS
+}
+
+// LifecycleRule 버킷 수명 주기 규칙
+type LifecycleRule struct {
+	ID                        string `json:"id"`
+	Prefix                    string `json:"prefix,omitempty"`
+	Enabled                   bool   `json:"enabled"`
+	ExpirationDays            int    `json:"expirationDays,omitempty"`            // 현재 버전 만료 (일)
+	NoncurrentExpirationDays  int    `json:"noncurrentExpirationDays,omitempty"`  // 이전 버전 만료 (일)
+	AbortIncompleteUploadDays int    `json:"abortIncompleteUploadDays,omitempty"` // 미완료 multipart 업로드 정리 (일)
+	ExpireDeleteMarkers       bool   `json:"expireDeleteMarkers,omitempty"`       // 이전 버전이 없는 삭제 마커 정리
+}
+
+// ObjectInfo 스트리밍 다운로드/업로드용 객체 정보
+type ObjectInfo struct {
+	Bucket       string            `json:"bucket"`
+	Key          string            `json:"key"`
+	Size         int64             `json:"size"`
+	ETag         string            `json:"etag"`
+	ContentType  string            `json:"contentType,omitempty"`
+	LastModified time.Time         `json:"lastModified,omitempty"`
+	UserMetadata map[string]string `json:"userMetadata,omitempty"` // 사용자 메타데이터 (X-Amz-Meta- 접두사 제외, HeadObject에서만 채움)
+}
+
+// ObjectRange 객체 바이트 범위 (Start, End 모두 포함)
+type ObjectRange struct {
+	Start int64
+	End   int64
+}
+
+// GetObjectOptions 스트리밍 다운로드 옵션
+type GetObjectOptions struct {
+	Range     *ObjectRange // nil이면 전체 객체
+	MatchETag string       // 지정하면 ETag가 다를 때 실패 (이어받기 중 객체 변경 감지)
+}
+
+// PutObjectOptions 스트리밍 업로드 옵션
+type PutObjectOptions struct {
+	ContentType  string
+	PartSize     uint64               // multipart 청크 크기 (0이면 DefaultPartSize)
+	Progress     func(uploaded int64) // 누적 업로드 바이트 콜백
+	UserMetadata map[string]string    // 사용자 메타데이터 (X-Amz-Meta- 접두사 자동 추가)
+}
+
+// DefaultPartSize 스트리밍 업로드 기본 multipart 청크 크기 (64MiB)
+const DefaultPartSize uint64 = 64 * 1024 * 1024
+
+// IsObjectNotFound 객체가 존재하지 않아 발생한 에러인지 확인합니다
+func IsObjectNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	code := minio.ToErrorResponse(err).Code
+	return code == "NoSuchKey" || code == "NotFound"
 }
 
 // Client MinIO 클라이언트 인터페이스
@@ -26,18 +90,32 @@ type Client interface {
 	DeleteBucket(ctx context.Context, bucketName string) error
 	ListBuckets(ctx context.Context) (interface{}, error)
 
+	// Bucket 설정 관련 (버전 관리, Object Lock, 수명 주기, 정책)
+	GetBucketVersioning(ctx context.Context, bucketName string) (string, error)
+	SetBucketVersioning(ctx context.Context, bucketName string, enabled bool) error
+	GetBucketRetention(ctx context.Context, bucketName string) (*BucketRetention, error)
+	SetBucketRetention(ctx context.Context, bucketName, mode string, validity uint, unit string) error
+	GetBucketLifecycle(ctx context.Context, bucketName string) ([]LifecycleRule, error)
+	SetBucketLifecycle(ctx context.Context, bucketName string, rules []LifecycleRule) error
+	GetBucketPolicy(ctx context.Context, bucketName string) (string, error)
+	SetBucketPolicy(ctx context.Context, bucketName, policy string) error
+
 	// Object 관련
 	PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) (interface{}, error)
 	GetObject(ctx context.Context, bucketName, objectName string) (interface{}, error)
+	GetObjectStream(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (io.ReadCloser, error)
+	PutObjectStream(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) (*ObjectInfo, error)
 	DeleteObject(ctx context.Context, bucketName, objectName string) error
 	ListObjects(ctx context.Context, bucketName string) (interface{}, error)
 
 	// 폴더 관련
 	DeleteFolder(ctx context.Context, bucketName, folderPath string) error
 	ListObjectsInFolder(ctx context.Context, bucketName, folderPath string) (interface{}, error)
+	ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) ([]ObjectInfo, error)
 
 	// Object 정보
 	StatObject(ctx context.Context, bucketName, objectName string) (interface{}, error)
+	HeadObject(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error)
 	CopyObject(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string) (interface{}, error)
 
 	// Presigned URL
@@ -108,7 +186,8 @@ func (c *client) MakeBucket(ctx context.Context, bucketName string, opts MakeBuc
 		return fmt.Errorf("minio client not initialized")
 	}
 	return c.minioClient.MakeBucket(ctx, bucketName, minio.MakeBucketOptions{
-		Region: opts.Region,
+		Region:        opts.Region,
+		ObjectLocking: opts.ObjectLocking,
 	})
 }
 
@@ -146,6 +225,151 @@ func (c *client) ListBuckets(ctx context.Context) (interface{}, error) {
 	return c.minioClient.ListBuckets(ctx)
 }
 
+// GetBucketVersioning 버킷 버전 관리 상태를 조회합니다 ("Enabled", "Suspended", 한 번도 설정하지 않았으면 "")
+func (c *client) GetBucketVersioning(ctx context.Context, bucketName string) (string, error) {
+	if c.minioClient == nil {
+		return "", fmt.Errorf("minio client not initialized")
+	}
+
+	versioning, err := c.minioClient.GetBucketVersioning(ctx, bucketName)
+	if err != nil {
+		return "", err
+	}
+	return versioning.Status, nil
+}
+
+// SetBucketVersioning 버킷 버전 관리를 활성화/일시 중지합니다
+func (c *client) SetBucketVersioning(ctx context.Context, bucketName string, enabled bool) error {
+	if c.minioClient == nil {
+		return fmt.Errorf("minio client not initialized")
+	}
+
+	if enabled {
+		return c.minioClient.EnableVersioning(ctx, bucketName)
+	}
+	return c.minioClient.SuspendVersioning(ctx, bucketName)
+}
+
+// GetBucketRetention 버킷 Object Lock 기본 보존 설정을 조회합니다
+func (c *client) GetBucketRetention(ctx context.Context, bucketName string) (*BucketRetention, error) {
+	if c.minioClient == nil {
+		return nil, fmt.Errorf("minio client not initialized")
+	}
+
+	objectLock, mode, validity, unit, err := c.minioClient.GetObjectLockConfig(ctx, bucketName)
+	if err != nil {
+		// Object Lock 없이 생성된 버킷
+		if minio.ToErrorResponse(err).Code == "ObjectLockConfigurationNotFoundError" {
+			return &BucketRetention{}, nil
+		}
+		return nil, err
+	}
+
+	retention := &BucketRetention{ObjectLockEnabled: objectLock == "Enabled"}
+	if mode != nil && validity != nil && unit != nil {
+		retention.Mode = mode.String()
+		retention.Validity = *validity
+		retention.Unit = unit.String()
+	}
+	return retention, nil
+}
+
+// SetBucketRetention 버킷 기본 보존 설정을 변경합니다 (mode가 비어 있으면 기본 보존 해제)
+func (c *client) SetBucketRetention(ctx context.Context, bucketName, mode string, validity uint, unit string) error {
+	if c.minioClient == nil {
+		return fmt.Errorf("minio client not initialized")
+	}
+
+	if mode == "" {
+		return c.minioClient.SetObjectLockConfig(ctx, bucketName, nil, nil, nil)
+	}
+
+	retentionMode := minio.RetentionMode(mode)
+	validityUnit := minio.ValidityUnit(unit)
+	return c.minioClient.SetObjectLockConfig(ctx, bucketName, &retentionMode, &validity, &validityUnit)
+}
+
+// GetBucketLifecycle 버킷 수명 주기 규칙을 조회합니다 (설정이 없으면 빈 목록)
+func (c *client) GetBucketLifecycle(ctx context.Context, bucketName string) ([]LifecycleRule, error) {
+	if c.minioClient == nil {
+		return nil, fmt.Errorf("minio client not initialized")
+	}
+
+	lifecycleConfig, err := c.minioClient.GetBucketLifecycle(ctx, bucketName)
+	if err != nil {
+		if minio.ToErrorResponse(err).Code == "NoSuchLifecycleConfiguration" {
+			return []LifecycleRule{}, nil
+		}
+		return nil, err
+	}
+
+	rules := make([]LifecycleRule, 0, len(lifecycleConfig.Rules))
+	for _, rule := range lifecycleConfig.Rules {
+		prefix := rule.RuleFilter.Prefix
+		if prefix == "" {
+			prefix = rule.Prefix
+		}
+		rules = append(rules, LifecycleRule{
+			ID:                        rule.ID,
+			Prefix:                    prefix,
+			Enabled:                   rule.Status == "Enabled",
+			ExpirationDays:            int(rule.Expiration.Days),
+			NoncurrentExpirationDays:  int(rule.NoncurrentVersionExpiration.NoncurrentDays),
+			AbortIncompleteUploadDays: int(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation),
+			ExpireDeleteMarkers:       rule.Expiration.DeleteMarker.IsEnabled(),
+		})
+	}
+	return rules, nil
+}
+
+// SetBucketLifecycle 버킷 수명 주기 규칙을 교체합니다 (빈 목록이면 수명 주기 설정 삭제)
+func (c *client) SetBucketLifecycle(ctx context.Context, bucketName string, rules []LifecycleRule) error {
+	if c.minioClient == nil {
+		return fmt.Errorf("minio client not initialized")
+	}
+
+	lifecycleConfig := lifecycle.NewConfiguration()
+	for _, rule := range rules {
+		status := "Disabled"
+		if rule.Enabled {
+			status = "Enabled"
+		}
+		lifecycleConfig.Rules = append(lifecycleConfig.Rules, lifecycle.Rule{
+			ID:         rule.ID,
+			Status:     status,
+			RuleFilter: lifecycle.Filter{Prefix: rule.Prefix},
+			Expiration: lifecycle.Expiration{
+				Days:         lifecycle.ExpirationDays(rule.ExpirationDays),
+				DeleteMarker: lifecycle.ExpireDeleteMarker(rule.ExpireDeleteMarkers),
+			},
+			NoncurrentVersionExpiration: lifecycle.NoncurrentVersionExpiration{
+				NoncurrentDays: lifecycle.ExpirationDays(rule.NoncurrentExpirationDays),
+			},
+			AbortIncompleteMultipartUpload: lifecycle.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: lifecycle.ExpirationDays(rule.AbortIncompleteUploadDays),
+			},
+		})
+	}
+
+	return c.minioClient.SetBucketLifecycle(ctx, bucketName, lifecycleConfig)
+}
+
+// GetBucketPolicy 버킷 정책(JSON)을 조회합니다 (정책이 없으면 빈 문자열)
+func (c *client) GetBucketPolicy(ctx context.Context, bucketName string) (string, error) {
+	if c.minioClient == nil {
+		return "", fmt.Errorf("minio client not initialized")
+	}
+	return c.minioClient.GetBucketPolicy(ctx, bucketName)
+}
+
+// SetBucketPolicy 버킷 정책을 교체합니다 (빈 문자열이면 정책 삭제)
+func (c *client) SetBucketPolicy(ctx context.Context, bucketName, policy string) error {
+	if c.minioClient == nil {
+		return fmt.Errorf("minio client not initialized")
+	}
+	return c.minioClient.SetBucketPolicy(ctx, bucketName, policy)
+}
+
 // PutObject 객체를 업로드합니다
 func (c *client) PutObject(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64) (interface{}, error) {
 	if c.minioClient == nil {
@@ -174,6 +398,73 @@ func (c *client) GetObject(ctx context.Context, bucketName, objectName string) (
 	return object, nil
 }
 
+// GetObjectStream 객체를 스트리밍으로 다운로드합니다 (호출자가 Close 해야 함)
+func (c *client) GetObjectStream(ctx context.Context, bucketName, objectName string, opts GetObjectOptions) (io.ReadCloser, error) {
+	if c.minioClient == nil {
+		return nil, fmt.Errorf("minio client not initialized")
+	}
+
+	getOpts := minio.GetObjectOptions{}
+	if opts.Range != nil {
+		if err := getOpts.SetRange(opts.Range.Start, opts.Range.End); err != nil {
+			return nil, err
+		}
+	}
+	if opts.MatchETag != "" {
+		if err := getOpts.SetMatchETag(opts.MatchETag); err != nil {
+			return nil, err
+		}
+	}
+
+	return c.minioClient.GetObject(ctx, bucketName, objectName, getOpts)
+}
+
+// PutObjectStream 객체를 multipart 청크 단위로 스트리밍 업로드합니다 (objectSize가 -1이면 크기 미상)
+func (c *client) PutObjectStream(ctx context.Context, bucketName, objectName string, reader io.Reader, objectSize int64, opts PutObjectOptions) (*ObjectInfo, error) {
+	if c.minioClient == nil {
+		return nil, fmt.Errorf("minio client not initialized")
+	}
+
+	putOpts := minio.PutObjectOptions{
+		ContentType:  opts.ContentType,
+		PartSize:     opts.PartSize,
+		UserMetadata: opts.UserMetadata,
+	}
+	if putOpts.PartSize == 0 {
+		putOpts.PartSize = DefaultPartSize
+	}
+	if opts.Progress != nil {
+		putOpts.Progress = &progressReader{report: opts.Progress}
+	}
+
+	uploadInfo, err := c.minioClient.PutObject(ctx, bucketName, objectName, reader, objectSize, putOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectInfo{
+		Bucket:       uploadInfo.Bucket,
+		Key:          uploadInfo.Key,
+		Size:         uploadInfo.Size,
+		ETag:         uploadInfo.ETag,
+		ContentType:  opts.ContentType,
+		LastModified: uploadInfo.LastModified,
+	}, nil
+}
+
+// progressReader minio-go 업로드 진행 훅 (전송된 바이트 수만큼 Read가 호출됨)
+type progressReader struct {
+	uploaded int64
+	report   func(uploaded int64)
+}
+
+// Read 전송된 바이트 수를 누적하고 콜백으로 전달합니다
+func (p *progressReader) Read(b []byte) (int, error) {
+	p.uploaded += int64(len(b))
+	p.report(p.uploaded)
+	return len(b), nil
+}
+
 // DeleteObject 객체를 삭제합니다
 func (c *client) DeleteObject(ctx context.Context, bucketName, objectName string) error {
 	if c.minioClient == nil {
@@ -216,6 +507,28 @@ func (c *client) StatObject(ctx context.Context, bucketName, objectName string)
 	return objectInfo, nil
 }
 
+// HeadObject 객체 메타데이터(크기, ETag, Content-Type)를 조회합니다
+func (c *client) HeadObject(ctx context.Context, bucketName, objectName string) (*ObjectInfo, error) {
+	if c.minioClient == nil {
+		return nil, fmt.Errorf("minio client not initialized")
+	}
+
+	info, err := c.minioClient.StatObject(ctx, bucketName, objectName, minio.StatObjectOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectInfo{
+		Bucket:       bucketName,
+		Key:          info.Key,
+		Size:         info.Size,
+		ETag:         info.ETag,
+		ContentType:  info.ContentType,
+		LastModified: info.LastModified,
+		UserMetadata: info.UserMetadata,
+	}, nil
+}
+
 // CopyObject 객체를 복사합니다
 func (c *client) CopyObject(ctx context.Context, srcBucket, srcObject, dstBucket, dstObject string) (interface{}, error) {
 	if c.minioClient == nil {
@@ -353,3 +666,32 @@ func (c *client) ListObjectsInFolder(ctx context.Context, bucketName, folderPath
 
 	return objects, nil
 }
+
+// ListObjectsWithPrefix 접두사 아래의 모든 객체를 재귀적으로 조회합니다 (접두사가 비어 있으면 버킷 전체)
+func (c *client) ListObjectsWithPrefix(ctx context.Context, bucketName, prefix string) ([]ObjectInfo, error) {
+	if c.minioClient == nil {
+		return nil, fmt.Errorf("minio client not initialized")
+	}
+
+	var objects []ObjectInfo
+	objectCh := c.minioClient.ListObjects(ctx, bucketName, minio.ListObjectsOptions{
+		Prefix:    prefix,
+		Recursive: true,
+	})
+
+	for object := range objectCh {
+		if object.Err != nil {
+			return nil, fmt.Errorf("failed to list objects with prefix %s: %w", prefix, object.Err)
+		}
+		objects = append(objects, ObjectInfo{
+			Bucket:       bucketName,
+			Key:          object.Key,
+			Size:         object.Size,
+			ETag:         object.ETag,
+			ContentType:  object.ContentType,
+			LastModified: object.LastModified,
+		})
+	}
+
+	return objects, nil
+}
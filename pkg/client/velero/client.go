@@ -28,6 +28,14 @@ type Client interface {
 	CreateRestore(ctx context.Context, namespace string, restore *velerov1.Restore) error
 	DeleteRestore(ctx context.Context, namespace, name string) error
 
+	// Schedule 관련
+	GetSchedules(ctx context.Context, namespace string) ([]velerov1.Schedule, error)
+	GetSchedule(ctx context.Context, namespace, name string) (*velerov1.Schedule, error)
+	CreateSchedule(ctx context.Context, namespace string, schedule *velerov1.Schedule) error
+	UpdateSchedule(ctx context.Context, namespace string, schedule *velerov1.Schedule) error
+	PauseSchedule(ctx context.Context, namespace, name string, paused bool) error
+	DeleteSchedule(ctx context.Context, namespace, name string) error
+
 	// BackupRepository 관련
 	GetBackupRepositories(ctx context.Context, namespace string) ([]velerov1.BackupRepository, error)
 	GetBackupRepository(ctx context.Context, namespace, name string) (*velerov1.BackupRepository, error)
@@ -36,16 +44,25 @@ type Client interface {
 	GetBackupStorageLocations(ctx context.Context, namespace string) ([]velerov1.BackupStorageLocation, error)
 	GetBackupStorageLocation(ctx context.Context, namespace, name string) (*velerov1.BackupStorageLocation, error)
 	CreateBackupStorageLocation(ctx context.Context, namespace string, bsl *velerov1.BackupStorageLocation) error
+	UpdateBackupStorageLocation(ctx context.Context, namespace string, bsl *velerov1.BackupStorageLocation) error
 	DeleteBackupStorageLocation(ctx context.Context, namespace, name string) error
 
 	// VolumeSnapshotLocation 관련
 	GetVolumeSnapshotLocations(ctx context.Context, namespace string) ([]velerov1.VolumeSnapshotLocation, error)
 	GetVolumeSnapshotLocation(ctx context.Context, namespace, name string) (*velerov1.VolumeSnapshotLocation, error)
+	CreateVolumeSnapshotLocation(ctx context.Context, namespace string, vsl *velerov1.VolumeSnapshotLocation) error
+	UpdateVolumeSnapshotLocation(ctx context.Context, namespace string, vsl *velerov1.VolumeSnapshotLocation) error
+	DeleteVolumeSnapshotLocation(ctx context.Context, namespace, name string) error
 
 	// PodVolumeRestore 관련
 	GetPodVolumeRestores(ctx context.Context, namespace string) ([]velerov1.PodVolumeRestore, error)
 	GetPodVolumeRestore(ctx context.Context, namespace, name string) (*velerov1.PodVolumeRestore, error)
 
+	// DownloadRequest 관련
+	CreateDownloadRequest(ctx context.Context, namespace string, request *velerov1.DownloadRequest) error
+	GetDownloadRequest(ctx context.Context, namespace, name string) (*velerov1.DownloadRequest, error)
+	DeleteDownloadRequest(ctx context.Context, namespace, name string) error
+
 	// HealthCheck : Velero 연결 확인
 	HealthCheck(ctx context.Context) error
 }
@@ -202,6 +219,58 @@ func (c *client) DeleteRestore(ctx context.Context, namespace, name string) erro
 	return c.k8sClient.Delete(ctx, restore)
 }
 
+// GetSchedules 네임스페이스의 Schedule 목록을 조회합니다
+func (c *client) GetSchedules(ctx context.Context, namespace string) ([]velerov1.Schedule, error) {
+	var scheduleList velerov1.ScheduleList
+	err := c.k8sClient.List(ctx, &scheduleList, ctrlclient.InNamespace(namespace))
+	if err != nil {
+		return nil, err
+	}
+	return scheduleList.Items, nil
+}
+
+// GetSchedule 특정 Schedule을 조회합니다
+func (c *client) GetSchedule(ctx context.Context, namespace, name string) (*velerov1.Schedule, error) {
+	var schedule velerov1.Schedule
+	err := c.k8sClient.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: name}, &schedule)
+	if err != nil {
+		return nil, err
+	}
+	return &schedule, nil
+}
+
+// CreateSchedule Schedule을 생성합니다
+func (c *client) CreateSchedule(ctx context.Context, namespace string, schedule *velerov1.Schedule) error {
+	schedule.Namespace = namespace
+	return c.k8sClient.Create(ctx, schedule)
+}
+
+// UpdateSchedule Schedule을 수정합니다 (ResourceVersion이 설정된 객체 필요)
+func (c *client) UpdateSchedule(ctx context.Context, namespace string, schedule *velerov1.Schedule) error {
+	schedule.Namespace = namespace
+	return c.k8sClient.Update(ctx, schedule)
+}
+
+// PauseSchedule Schedule을 일시 중지하거나 재개합니다
+func (c *client) PauseSchedule(ctx context.Context, namespace, name string, paused bool) error {
+	schedule, err := c.GetSchedule(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	original := schedule.DeepCopy()
+	schedule.Spec.Paused = paused
+	return c.k8sClient.Patch(ctx, schedule, ctrlclient.MergeFrom(original))
+}
+
+// DeleteSchedule Schedule을 삭제합니다 (이미 생성된 Backup은 유지)
+func (c *client) DeleteSchedule(ctx context.Context, namespace, name string) error {
+	schedule := &velerov1.Schedule{}
+	schedule.Namespace = namespace
+	schedule.Name = name
+	return c.k8sClient.Delete(ctx, schedule)
+}
+
 // GetBackupRepositories 네임스페이스의 BackupRepository 목록을 조회합니다
 func (c *client) GetBackupRepositories(ctx context.Context, namespace string) ([]velerov1.BackupRepository, error) {
 	var repoList velerov1.BackupRepositoryList
@@ -284,9 +353,16 @@ func (c *client) GetPodVolumeRestore(ctx context.Context, namespace, name string
 
 // CreateBackupStorageLocation BackupStorageLocation을 생성합니다
 func (c *client) CreateBackupStorageLocation(ctx context.Context, namespace string, bsl *velerov1.BackupStorageLocation) error {
+	bsl.Namespace = namespace
 	return c.k8sClient.Create(ctx, bsl)
 }
 
+// UpdateBackupStorageLocation BackupStorageLocation을 수정합니다 (ResourceVersion이 설정된 객체 필요)
+func (c *client) UpdateBackupStorageLocation(ctx context.Context, namespace string, bsl *velerov1.BackupStorageLocation) error {
+	bsl.Namespace = namespace
+	return c.k8sClient.Update(ctx, bsl)
+}
+
 // DeleteBackupStorageLocation BackupStorageLocation을 삭제합니다
 func (c *client) DeleteBackupStorageLocation(ctx context.Context, namespace, name string) error {
 	bsl := &velerov1.BackupStorageLocation{}
@@ -295,6 +371,50 @@ func (c *client) DeleteBackupStorageLocation(ctx context.Context, namespace, nam
 	return c.k8sClient.Delete(ctx, bsl)
 }
 
+// CreateVolumeSnapshotLocation VolumeSnapshotLocation을 생성합니다
+func (c *client) CreateVolumeSnapshotLocation(ctx context.Context, namespace string, vsl *velerov1.VolumeSnapshotLocation) error {
+	vsl.Namespace = namespace
+	return c.k8sClient.Create(ctx, vsl)
+}
+
+// UpdateVolumeSnapshotLocation VolumeSnapshotLocation을 수정합니다 (ResourceVersion이 설정된 객체 필요)
+func (c *client) UpdateVolumeSnapshotLocation(ctx context.Context, namespace string, vsl *velerov1.VolumeSnapshotLocation) error {
+	vsl.Namespace = namespace
+	return c.k8sClient.Update(ctx, vsl)
+}
+
+// DeleteVolumeSnapshotLocation VolumeSnapshotLocation을 삭제합니다
+func (c *client) DeleteVolumeSnapshotLocation(ctx context.Context, namespace, name string) error {
+	vsl := &velerov1.VolumeSnapshotLocation{}
+	vsl.Name = name
+	vsl.Namespace = namespace
+	return c.k8sClient.Delete(ctx, vsl)
+}
+
+// CreateDownloadRequest DownloadRequest를 생성합니다
+func (c *client) CreateDownloadRequest(ctx context.Context, namespace string, request *velerov1.DownloadRequest) error {
+	request.Namespace = namespace
+	return c.k8sClient.Create(ctx, request)
+}
+
+// GetDownloadRequest 특정 DownloadRequest를 조회합니다
+func (c *client) GetDownloadRequest(ctx context.Context, namespace, name string) (*velerov1.DownloadRequest, error) {
+	var request velerov1.DownloadRequest
+	err := c.k8sClient.Get(ctx, ctrlclient.ObjectKey{Namespace: namespace, Name: name}, &request)
+	if err != nil {
+		return nil, err
+	}
+	return &request, nil
+}
+
+// DeleteDownloadRequest DownloadRequest를 삭제합니다
+func (c *client) DeleteDownloadRequest(ctx context.Context, namespace, name string) error {
+	request := &velerov1.DownloadRequest{}
+	request.Name = name
+	request.Namespace = namespace
+	return c.k8sClient.Delete(ctx, request)
+}
+
 // HealthCheck : Velero 연결 확인
 func (c *client) HealthCheck(ctx context.Context) error {
 	// 간단한 API 호출로 Velero 연결 상태 확인
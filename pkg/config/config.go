@@ -3,6 +3,7 @@ package config
 
 import (
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/joho/godotenv"
@@ -48,6 +49,23 @@ func Load() *Config {
 			Level:  getEnvOrDefault("LOG_LEVEL", "info"),
 			Format: getEnvOrDefault("LOG_FORMAT", "pretty"),
 		},
+		Auth: AuthConfig{
+			Mode:           getEnvOrDefault("AUTH_MODE", "none"),
+			Tokens:         getEnvOrDefault("AUTH_TOKENS", ""),
+			TokensFile:     getEnvOrDefault("AUTH_TOKENS_FILE", ""),
+			JWKSFile:       getEnvOrDefault("AUTH_JWKS_FILE", ""),
+			JWTIssuer:      getEnvOrDefault("AUTH_JWT_ISSUER", ""),
+			JWTAudience:    getEnvOrDefault("AUTH_JWT_AUDIENCE", ""),
+			JWTRoleClaim:   getEnvOrDefault("AUTH_JWT_ROLE_CLAIM", "roles"),
+			JWTRoleMapping: getEnvOrDefault("AUTH_JWT_ROLE_MAPPING", ""),
+			JWTLeeway:      getDurationOrDefault("AUTH_JWT_LEEWAY", time.Minute),
+		},
+		Audit: AuditConfig{
+			Enabled:    getBoolOrDefault("AUDIT_ENABLED", true),
+			Path:       getEnvOrDefault("AUDIT_LOG_PATH", "./data/audit/audit.log"),
+			MaxSizeMB:  getIntOrDefault("AUDIT_MAX_SIZE_MB", 100),
+			MaxBackups: getIntOrDefault("AUDIT_MAX_BACKUPS", 5),
+		},
 	}
 }
 
@@ -70,6 +88,28 @@ func getDurationOrDefault(key string, defaultValue time.Duration) time.Duration
 	return defaultValue
 }
 
+// getIntOrDefault : 환경변수 key가 존재하면 int로 변환하여 반환
+// 변환 실패 시 기본값(defaultValue) 사용
+func getIntOrDefault(key string, defaultValue int) int {
+	if value := os.Getenv(key); value != "" {
+		if number, err := strconv.Atoi(value); err == nil {
+			return number
+		}
+	}
+	return defaultValue
+}
+
+// getBoolOrDefault : 환경변수 key가 존재하면 bool로 변환하여 반환
+// 변환 실패 시 기본값(defaultValue) 사용
+func getBoolOrDefault(key string, defaultValue bool) bool {
+	if value := os.Getenv(key); value != "" {
+		if b, err := strconv.ParseBool(value); err == nil {
+			return b
+		}
+	}
+	return defaultValue
+}
+
 // getPortOrDefault : PORT 또는 SERVER_PORT 환경변수를 확인하여 포트 반환
 // 둘 다 없으면 기본값 사용
 func getPortOrDefault() string {
@@ -1,12 +1,18 @@
 package config
 
-import "time"
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+)
 
 // Config : 전체 애플리케이션 설정 구조체
 type Config struct {
 	Server   ServerConfig  // 서버 관련 설정
 	Timeouts TimeoutConfig // 타임아웃 관련 설정
 	Logging  LoggingConfig // 로깅 관련 설정
+	Auth     AuthConfig    // API 인증 관련 설정
+	Audit    AuditConfig   // 감사 로그 관련 설정
 }
 
 // ServerConfig : 서버 호스트, 포트 및 타임아웃 설정
@@ -30,6 +36,27 @@ type LoggingConfig struct {
 	Format string // 로그 포맷 (예: json, text)
 }
 
+// AuthConfig : API 인증 설정
+type AuthConfig struct {
+	Mode           string        // 인증 방식 ("none", "token", "jwt", "token,jwt")
+	Tokens         string        // 정적 API 토큰 목록 ("name:role:token" 콤마 구분)
+	TokensFile     string        // 정적 API 토큰 파일 (한 줄에 "name:role:token")
+	JWKSFile       string        // JWT 서명 검증용 JWKS 파일
+	JWTIssuer      string        // 기대하는 JWT 발급자 (iss)
+	JWTAudience    string        // 기대하는 JWT 대상 (aud)
+	JWTRoleClaim   string        // 역할 클레임 경로 (예: roles, realm_access.roles)
+	JWTRoleMapping string        // 클레임 값 → 역할 매핑 ("group=admin" 콤마 구분)
+	JWTLeeway      time.Duration // exp/nbf 허용 오차
+}
+
+// AuditConfig : 변경 작업 감사 로그 설정
+type AuditConfig struct {
+	Enabled    bool   // 감사 로그 기록 여부
+	Path       string // JSON lines 파일 경로
+	MaxSizeMB  int    // 파일 회전 기준 크기 (MB)
+	MaxBackups int    // 보관할 회전 파일 수
+}
+
 // KubeConfig : Kubernetes 설정 구조체
 type KubeConfig struct {
 	KubeConfig string `json:"kubeconfig" binding:"required" example:"base64 인코딩된 KubeConfig 값"` // [필수] Base64 인코딩된 KubeConfig 값
@@ -46,8 +73,34 @@ type MinioConfig struct {
 
 // VeleroConfig : Velero 설정 구조체
 type VeleroConfig struct {
-	KubeConfig  KubeConfig  `json:"kubeconfig" binding:"required"`
-	MinioConfig MinioConfig `json:"minio" binding:"required"`
+	KubeConfig  KubeConfig            `json:"kubeconfig" binding:"required"`
+	MinioConfig MinioConfig           `json:"minio" binding:"required"`
+	Install     *VeleroInstallOptions `json:"install,omitempty"` // [옵션] Velero 설치 프로필 (설치 API에서만 사용)
+}
+
+// VeleroInstallOptions : Velero 설치 프로필 (비어 있는 항목은 기본값 사용)
+type VeleroInstallOptions struct {
+	ChartURL       string `json:"chartUrl,omitempty" example:"oci://registry.local/charts/velero"`                        // [옵션] 차트 위치 (http(s) URL, oci://, repo/chart), 기본 값 : GitHub 릴리스
+	ChartVersion   string `json:"chartVersion,omitempty" example:"11.1.0"`                                                // [옵션] 차트 버전 (기본 값 : '11.1.0')
+	Image          string `json:"image,omitempty" example:"registry.local/velero/velero"`                                 // [옵션] Velero 이미지 저장소 (기본 값 : 'docker.io/velero/velero')
+	ImageTag       string `json:"imageTag,omitempty" example:"v1.17.0"`                                                   // [옵션] Velero 이미지 태그 (기본 값 : 'v1.17.0')
+	AWSPluginImage string `json:"awsPluginImage,omitempty" example:"registry.local/velero/velero-plugin-for-aws:v1.13.0"` // [옵션] AWS 플러그인 이미지 (태그 포함)
+	KubectlImage   string `json:"kubectlImage,omitempty" example:"registry.local/bitnamilegacy/kubectl"`                  // [옵션] kubectl 이미지 저장소 (CRD 업그레이드 훅)
+
+	Bucket                string `json:"bucket,omitempty" example:"velero"`    // [옵션] 백업 버킷 (기본 값 : 'velero')
+	Prefix                string `json:"prefix,omitempty" example:"cluster-a"` // [옵션] 버킷 내 접두사
+	Region                string `json:"region,omitempty" example:"minio"`     // [옵션] S3 리전 (기본 값 : 'minio')
+	CACert                string `json:"caCert,omitempty"`                     // [옵션] MinIO TLS 인증서 검증용 PEM CA 번들
+	InsecureSkipTLSVerify bool   `json:"insecureSkipTLSVerify,omitempty"`      // [옵션] MinIO TLS 인증서 검증 생략
+
+	NodeAgent                *bool `json:"nodeAgent,omitempty" example:"true"`                // [옵션] node-agent DaemonSet 배포 여부 (기본 값 : true)
+	DefaultVolumesToFsBackup *bool `json:"defaultVolumesToFsBackup,omitempty" example:"true"` // [옵션] 파일 시스템 볼륨 백업 기본 사용 여부 (기본 값 : true)
+
+	Resources          *corev1.ResourceRequirements `json:"resources,omitempty"`          // [옵션] Velero 서버 리소스 요청/제한
+	NodeAgentResources *corev1.ResourceRequirements `json:"nodeAgentResources,omitempty"` // [옵션] node-agent 리소스 요청/제한
+	Tolerations        []corev1.Toleration          `json:"tolerations,omitempty"`        // [옵션] Velero 서버와 node-agent에 적용할 toleration
+
+	Values map[string]interface{} `json:"values,omitempty"` // [옵션] 생성된 Helm values 위에 병합할 추가 values
 }
 
 // InstallChartConfig : Helm 차트 설치 설정
@@ -66,3 +119,10 @@ type UpgradeChartConfig struct {
 	Namespace   string                 `json:"namespace"`
 	Values      map[string]interface{} `json:"values,omitempty"`
 }
+
+// RollbackChartConfig : Helm 차트 롤백 설정
+type RollbackChartConfig struct {
+	ReleaseName string `json:"release_name"`
+	Namespace   string `json:"namespace"`
+	Revision    int    `json:"revision"` // 0이면 직전 리비전
+}
@@ -17,6 +17,19 @@ type (
 
 	// Values 관련
 	Values = map[string]interface{}
+
+	// RegistryLoginRequest : OCI 레지스트리 로그인 요청 구조체
+	RegistryLoginRequest struct {
+		Host     string `json:"host" binding:"required" example:"registry-1.docker.io"`
+		Username string `json:"username" binding:"required"`
+		Password string `json:"password" binding:"required"`
+		Insecure bool   `json:"insecure,omitempty" example:"false"` // HTTP(평문) 레지스트리 허용
+	}
+
+	// RegistryLogoutRequest : OCI 레지스트리 로그아웃 요청 구조체
+	RegistryLogoutRequest struct {
+		Host string `json:"host" binding:"required" example:"registry-1.docker.io"`
+	}
 )
 
 // Helm 타입 어설션 헬퍼 함수들
@@ -0,0 +1,96 @@
+package types
+
+import (
+	"time"
+
+	"github.com/taking/kubemigrate/pkg/config"
+)
+
+// 마이그레이션 관련 타입들
+type (
+	// MigrationRequest : 클러스터 간 마이그레이션 요청 구조체
+	MigrationRequest struct {
+		Name            string                       `json:"name" binding:"required" example:"app-migration"` // 백업/복원 이름으로 사용
+		Source          config.KubeConfig            `json:"source" binding:"required"`                       // 원본 클러스터
+		Target          config.KubeConfig            `json:"target" binding:"required"`                       // 대상 클러스터
+		MinioConfig     config.MinioConfig           `json:"minio" binding:"required"`                        // 양쪽 클러스터가 공유하는 MinIO
+		SourceClusterID string                       `json:"sourceClusterId,omitempty" example:"prod-a"`      // source 대신 등록된 클러스터 ID
+		TargetClusterID string                       `json:"targetClusterId,omitempty" example:"dr-b"`        // target 대신 등록된 클러스터 ID
+		StorageID       string                       `json:"storageId,omitempty" example:"backup-minio"`      // minio 대신 등록된 스토리지 ID
+		VeleroNamespace string                       `json:"veleroNamespace,omitempty" example:"velero"`      // 기본 값 : 'velero'
+		ForceInstall    bool                         `json:"forceInstall,omitempty" example:"false"`          // Velero 강제 재설치 여부
+		VeleroInstall   *config.VeleroInstallOptions `json:"veleroInstall,omitempty"`                         // Velero 설치 프로필 (양쪽 클러스터 공통)
+
+		// 백업 설정
+		IncludeNamespaces        []string          `json:"includeNamespaces" binding:"required" example:"app"`
+		ExcludeResources         []string          `json:"excludeResources,omitempty" example:"events"`
+		LabelSelector            map[string]string `json:"labelSelector,omitempty" example:"app=myapp"`
+		IncludeClusterResources  *bool             `json:"includeClusterResources,omitempty" example:"false"`
+		DefaultVolumesToFsBackup *bool             `json:"defaultVolumesToFsBackup,omitempty" example:"true"`
+		TTL                      string            `json:"ttl,omitempty" example:"720h0m0s"`
+
+		// 복원 설정
+		RestorePVs             *bool             `json:"restorePVs,omitempty" example:"true"`
+		StorageClassMappings   map[string]string `json:"storageClassMappings,omitempty" example:"original-sc:new-sc"`
+		NamespaceMappings      map[string]string `json:"namespaceMappings,omitempty" example:"old-ns:new-ns"`
+		ExistingResourcePolicy string            `json:"existingResourcePolicy,omitempty" example:"none"` // "none", "update"
+	}
+
+	// MigrationStep : 마이그레이션 단계별 진행 상태
+	MigrationStep struct {
+		Name        string     `json:"name"`   // 예: "source-velero", "backup", "restore"
+		Status      string     `json:"status"` // "pending", "running", "completed", "failed"
+		Message     string     `json:"message,omitempty"`
+		StartedAt   *time.Time `json:"startedAt,omitempty"`
+		CompletedAt *time.Time `json:"completedAt,omitempty"`
+	}
+
+	// MigrationResult : 마이그레이션 시작 결과
+	MigrationResult struct {
+		Status      string          `json:"status"`
+		MigrationID string          `json:"migrationId"`
+		Name        string          `json:"name"`
+		BackupName  string          `json:"backupName"`
+		RestoreName string          `json:"restoreName"`
+		Message     string          `json:"message"`
+		StatusUrl   string          `json:"statusUrl"`
+		StreamUrl   string          `json:"streamUrl"`
+		Steps       []MigrationStep `json:"steps"`
+		CreatedAt   time.Time       `json:"createdAt"`
+	}
+
+	// CompatibilityRequest : 마이그레이션 사전 호환성 분석 요청 구조체
+	CompatibilityRequest struct {
+		Source          config.KubeConfig `json:"source" binding:"required"`                  // 원본 클러스터
+		Target          config.KubeConfig `json:"target" binding:"required"`                  // 대상 클러스터
+		SourceClusterID string            `json:"sourceClusterId,omitempty" example:"prod-a"` // source 대신 등록된 클러스터 ID
+		TargetClusterID string            `json:"targetClusterId,omitempty" example:"dr-b"`   // target 대신 등록된 클러스터 ID
+
+		// 마이그레이션 요청과 동일한 의미의 범위 설정
+		IncludeNamespaces       []string          `json:"includeNamespaces" binding:"required" example:"app"`
+		ExcludeResources        []string          `json:"excludeResources,omitempty" example:"events"`
+		IncludeClusterResources *bool             `json:"includeClusterResources,omitempty" example:"true"`
+		StorageClassMappings    map[string]string `json:"storageClassMappings,omitempty" example:"original-sc:new-sc"`
+	}
+
+	// CompatibilityIssue : 호환성 분석 항목
+	CompatibilityIssue struct {
+		Category   string `json:"category"`           // "version", "api", "crd", "storage", "capacity"
+		Resource   string `json:"resource,omitempty"` // 예: "ingresses.extensions/v1beta1", "app/data-pvc"
+		Message    string `json:"message"`
+		Suggestion string `json:"suggestion,omitempty"`
+	}
+
+	// CompatibilityReport : 마이그레이션 사전 호환성 분석 결과
+	// Errors는 복원을 실패시키는 항목, Warnings는 복원은 가능하지만 확인이 필요한 항목입니다
+	CompatibilityReport struct {
+		Compatible    bool                 `json:"compatible"`
+		SourceVersion string               `json:"sourceVersion"`
+		TargetVersion string               `json:"targetVersion"`
+		Errors        []CompatibilityIssue `json:"errors"`
+		Warnings      []CompatibilityIssue `json:"warnings"`
+		ErrorCount    int                  `json:"errorCount"`
+		WarningCount  int                  `json:"warningCount"`
+		AnalyzedAt    time.Time            `json:"analyzedAt"`
+	}
+)
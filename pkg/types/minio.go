@@ -1,9 +1,12 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/minio/minio-go/v7"
+	minioclient "github.com/taking/kubemigrate/pkg/client/minio"
+	"github.com/taking/kubemigrate/pkg/config"
 )
 
 // MinIO 리소스 타입 정의
@@ -32,6 +35,48 @@ type (
 	MakeBucketOptions struct {
 		Region string
 	}
+
+	// BucketVersioningRequest : 버킷 버전 관리 설정 요청 구조체 (minio 포함)
+	BucketVersioningRequest struct {
+		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
+		Enabled     bool               `json:"enabled" example:"true"` // false이면 버전 관리 일시 중지
+	}
+
+	// BucketRetentionRequest : 버킷 Object Lock 기본 보존 설정 요청 구조체 (minio 포함)
+	BucketRetentionRequest struct {
+		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
+		Mode        string             `json:"mode,omitempty" example:"COMPLIANCE"` // GOVERNANCE, COMPLIANCE (비어 있으면 기본 보존 해제)
+		Validity    uint               `json:"validity,omitempty" example:"30"`     // 보존 기간
+		Unit        string             `json:"unit,omitempty" example:"DAYS"`       // DAYS, YEARS (기본 값 : DAYS)
+	}
+
+	// BucketLifecycleRequest : 버킷 수명 주기 설정 요청 구조체 (minio 포함)
+	BucketLifecycleRequest struct {
+		MinioConfig config.MinioConfig          `json:"minio" binding:"required"`
+		Rules       []minioclient.LifecycleRule `json:"rules"` // 기존 규칙 전체를 교체 (빈 목록이면 수명 주기 설정 삭제)
+	}
+
+	// BucketPolicyRequest : 버킷 정책 설정 요청 구조체 (minio 포함)
+	BucketPolicyRequest struct {
+		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
+		Policy      json.RawMessage    `json:"policy" swaggertype:"object"` // S3 버킷 정책 JSON
+	}
+
+	// ReplicationEndpoint : 복제 원본/대상 MinIO 엔드포인트 및 버킷
+	ReplicationEndpoint struct {
+		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
+		Bucket      string             `json:"bucket" binding:"required" example:"velero"`
+	}
+
+	// ReplicationRequest : 버킷 간 객체 복제 요청 구조체 (엔드포인트가 달라도 됨)
+	ReplicationRequest struct {
+		Source         ReplicationEndpoint `json:"source" binding:"required"`
+		Destination    ReplicationEndpoint `json:"destination" binding:"required"`                                 // 버킷이 없으면 생성
+		Prefixes       []string            `json:"prefixes,omitempty" example:"backups/daily-2024-01-15/,restic/"` // 비어 있으면 버킷 전체
+		Concurrency    int                 `json:"concurrency,omitempty" example:"4"`                              // 동시 복사 객체 수 (기본 값 : 4, 최대 32)
+		VerifyChecksum *bool               `json:"verifyChecksum,omitempty" example:"true"`                        // 전송 데이터 MD5를 원본 ETag와 비교 (기본 값 : true)
+		SkipUnchanged  *bool               `json:"skipUnchanged,omitempty" example:"true"`                         // 크기와 원본 ETag가 같은 대상 객체 건너뜀 (기본 값 : true)
+	}
 )
 
 // MinIO 타입 어설션 헬퍼 함수들
@@ -6,6 +6,8 @@ import (
 
 	"github.com/taking/kubemigrate/pkg/config"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/util/results"
+	v1 "k8s.io/api/core/v1"
 )
 
 // Velero 리소스 타입 정의
@@ -203,23 +205,100 @@ type (
 		Metadata                 map[string]string `json:"metadata,omitempty"`
 	}
 
+	// RegistryRef : 등록된 클러스터/스토리지 참조 (kubeconfig/MinIO 설정 대신 사용, 쿼리 파라미터로도 전달 가능)
+	RegistryRef struct {
+		ClusterID string `json:"clusterId,omitempty" example:"prod-a"`       // kubeconfig 대신 사용
+		StorageID string `json:"storageId,omitempty" example:"backup-minio"` // minio 대신 사용
+	}
+
+	// InstallVeleroRequest : Velero 설치 요청 구조체 (kubeconfig, minio 또는 등록 ID)
+	InstallVeleroRequest struct {
+		config.VeleroConfig
+		RegistryRef
+	}
+
 	// CreateBackupRequest : 백업 생성 전체 요청 구조체 (kubeconfig, minio 포함)
 	CreateBackupRequest struct {
 		KubeConfig  config.KubeConfig  `json:"kubeconfig" binding:"required"`
 		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
 		Backup      BackupRequest      `json:"backup" binding:"required"`
+		RegistryRef
+	}
+
+	// ScheduleRequest : 백업 스케줄 생성/수정 요청 구조체
+	ScheduleRequest struct {
+		Name                       string        `json:"name" binding:"required" example:"daily-backup"`
+		Schedule                   string        `json:"schedule" binding:"required" example:"0 2 * * *"` // cron 표현식 (@daily, @every 6h 지원)
+		Paused                     *bool         `json:"paused,omitempty" example:"false"`                // 수정 시 생략하면 기존 값 유지
+		UseOwnerReferencesInBackup *bool         `json:"useOwnerReferencesInBackup,omitempty" example:"false"`
+		SkipImmediately            *bool         `json:"skipImmediately,omitempty" example:"false"`
+		Template                   BackupRequest `json:"template" binding:"required"` // 생성될 백업 템플릿 (name은 무시)
+	}
+
+	// CreateScheduleRequest : 스케줄 생성/수정 전체 요청 구조체 (kubeconfig, minio 포함)
+	CreateScheduleRequest struct {
+		KubeConfig  config.KubeConfig  `json:"kubeconfig" binding:"required"`
+		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
+		Schedule    ScheduleRequest    `json:"schedule" binding:"required"`
+		RegistryRef
+	}
+
+	// StorageCredential : 스토리지 자격 증명 Secret 설정
+	StorageCredential struct {
+		SecretName string `json:"secretName,omitempty" example:"aws-credentials"` // 기본 값 : '<location 이름>-credentials'
+		Key        string `json:"key,omitempty" example:"cloud"`                  // 기본 값 : 'cloud'
+		Data       string `json:"data,omitempty"`                                 // 자격 증명 파일 내용 (AWS credentials, GCP 서비스 계정 JSON, Azure env), 지정하면 Secret 생성/교체
+	}
+
+	// BackupStorageLocationRequest : BackupStorageLocation 생성/수정 요청 구조체
+	BackupStorageLocationRequest struct {
+		Name                string             `json:"name" binding:"required" example:"aws-primary"`
+		Provider            string             `json:"provider" binding:"required" example:"aws"` // aws (S3 호환 포함), gcp, azure
+		Bucket              string             `json:"bucket" binding:"required" example:"velero-backups"`
+		Prefix              string             `json:"prefix,omitempty" example:"cluster-a"`
+		CACert              string             `json:"caCert,omitempty"`                                                         // PEM 형식 CA 번들
+		Config              map[string]string  `json:"config,omitempty" example:"region:us-east-1,s3Url:https://s3.example.com"` // provider별 설정
+		Credential          *StorageCredential `json:"credential,omitempty"`
+		Default             bool               `json:"default,omitempty" example:"false"`          // true이면 다른 BSL의 default 해제
+		AccessMode          string             `json:"accessMode,omitempty" example:"ReadWrite"`   // "ReadWrite", "ReadOnly"
+		BackupSyncPeriod    string             `json:"backupSyncPeriod,omitempty" example:"1m0s"`  // 백업 동기화 주기
+		ValidationFrequency string             `json:"validationFrequency,omitempty" example:"1m"` // 스토리지 검증 주기
+	}
+
+	// CreateBackupStorageLocationRequest : BSL 생성/수정 전체 요청 구조체 (kubeconfig 포함)
+	CreateBackupStorageLocationRequest struct {
+		KubeConfig config.KubeConfig            `json:"kubeconfig" binding:"required"`
+		Location   BackupStorageLocationRequest `json:"location" binding:"required"`
+		ClusterID  string                       `json:"clusterId,omitempty" example:"prod-a"` // kubeconfig 대신 사용
+	}
+
+	// VolumeSnapshotLocationRequest : VolumeSnapshotLocation 생성/수정 요청 구조체
+	VolumeSnapshotLocationRequest struct {
+		Name       string             `json:"name" binding:"required" example:"aws-snapshots"`
+		Provider   string             `json:"provider" binding:"required" example:"aws"` // aws, gcp, azure
+		Config     map[string]string  `json:"config,omitempty" example:"region:us-east-1"`
+		Credential *StorageCredential `json:"credential,omitempty"`
+	}
+
+	// CreateVolumeSnapshotLocationRequest : VSL 생성/수정 전체 요청 구조체 (kubeconfig 포함)
+	CreateVolumeSnapshotLocationRequest struct {
+		KubeConfig config.KubeConfig             `json:"kubeconfig" binding:"required"`
+		Location   VolumeSnapshotLocationRequest `json:"location" binding:"required"`
+		ClusterID  string                        `json:"clusterId,omitempty" example:"prod-a"` // kubeconfig 대신 사용
 	}
 
 	// DeleteBackupRequest : 백업 삭제 전체 요청 구조체 (kubeconfig, minio 포함)
 	DeleteBackupRequest struct {
 		KubeConfig  config.KubeConfig  `json:"kubeconfig" binding:"required"`
 		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
+		RegistryRef
 	}
 
 	// DeleteRestoreRequest : 복원 삭제 전체 요청 구조체 (kubeconfig, minio 포함)
 	DeleteRestoreRequest struct {
 		KubeConfig  config.KubeConfig  `json:"kubeconfig" binding:"required"`
 		MinioConfig config.MinioConfig `json:"minio" binding:"required"`
+		RegistryRef
 	}
 
 	// RestoreRequest : 복원 생성 요청 구조체
@@ -238,14 +317,52 @@ type (
 		RestorePVs              bool              `json:"restorePVs" binding:"required" example:"true"`
 		StorageClassMappings    map[string]string `json:"storageClassMappings,omitempty" example:"original-sc:new-sc"`
 		NamespaceMappings       map[string]string `json:"namespaceMappings,omitempty" example:"old-ns:new-ns"`
+		ExistingResourcePolicy  string            `json:"existingResourcePolicy,omitempty" example:"none"` // "none", "update"
 		Hooks                   *RestoreHooks     `json:"hooks,omitempty"`
-		Metadata                map[string]string `json:"metadata,omitempty"`
+		Metadata                map[string]string `json:"metadata,omitempty"`    // Restore 리소스에 부여할 레이블
+		Annotations             map[string]string `json:"annotations,omitempty"` // Restore 리소스에 부여할 어노테이션
 	}
 
 	// VeleroRestoreRequest : 복원 생성 전체 요청 구조체 (kubeconfig 포함)
 	VeleroRestoreRequest struct {
-		KubeConfig config.KubeConfig `json:"kubeconfig" binding:"required"`
-		Restore    RestoreRequest    `json:"restore" binding:"required"`
+		KubeConfig  config.KubeConfig   `json:"kubeconfig" binding:"required"`
+		MinioConfig *config.MinioConfig `json:"minio,omitempty"` // [옵션] dry-run 시 백업 tarball 조회용 (필수)
+		Restore     RestoreRequest      `json:"restore" binding:"required"`
+		RegistryRef
+	}
+
+	// RestorePreview : 복원 dry-run 결과 (생성되는 리소스 없음)
+	RestorePreview struct {
+		RestoreName string                `json:"restoreName"`
+		BackupName  string                `json:"backupName"`
+		Summary     RestorePreviewSummary `json:"summary"`
+		Items       []RestorePreviewItem  `json:"items"`
+		Warnings    []string              `json:"warnings,omitempty"`
+	}
+
+	// RestorePreviewSummary : 복원 dry-run 요약
+	RestorePreviewSummary struct {
+		BackupItems int `json:"backupItems"` // 백업에 포함된 전체 객체 수
+		Restored    int `json:"restored"`    // 필터 적용 후 복원 대상 객체 수
+		Excluded    int `json:"excluded"`    // 필터로 제외된 객체 수
+		Create      int `json:"create"`
+		Update      int `json:"update"`
+		Skip        int `json:"skip"`
+		Conflicts   int `json:"conflicts"` // 대상 클러스터에 이미 존재하는 객체 수
+	}
+
+	// RestorePreviewItem : 복원 대상 객체
+	RestorePreviewItem struct {
+		Resource           string `json:"resource" example:"persistentvolumeclaims"`
+		Namespace          string `json:"namespace,omitempty" example:"app"`
+		Name               string `json:"name" example:"data"`
+		TargetNamespace    string `json:"targetNamespace,omitempty" example:"app-restored"` // 네임스페이스 매핑 적용 결과
+		TargetName         string `json:"targetName,omitempty"`                             // namespaces 리소스의 매핑 결과
+		StorageClass       string `json:"storageClass,omitempty" example:"standard"`
+		TargetStorageClass string `json:"targetStorageClass,omitempty" example:"fast-ssd"` // StorageClass 매핑 적용 결과
+		Action             string `json:"action" example:"create"`                         // create, update, skip
+		Exists             bool   `json:"exists"`                                          // 대상 클러스터에 같은 객체 존재 여부
+		Message            string `json:"message,omitempty"`
 	}
 
 	// RestoreHooks : 복원 훅 설정
@@ -266,6 +383,13 @@ type (
 	// RestoreHookSpec : 개별 복원 훅 설정
 	RestoreHookSpec struct {
 		Exec *ExecHook `json:"exec,omitempty"`
+		Init *InitHook `json:"init,omitempty"`
+	}
+
+	// InitHook : 복원된 Pod에 주입할 InitContainer 훅 설정
+	InitHook struct {
+		InitContainers []v1.Container `json:"initContainers" binding:"required"`
+		Timeout        string         `json:"timeout,omitempty" example:"5m"`
 	}
 
 	// RestoreResult : 복원 생성 결과
@@ -348,6 +472,10 @@ type (
 		Command   []string `json:"command" binding:"required"`
 		OnError   string   `json:"onError,omitempty"` // "Continue", "Fail"
 		Timeout   string   `json:"timeout,omitempty" example:"30s"`
+
+		// 복원 훅 전용 설정
+		WaitTimeout  string `json:"waitTimeout,omitempty" example:"2m"`
+		WaitForReady *bool  `json:"waitForReady,omitempty" example:"true"`
 	}
 
 	// BackupResult : 백업 생성 결과
@@ -399,6 +527,86 @@ type (
 		StorageLocation  string         `json:"storageLocation"`
 		BackupRepository string         `json:"backupRepository"`
 	}
+
+	// BackupContents : 오브젝트 스토리지의 백업 tarball 내용 색인
+	BackupContents struct {
+		BackupName   string              `json:"backupName"`
+		Bucket       string              `json:"bucket"`
+		Prefix       string              `json:"prefix,omitempty"`
+		TotalItems   int                 `json:"totalItems"`
+		Namespaces   map[string]int      `json:"namespaces"`             // 네임스페이스별 객체 수
+		Resources    map[string]int      `json:"resources"`              // 리소스(resource.group)별 객체 수
+		Kinds        map[string]int      `json:"kinds,omitempty"`        // GVK별 객체 수 (resource-list 기준)
+		ResourceList map[string][]string `json:"resourceList,omitempty"` // <backup>-resource-list.json.gz 내용
+		Items        []BackupContentItem `json:"items"`
+		IndexedAt    time.Time           `json:"indexedAt"`
+	}
+
+	// BackupContentItem : 백업 tarball에 포함된 객체
+	BackupContentItem struct {
+		Resource         string   `json:"resource" example:"deployments.apps"`
+		Namespace        string   `json:"namespace,omitempty" example:"default"` // cluster 범위 리소스는 비어 있음
+		Name             string   `json:"name" example:"nginx"`
+		PreferredVersion string   `json:"preferredVersion,omitempty" example:"v1"`
+		Versions         []string `json:"versions,omitempty"` // tarball에 저장된 API 버전
+		Path             string   `json:"path"`               // tarball 내 매니페스트 경로
+		Size             int64    `json:"size"`
+	}
+
+	// BackupDiff : 두 백업 간 객체 비교 결과 (From 기준으로 To의 변경 사항)
+	BackupDiff struct {
+		From    string            `json:"from" example:"nightly-20240114"`
+		To      string            `json:"to" example:"nightly-20240115"`
+		Summary BackupDiffSummary `json:"summary"`
+		Added   []BackupDiffItem  `json:"added"`
+		Removed []BackupDiffItem  `json:"removed"`
+		Changed []BackupDiffItem  `json:"changed"`
+	}
+
+	// BackupDiffSummary : 백업 비교 요약
+	BackupDiffSummary struct {
+		Added     int `json:"added"`
+		Removed   int `json:"removed"`
+		Changed   int `json:"changed"`
+		Unchanged int `json:"unchanged"`
+	}
+
+	// BackupDiffItem : 추가/삭제/변경된 객체
+	BackupDiffItem struct {
+		Resource  string                `json:"resource" example:"deployments.apps"`
+		Namespace string                `json:"namespace,omitempty" example:"default"`
+		Name      string                `json:"name" example:"nginx"`
+		Patch     []BackupDiffOperation `json:"patch,omitempty"` // 변경된 객체만 포함
+	}
+
+	// BackupDiffOperation : JSON Patch(RFC 6902) 형식의 변경 항목 (oldValue는 비교용 확장 필드)
+	BackupDiffOperation struct {
+		Op       string      `json:"op" example:"replace"` // add, remove, replace
+		Path     string      `json:"path" example:"/spec/replicas"`
+		Value    interface{} `json:"value,omitempty"`
+		OldValue interface{} `json:"oldValue,omitempty"`
+	}
+
+	// VeleroLogs : DownloadRequest로 내려받은 백업/복원 로그
+	VeleroLogs struct {
+		Name     string   `json:"name" example:"daily-backup"`
+		Kind     string   `json:"kind" example:"BackupLog"` // BackupLog, RestoreLog
+		Total    int      `json:"total"`                    // 필터 적용 전 전체 줄 수
+		Warnings int      `json:"warnings"`
+		Errors   int      `json:"errors"`
+		Lines    []string `json:"lines"`
+	}
+
+	// RestoreResults : 복원 결과 (네임스페이스별 경고/오류)
+	RestoreResults struct {
+		RestoreName  string         `json:"restoreName" example:"daily-backup-restore"`
+		BackupName   string         `json:"backupName" example:"daily-backup"`
+		Phase        string         `json:"phase" example:"PartiallyFailed"`
+		WarningCount int            `json:"warningCount"`
+		ErrorCount   int            `json:"errorCount"`
+		Warnings     results.Result `json:"warnings"`
+		Errors       results.Result `json:"errors"`
+	}
 )
 
 // InstallationError의 Error 인터페이스 구현